@@ -0,0 +1,50 @@
+package blocks
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Directory is the directory which operates on db table 'blocks'
+type Directory struct {
+	// querier is an interface containing all of the
+	// directory methods. Must be created with blocks.NewDirectory(db)
+	querier Querier
+	db      *sql.DB
+}
+
+// NewDirectory creates a new blocks directory
+func NewDirectory(db *sql.DB) *Directory {
+	return &Directory{db: db, querier: New(db)}
+}
+
+// Close closes Directory database connection
+func (d Directory) Close() error {
+	return d.db.Close()
+}
+
+// BlockUser blocks the target user on behalf of userID. Blocking an already blocked user is a no-op
+func (d Directory) BlockUser(ctx context.Context, userID, blockedUserID string) error {
+	return d.querier.BlockUser(ctx, BlockUserParams{
+		UserID:        userID,
+		BlockedUserID: blockedUserID,
+		CreatedAt:     time.Now(),
+	})
+}
+
+// UnblockUser removes the block on the target user
+func (d Directory) UnblockUser(ctx context.Context, userID, blockedUserID string) error {
+	return d.querier.UnblockUser(ctx, UnblockUserParams{
+		UserID:        userID,
+		BlockedUserID: blockedUserID,
+	})
+}
+
+// IsBlockedBetween returns whether any of the two users has blocked the other one
+func (d Directory) IsBlockedBetween(ctx context.Context, userID, otherUserID string) (bool, error) {
+	return d.querier.IsBlockedBetween(ctx, IsBlockedBetweenParams{
+		UserID:        userID,
+		BlockedUserID: otherUserID,
+	})
+}
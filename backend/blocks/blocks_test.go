@@ -0,0 +1,42 @@
+package blocks_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DagDigg/unpaper/backend/blocks"
+	v1Testing "github.com/DagDigg/unpaper/backend/pkg/service/v1/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockUser(t *testing.T) {
+	t.Parallel()
+	ws := v1Testing.GetWrappedServer(t)
+	dir := blocks.NewDirectory(ws.Server.GetDB())
+	assert := assert.New(t)
+
+	t.Run("When blocking and unblocking user", func(t *testing.T) {
+		blocker, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		blocked, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+
+		ctx := context.Background()
+		assert.Nil(dir.BlockUser(ctx, blocker.Id, blocked.Id))
+		// Blocking twice is a no-op
+		assert.Nil(dir.BlockUser(ctx, blocker.Id, blocked.Id))
+
+		// Block is checked in both directions
+		ok, err := dir.IsBlockedBetween(ctx, blocker.Id, blocked.Id)
+		assert.Nil(err)
+		assert.True(ok)
+		ok, err = dir.IsBlockedBetween(ctx, blocked.Id, blocker.Id)
+		assert.Nil(err)
+		assert.True(ok)
+
+		assert.Nil(dir.UnblockUser(ctx, blocker.Id, blocked.Id))
+		ok, err = dir.IsBlockedBetween(ctx, blocker.Id, blocked.Id)
+		assert.Nil(err)
+		assert.False(ok)
+	})
+}
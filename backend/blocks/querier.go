@@ -0,0 +1,15 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package blocks
+
+import (
+	"context"
+)
+
+type Querier interface {
+	BlockUser(ctx context.Context, arg BlockUserParams) error
+	IsBlockedBetween(ctx context.Context, arg IsBlockedBetweenParams) (bool, error)
+	UnblockUser(ctx context.Context, arg UnblockUserParams) error
+}
+
+var _ Querier = (*Queries)(nil)
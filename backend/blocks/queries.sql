@@ -0,0 +1,16 @@
+-- name: BlockUser :exec
+INSERT INTO blocks (user_id, blocked_user_id, created_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, blocked_user_id) DO NOTHING;
+
+-- name: UnblockUser :exec
+DELETE FROM blocks
+WHERE user_id=$1 AND blocked_user_id=$2;
+
+-- name: IsBlockedBetween :one
+SELECT EXISTS(
+	SELECT 1 FROM blocks
+	WHERE
+	(user_id=$1 AND blocked_user_id=$2) OR
+	(user_id=$2 AND blocked_user_id=$1)
+);
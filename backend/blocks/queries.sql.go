@@ -0,0 +1,62 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: queries.sql
+
+package blocks
+
+import (
+	"context"
+	"time"
+)
+
+const blockUser = `-- name: BlockUser :exec
+INSERT INTO blocks (user_id, blocked_user_id, created_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, blocked_user_id) DO NOTHING
+`
+
+type BlockUserParams struct {
+	UserID        string
+	BlockedUserID string
+	CreatedAt     time.Time
+}
+
+func (q *Queries) BlockUser(ctx context.Context, arg BlockUserParams) error {
+	_, err := q.db.ExecContext(ctx, blockUser, arg.UserID, arg.BlockedUserID, arg.CreatedAt)
+	return err
+}
+
+const isBlockedBetween = `-- name: IsBlockedBetween :one
+SELECT EXISTS(
+	SELECT 1 FROM blocks
+	WHERE
+	(user_id=$1 AND blocked_user_id=$2) OR
+	(user_id=$2 AND blocked_user_id=$1)
+)
+`
+
+type IsBlockedBetweenParams struct {
+	UserID        string
+	BlockedUserID string
+}
+
+func (q *Queries) IsBlockedBetween(ctx context.Context, arg IsBlockedBetweenParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isBlockedBetween, arg.UserID, arg.BlockedUserID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const unblockUser = `-- name: UnblockUser :exec
+DELETE FROM blocks
+WHERE user_id=$1 AND blocked_user_id=$2
+`
+
+type UnblockUserParams struct {
+	UserID        string
+	BlockedUserID string
+}
+
+func (q *Queries) UnblockUser(ctx context.Context, arg UnblockUserParams) error {
+	_, err := q.db.ExecContext(ctx, unblockUser, arg.UserID, arg.BlockedUserID)
+	return err
+}
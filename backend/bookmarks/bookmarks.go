@@ -0,0 +1,117 @@
+package bookmarks
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+)
+
+// MaxCollectionLength is the maximum length, in characters, of a collection name
+const MaxCollectionLength = 50
+
+var (
+	// ErrPostNotFound is returned when bookmarking a post which does not exist or has been deleted
+	ErrPostNotFound = errors.New("post not found")
+	// ErrCollectionTooLong is returned when the collection name exceeds MaxCollectionLength
+	ErrCollectionTooLong = errors.New("collection name too long")
+)
+
+// Directory is the directory which operates on db table 'bookmarks'
+type Directory struct {
+	// querier is an interface containing all of the
+	// directory methods. Must be created with bookmarks.NewDirectory(db)
+	querier Querier
+	db      *sql.DB
+}
+
+// NewDirectory creates a new bookmarks directory
+func NewDirectory(db *sql.DB) *Directory {
+	return &Directory{db: db, querier: New(db)}
+}
+
+// Close closes Directory database connection
+func (d Directory) Close() error {
+	return d.db.Close()
+}
+
+// NormalizeCollection trims the collection name, returning an error if it's too long.
+// An empty name refers to bookmarks without a collection
+func NormalizeCollection(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if utf8.RuneCountInString(name) > MaxCollectionLength {
+		return "", ErrCollectionTooLong
+	}
+
+	return name, nil
+}
+
+// BookmarkPost bookmarks the post on behalf of the user, inside the optional collection.
+// Bookmarking an already bookmarked post moves it to the collection
+func (d Directory) BookmarkPost(ctx context.Context, params BookmarkPostParams) error {
+	if params.CreatedAt.IsZero() {
+		params.CreatedAt = time.Now().UTC()
+	}
+	n, err := d.querier.BookmarkPost(ctx, params)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrPostNotFound
+	}
+
+	return nil
+}
+
+// UnbookmarkPost removes the post from the user bookmarks. Removing a missing bookmark is a no-op
+func (d Directory) UnbookmarkPost(ctx context.Context, userID, postID string) error {
+	return d.querier.UnbookmarkPost(ctx, UnbookmarkPostParams{
+		UserID: userID,
+		PostID: postID,
+	})
+}
+
+// GetBookmarkedPostIDs returns the set of posts, among postIDs, bookmarked by the user
+func (d Directory) GetBookmarkedPostIDs(ctx context.Context, userID string, postIDs []string) (map[string]bool, error) {
+	res, err := d.querier.GetBookmarkedPostIDs(ctx, GetBookmarkedPostIDsParams{
+		PostIds: postIDs,
+		UserID:  userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bookmarked := map[string]bool{}
+	for _, id := range res {
+		bookmarked[id] = true
+	}
+
+	return bookmarked, nil
+}
+
+// GetBookmarkKeys returns the post ids and bookmark dates of a page of the user bookmarks, from the latest
+func (d Directory) GetBookmarkKeys(ctx context.Context, params GetBookmarkKeysParams) ([]GetBookmarkKeysRow, error) {
+	return d.querier.GetBookmarkKeys(ctx, params)
+}
+
+// GetBookmarkCollections returns the user collections, from the most recently used
+func (d Directory) GetBookmarkCollections(ctx context.Context, userID string) ([]*v1API.BookmarkCollection, error) {
+	res, err := d.querier.GetBookmarkCollections(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	collections := []*v1API.BookmarkCollection{}
+	for _, r := range res {
+		collections = append(collections, &v1API.BookmarkCollection{
+			Name:           r.Collection,
+			BookmarksCount: int32(r.BookmarksCount),
+		})
+	}
+
+	return collections, nil
+}
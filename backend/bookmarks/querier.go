@@ -0,0 +1,17 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package bookmarks
+
+import (
+	"context"
+)
+
+type Querier interface {
+	BookmarkPost(ctx context.Context, arg BookmarkPostParams) (int64, error)
+	GetBookmarkCollections(ctx context.Context, userID string) ([]GetBookmarkCollectionsRow, error)
+	GetBookmarkKeys(ctx context.Context, arg GetBookmarkKeysParams) ([]GetBookmarkKeysRow, error)
+	GetBookmarkedPostIDs(ctx context.Context, arg GetBookmarkedPostIDsParams) ([]string, error)
+	UnbookmarkPost(ctx context.Context, arg UnbookmarkPostParams) error
+}
+
+var _ Querier = (*Queries)(nil)
@@ -0,0 +1,28 @@
+-- name: BookmarkPost :execrows
+INSERT INTO bookmarks (user_id, post_id, collection, created_at)
+SELECT sqlc.arg(user_id)::VARCHAR(100), p.id, sqlc.arg(collection)::VARCHAR(100), sqlc.arg(created_at)::TIMESTAMPTZ
+FROM posts p
+WHERE p.id = sqlc.arg(post_id)::VARCHAR(100) AND p.deleted_at IS NULL
+ON CONFLICT (user_id, post_id) DO UPDATE SET collection = EXCLUDED.collection;
+
+-- name: UnbookmarkPost :exec
+DELETE FROM bookmarks
+WHERE user_id = $1 AND post_id = $2;
+
+-- name: GetBookmarkedPostIDs :many
+SELECT post_id FROM bookmarks
+WHERE post_id = ANY(sqlc.arg(post_ids)::VARCHAR(100)[]) AND user_id = sqlc.arg(user_id)::VARCHAR(100);
+
+-- name: GetBookmarkKeys :many
+SELECT post_id, created_at FROM bookmarks
+WHERE user_id = sqlc.arg(user_id)::VARCHAR(100)
+AND (NOT sqlc.arg(has_collection)::BOOLEAN OR collection = sqlc.arg(collection)::VARCHAR(100))
+AND (NOT sqlc.arg(has_cursor)::BOOLEAN OR (created_at, post_id) < (sqlc.arg(cursor_created_at)::TIMESTAMPTZ, sqlc.arg(cursor_id)::VARCHAR(100)))
+ORDER BY created_at DESC, post_id DESC
+LIMIT sqlc.arg(page_size)::INTEGER;
+
+-- name: GetBookmarkCollections :many
+SELECT collection, COUNT(*) AS bookmarks_count FROM bookmarks
+WHERE user_id = $1 AND collection <> ''
+GROUP BY collection
+ORDER BY MAX(created_at) DESC;
@@ -0,0 +1,177 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: queries.sql
+
+package bookmarks
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const bookmarkPost = `-- name: BookmarkPost :execrows
+INSERT INTO bookmarks (user_id, post_id, collection, created_at)
+SELECT $1::VARCHAR(100), p.id, $2::VARCHAR(100), $3::TIMESTAMPTZ
+FROM posts p
+WHERE p.id = $4::VARCHAR(100) AND p.deleted_at IS NULL
+ON CONFLICT (user_id, post_id) DO UPDATE SET collection = EXCLUDED.collection
+`
+
+type BookmarkPostParams struct {
+	UserID     string
+	Collection string
+	CreatedAt  time.Time
+	PostID     string
+}
+
+func (q *Queries) BookmarkPost(ctx context.Context, arg BookmarkPostParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, bookmarkPost,
+		arg.UserID,
+		arg.Collection,
+		arg.CreatedAt,
+		arg.PostID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getBookmarkCollections = `-- name: GetBookmarkCollections :many
+SELECT collection, COUNT(*) AS bookmarks_count FROM bookmarks
+WHERE user_id = $1 AND collection <> ''
+GROUP BY collection
+ORDER BY MAX(created_at) DESC
+`
+
+type GetBookmarkCollectionsRow struct {
+	Collection     string
+	BookmarksCount int64
+}
+
+func (q *Queries) GetBookmarkCollections(ctx context.Context, userID string) ([]GetBookmarkCollectionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getBookmarkCollections, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetBookmarkCollectionsRow
+	for rows.Next() {
+		var i GetBookmarkCollectionsRow
+		if err := rows.Scan(&i.Collection, &i.BookmarksCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getBookmarkKeys = `-- name: GetBookmarkKeys :many
+SELECT post_id, created_at FROM bookmarks
+WHERE user_id = $1::VARCHAR(100)
+AND (NOT $2::BOOLEAN OR collection = $3::VARCHAR(100))
+AND (NOT $4::BOOLEAN OR (created_at, post_id) < ($5::TIMESTAMPTZ, $6::VARCHAR(100)))
+ORDER BY created_at DESC, post_id DESC
+LIMIT $7::INTEGER
+`
+
+type GetBookmarkKeysParams struct {
+	UserID          string
+	HasCollection   bool
+	Collection      string
+	HasCursor       bool
+	CursorCreatedAt time.Time
+	CursorID        string
+	PageSize        int32
+}
+
+type GetBookmarkKeysRow struct {
+	PostID    string
+	CreatedAt time.Time
+}
+
+func (q *Queries) GetBookmarkKeys(ctx context.Context, arg GetBookmarkKeysParams) ([]GetBookmarkKeysRow, error) {
+	rows, err := q.db.QueryContext(ctx, getBookmarkKeys,
+		arg.UserID,
+		arg.HasCollection,
+		arg.Collection,
+		arg.HasCursor,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetBookmarkKeysRow
+	for rows.Next() {
+		var i GetBookmarkKeysRow
+		if err := rows.Scan(&i.PostID, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getBookmarkedPostIDs = `-- name: GetBookmarkedPostIDs :many
+SELECT post_id FROM bookmarks
+WHERE post_id = ANY($1::VARCHAR(100)[]) AND user_id = $2::VARCHAR(100)
+`
+
+type GetBookmarkedPostIDsParams struct {
+	PostIds []string
+	UserID  string
+}
+
+func (q *Queries) GetBookmarkedPostIDs(ctx context.Context, arg GetBookmarkedPostIDsParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getBookmarkedPostIDs, pq.Array(arg.PostIds), arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var post_id string
+		if err := rows.Scan(&post_id); err != nil {
+			return nil, err
+		}
+		items = append(items, post_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const unbookmarkPost = `-- name: UnbookmarkPost :exec
+DELETE FROM bookmarks
+WHERE user_id = $1 AND post_id = $2
+`
+
+type UnbookmarkPostParams struct {
+	UserID string
+	PostID string
+}
+
+func (q *Queries) UnbookmarkPost(ctx context.Context, arg UnbookmarkPostParams) error {
+	_, err := q.db.ExecContext(ctx, unbookmarkPost, arg.UserID, arg.PostID)
+	return err
+}
@@ -3,9 +3,13 @@ package comments
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"time"
 
 	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/pagination"
 	"github.com/Masterminds/squirrel"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Directory is the directory which operates on db table 'users'
@@ -41,10 +45,24 @@ const (
 
 // CreateComment INSERTs a comment in db
 func (d *Directory) CreateComment(ctx context.Context, args CreateCommentParams) (*v1API.Comment, error) {
+	if args.Mentions == nil {
+		args.Mentions = json.RawMessage("[]")
+	}
+	if args.CreatedAt.IsZero() {
+		args.CreatedAt = time.Now().UTC()
+	}
 	res, err := d.querier.CreateComment(ctx, args)
 	if err != nil {
 		return nil, err
 	}
+	// Replies change the controversy score of the parent
+	ids := []string{res.ID}
+	if res.ParentID.Valid {
+		ids = append(ids, res.ParentID.String)
+	}
+	if err := d.querier.RefreshCommentScores(ctx, ids); err != nil {
+		return nil, err
+	}
 
 	return pgCommentToPB(pgCommentToPBParams{
 		c:               res,
@@ -58,45 +76,183 @@ func (d *Directory) GetComments(ctx context.Context, userID string, postID strin
 	if err != nil {
 		return nil, err
 	}
-	cmts := []*v1API.Comment{}
-	for _, c := range res {
-		pbCmt, err := pgCommentToPB(pgCommentToPBParams{
-			c:               c,
-			hasAlreadyLiked: hasAlreadyLiked(c.UserIdsWhoLikes, userID),
-		})
-		if err != nil {
-			return nil, err
-		}
-		cmts = append(cmts, pbCmt)
+
+	return d.commentsToPB(ctx, userID, res)
+}
+
+// GetCommentsPage returns a page of the post comments, of any level, in the requested order.
+// The next page cursor is returned along with the comments
+func (d *Directory) GetCommentsPage(ctx context.Context, userID string, params GetCommentsPageParams) ([]*v1API.Comment, string, error) {
+	res, err := d.querier.GetCommentsPage(ctx, params)
+	if err != nil {
+		return nil, "", err
+	}
+
+	cmts := []Comment{}
+	for _, r := range res {
+		cmts = append(cmts, scoredRowToComment(GetCommentsLevelRow(r)))
+	}
+	pbCmts, err := d.commentsToPB(ctx, userID, cmts)
+	if err != nil {
+		return nil, "", err
+	}
+	nextCursor := ""
+	if len(res) > 0 {
+		nextCursor = pagination.NextCursor(len(res), params.PageSize, scoredRowCursor(GetCommentsLevelRow(res[len(res)-1])))
+	}
+
+	return pbCmts, nextCursor, nil
+}
+
+// CountCommentsByPostIDs returns the number of comments of every post, keyed by post id.
+// Posts without comments are not present in the map
+func (d *Directory) CountCommentsByPostIDs(ctx context.Context, postIDs []string) (map[string]int32, error) {
+	res, err := d.querier.CountCommentsByPostIDs(ctx, postIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int32{}
+	for _, r := range res {
+		counts[r.PostID] = int32(r.CommentsCount)
+	}
+
+	return counts, nil
+}
+
+// GetTopCommentsByPostIDs returns at most `perPost` comments for every post, keyed by post id.
+// Comments threaded to the post come first, followed by the most liked ones
+func (d *Directory) GetTopCommentsByPostIDs(ctx context.Context, userID string, postIDs []string, perPost int32) (map[string][]*v1API.Comment, error) {
+	res, err := d.querier.GetTopCommentsByPostIDs(ctx, GetTopCommentsByPostIDsParams{
+		PostIds: postIDs,
+		PerPost: perPost,
+	})
+	if err != nil {
+		return nil, err
+	}
+	cmts, err := d.commentsToPB(ctx, userID, res)
+	if err != nil {
+		return nil, err
+	}
+
+	byPost := map[string][]*v1API.Comment{}
+	for _, c := range cmts {
+		byPost[c.PostId] = append(byPost[c.PostId], c)
+	}
+
+	return byPost, nil
+}
+
+// GetComment returns the db comment by ID
+func (d *Directory) GetComment(ctx context.Context, userID, commentID string) (*v1API.Comment, error) {
+	res, err := d.querier.GetComment(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.commentToPB(ctx, userID, res)
+}
+
+// GetCommentsByIDs returns the comments with the passed ids, in no particular order
+func (d *Directory) GetCommentsByIDs(ctx context.Context, userID string, ids []string) ([]*v1API.Comment, error) {
+	res, err := d.querier.GetCommentsByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.commentsToPB(ctx, userID, res)
+}
+
+// UpdateCommentMessage updates the comment message, recording the previous one in the edit history.
+// Only the author can update a comment, and deleted comments cannot be updated
+func (d *Directory) UpdateCommentMessage(ctx context.Context, params UpdateCommentMessageParams) (*v1API.Comment, error) {
+	if params.Mentions == nil {
+		params.Mentions = json.RawMessage("[]")
+	}
+	if params.EditedAt.IsZero() {
+		params.EditedAt = time.Now().UTC()
+	}
+	res, err := d.querier.UpdateCommentMessage(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.commentToPB(ctx, params.Author, res)
+}
+
+// SoftDeleteComment replaces the comment content with a placeholder, recording the previous message in the edit history.
+// The comment row is kept, so that replies and threads still reference it, while its hashtags are removed
+func (d *Directory) SoftDeleteComment(ctx context.Context, params SoftDeleteCommentParams) (*v1API.Comment, error) {
+	if params.DeletedAt.IsZero() {
+		params.DeletedAt = time.Now().UTC()
+	}
+	// Moderators remove content on behalf of the author
+	if params.EditorID == "" {
+		params.EditorID = params.Author
+	}
+	res, err := d.querier.SoftDeleteComment(ctx, params)
+	if err != nil {
+		return nil, err
 	}
 
-	return cmts, nil
+	return d.commentToPB(ctx, params.Author, res)
 }
 
-// LikeComment increments the likes of the comment
+// LikeComment records the user like and increments the likes of the comment.
+// Liking an already liked comment leaves the comment unchanged
 func (d *Directory) LikeComment(ctx context.Context, params LikeCommentParams) (*v1API.Comment, error) {
+	if params.CreatedAt.IsZero() {
+		params.CreatedAt = time.Now().UTC()
+	}
 	res, err := d.querier.LikeComment(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	if err := d.querier.RefreshCommentScores(ctx, []string{res.ID}); err != nil {
+		return nil, err
+	}
 
 	return pgCommentToPB(pgCommentToPBParams{
 		c:               res,
-		hasAlreadyLiked: hasAlreadyLiked(res.UserIdsWhoLikes, params.UserID),
+		hasAlreadyLiked: true,
 	})
 }
 
-// RemoveLikeFromComment decrements the likes of the comment
+// RemoveLikeFromComment removes the user like and decrements the likes of the comment
 func (d *Directory) RemoveLikeFromComment(ctx context.Context, params RemoveLikeFromCommentParams) (*v1API.Comment, error) {
 	res, err := d.querier.RemoveLikeFromComment(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	if err := d.querier.RefreshCommentScores(ctx, []string{res.ID}); err != nil {
+		return nil, err
+	}
 
 	return pgCommentToPB(pgCommentToPBParams{
 		c:               res,
-		hasAlreadyLiked: hasAlreadyLiked(res.UserIdsWhoLikes, params.UserID),
+		hasAlreadyLiked: false,
+	})
+}
+
+// RecordCommentViews records that the comments have been served to the user, counting each viewer once.
+// Authors do not count as viewers of their own comments
+func (d *Directory) RecordCommentViews(ctx context.Context, userID string, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	viewed, err := d.querier.RecordCommentViews(ctx, RecordCommentViewsParams{
+		UserID:    userID,
+		Ids:       ids,
+		CreatedAt: time.Now().UTC(),
 	})
+	if err != nil {
+		return err
+	}
+	if len(viewed) == 0 {
+		return nil
+	}
+
+	return d.querier.RefreshCommentScores(ctx, viewed)
 }
 
 // HasUserLikedComment returns whether the user has already liked the comment
@@ -108,3 +264,73 @@ func (d *Directory) HasUserLikedComment(ctx context.Context, params HasUserLiked
 
 	return ok, nil
 }
+
+// GetLikedCommentIDs returns the set of comments, among commentIDs, liked by the user
+func (d *Directory) GetLikedCommentIDs(ctx context.Context, userID string, commentIDs []string) (map[string]bool, error) {
+	liked := map[string]bool{}
+	if len(commentIDs) == 0 {
+		return liked, nil
+	}
+	res, err := d.querier.GetLikedCommentIDs(ctx, GetLikedCommentIDsParams{
+		CommentIds: commentIDs,
+		UserID:     userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range res {
+		liked[id] = true
+	}
+
+	return liked, nil
+}
+
+// GetCommentLikers returns a page of the users who liked the comment, from the latest like
+func (d *Directory) GetCommentLikers(ctx context.Context, params GetCommentLikersParams) ([]*v1API.Liker, error) {
+	res, err := d.querier.GetCommentLikers(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	likers := []*v1API.Liker{}
+	for _, r := range res {
+		likers = append(likers, &v1API.Liker{
+			UserId:   r.UserID,
+			Username: r.Username.String,
+			LikedAt:  timestamppb.New(r.CreatedAt),
+		})
+	}
+
+	return likers, nil
+}
+
+// commentToPB converts the comment to protobuf, along with whether the user liked it
+func (d *Directory) commentToPB(ctx context.Context, userID string, c Comment) (*v1API.Comment, error) {
+	liked, err := d.querier.HasUserLikedComment(ctx, HasUserLikedCommentParams{
+		CommentID: c.ID,
+		UserID:    userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pgCommentToPB(pgCommentToPBParams{
+		c:               c,
+		hasAlreadyLiked: liked,
+	})
+}
+
+// commentsToPB converts the comments to protobuf, retrieving with a single query which ones the user liked
+func (d *Directory) commentsToPB(ctx context.Context, userID string, cmts []Comment) ([]*v1API.Comment, error) {
+	ids := []string{}
+	for _, c := range cmts {
+		ids = append(ids, c.ID)
+	}
+	liked, err := d.GetLikedCommentIDs(ctx, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgCommentsListToPB(cmts, liked)
+}
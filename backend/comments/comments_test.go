@@ -6,8 +6,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/pagination"
 	v1Testing "github.com/DagDigg/unpaper/backend/pkg/service/v1/testing"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -120,8 +122,8 @@ func TestGetComments(t *testing.T) {
 			{id: "5", audio: &v1API.Audio{}},
 		}
 		for _, c := range cmtsToAdd {
-			q := `INSERT INTO COMMENTS (id, message, audio, author, parent_id, likes, post_id, thread_type)
-				  values ($1, $2, $3, $4, $5, $6, $7, $8)`
+			q := `INSERT INTO COMMENTS (id, message, audio, author, parent_id, likes, post_id, thread_type, created_at)
+				  values ($1, $2, $3, $4, $5, $6, $7, $8, now())`
 			_, err := ws.Server.GetDB().Exec(q, c.ID, c.Message, c.Audio, c.Author, c.ParentID, c.Likes, c.PostID, c.ThreadType)
 			if err != nil {
 				t.Fatalf("error inserting comments: %v", err)
@@ -179,6 +181,197 @@ func TestLikeComment(t *testing.T) {
 	})
 }
 
+func TestBatchedComments(t *testing.T) {
+	t.Parallel()
+	ws := v1Testing.GetWrappedServer(t)
+	dir := comments.NewDirectory(ws.Server.GetDB())
+	assert := assert.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	t.Run("When retrieving comments of multiple posts at once", func(t *testing.T) {
+		postA, postB := uuid.NewString(), uuid.NewString()
+		createdAt := time.Now().UTC()
+		for i := 0; i < 5; i++ {
+			_, err := dir.CreateComment(ctx, comments.CreateCommentParams{
+				ID:         uuid.NewString(),
+				Message:    sql.NullString{String: "foo", Valid: true},
+				Audio:      json.RawMessage("{}"),
+				Author:     uuid.NewString(),
+				PostID:     postA,
+				ThreadType: string(comments.ThreadTypeNone),
+				CreatedAt:  createdAt.Add(time.Duration(i) * time.Second),
+			})
+			assert.Nil(err)
+		}
+		_, err := dir.CreateComment(ctx, comments.CreateCommentParams{
+			ID:         uuid.NewString(),
+			Audio:      json.RawMessage("{}"),
+			Author:     uuid.NewString(),
+			PostID:     postB,
+			ThreadType: string(comments.ThreadTypeNone),
+		})
+		assert.Nil(err)
+
+		counts, err := dir.CountCommentsByPostIDs(ctx, []string{postA, postB})
+		assert.Nil(err)
+		assert.Equal(map[string]int32{postA: 5, postB: 1}, counts)
+
+		top, err := dir.GetTopCommentsByPostIDs(ctx, uuid.NewString(), []string{postA, postB}, 3)
+		assert.Nil(err)
+		assert.Len(top[postA], 3)
+		assert.Len(top[postB], 1)
+
+		// Paginate over the post comments
+		page, next, err := dir.GetCommentsPage(ctx, "", comments.GetCommentsPageParams{Sort: string(comments.SortOldest), PostID: postA, PageSize: 3})
+		assert.Nil(err)
+		assert.Len(page, 3)
+		cursor, err := pagination.Decode(next)
+		assert.Nil(err)
+		page, next, err = dir.GetCommentsPage(ctx, "", comments.GetCommentsPageParams{
+			Sort:        string(comments.SortOldest),
+			PostID:      postA,
+			PageSize:    3,
+			HasCursor:   true,
+			CursorScore: cursor.Score,
+			CursorID:    cursor.ID,
+		})
+		assert.Nil(err)
+		assert.Len(page, 2)
+		assert.Empty(next)
+	})
+}
+
+func TestCommentsRanking(t *testing.T) {
+	t.Parallel()
+	ws := v1Testing.GetWrappedServer(t)
+	dir := comments.NewDirectory(ws.Server.GetDB())
+	assert := assert.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	t.Run("When ranking comments by likes, replies and views", func(t *testing.T) {
+		postID := uuid.NewString()
+		create := func(parentID string) string {
+			c, err := dir.CreateComment(ctx, comments.CreateCommentParams{
+				ID:         uuid.NewString(),
+				Audio:      json.RawMessage("{}"),
+				Author:     uuid.NewString(),
+				ParentID:   sql.NullString{String: parentID, Valid: parentID != ""},
+				PostID:     postID,
+				ThreadType: string(comments.ThreadTypeNone),
+			})
+			assert.Nil(err)
+			return c.Id
+		}
+		like := func(id string, n int) {
+			for i := 0; i < n; i++ {
+				_, err := dir.LikeComment(ctx, comments.LikeCommentParams{ID: id, UserID: uuid.NewString()})
+				assert.Nil(err)
+			}
+		}
+		view := func(ids []string, n int) {
+			for i := 0; i < n; i++ {
+				assert.Nil(dir.RecordCommentViews(ctx, uuid.NewString(), ids))
+			}
+		}
+		firstIDs := func(sort comments.Sort) []string {
+			page, _, err := dir.GetCommentsPage(ctx, "", comments.GetCommentsPageParams{Sort: string(sort), PostID: postID, PageSize: 10})
+			assert.Nil(err)
+			ids := []string{}
+			for _, c := range page {
+				if c.ParentId == "" {
+					ids = append(ids, c.Id)
+				}
+			}
+			return ids
+		}
+
+		// A comment liked by most of its many viewers outranks one liked by its only viewer
+		popular, niche, debated := create(""), create(""), create("")
+		view([]string{popular}, 20)
+		like(popular, 15)
+		view([]string{niche}, 1)
+		like(niche, 1)
+		assert.Equal([]string{popular, niche}, firstIDs(comments.SortTop)[:2])
+
+		// Replies make a liked comment controversial
+		like(debated, 3)
+		for i := 0; i < 3; i++ {
+			create(debated)
+		}
+		assert.Equal(debated, firstIDs(comments.SortControversial)[0])
+		assert.Equal(popular, firstIDs(comments.SortHot)[0])
+	})
+}
+
+func TestGetCommentTree(t *testing.T) {
+	t.Parallel()
+	ws := v1Testing.GetWrappedServer(t)
+	dir := comments.NewDirectory(ws.Server.GetDB())
+	assert := assert.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	t.Run("When retrieving comments as a tree", func(t *testing.T) {
+		postID := uuid.NewString()
+		createdAt := time.Now().UTC()
+		create := func(id, parentID string, offset int) {
+			_, err := dir.CreateComment(ctx, comments.CreateCommentParams{
+				ID:         id,
+				Message:    sql.NullString{String: id, Valid: true},
+				Audio:      json.RawMessage("{}"),
+				Author:     uuid.NewString(),
+				ParentID:   sql.NullString{String: parentID, Valid: parentID != ""},
+				PostID:     postID,
+				ThreadType: string(comments.ThreadTypeNone),
+				CreatedAt:  createdAt.Add(time.Duration(offset) * time.Second),
+			})
+			assert.Nil(err)
+		}
+		root := uuid.NewString()
+		replyA, replyB := uuid.NewString(), uuid.NewString()
+		nested := uuid.NewString()
+		create(root, "", 0)
+		create(replyA, root, 1)
+		create(replyB, root, 2)
+		create(nested, replyA, 3)
+
+		tree, next, err := dir.GetCommentTree(ctx, "", comments.GetCommentTreeParams{
+			PostID:   postID,
+			Sort:     comments.SortOldest,
+			MaxDepth: 2,
+			PageSize: 1,
+		})
+		assert.Nil(err)
+		assert.NotEmpty(next)
+		assert.Len(tree, 1)
+		assert.Equal(root, tree[0].Comment.Id)
+		assert.Equal(int32(2), tree[0].RepliesCount)
+		assert.Len(tree[0].Replies, 1)
+		assert.Equal(replyA, tree[0].Replies[0].Comment.Id)
+		assert.NotEmpty(tree[0].RepliesNextCursor)
+		// The nested reply is beyond the max depth
+		assert.Equal(int32(1), tree[0].Replies[0].RepliesCount)
+		assert.Empty(tree[0].Replies[0].Replies)
+
+		// Load more replies of the root comment
+		cursor, err := pagination.Decode(tree[0].RepliesNextCursor)
+		assert.Nil(err)
+		more, _, err := dir.GetCommentTree(ctx, "", comments.GetCommentTreeParams{
+			PostID:   postID,
+			ParentID: root,
+			Sort:     comments.SortOldest,
+			MaxDepth: 1,
+			PageSize: 1,
+			Cursor:   cursor,
+		})
+		assert.Nil(err)
+		assert.Len(more, 1)
+		assert.Equal(replyB, more[0].Comment.Id)
+	})
+}
+
 func createTestComment(ctx context.Context, t *testing.T, dir *comments.Directory) *v1API.Comment {
 	assert := assert.New(t)
 	audio := &v1API.Audio{
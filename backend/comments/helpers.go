@@ -1,11 +1,11 @@
 package comments
 
 import (
-	"github.com/DagDigg/unpaper/backend/helpers"
 	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
 	"github.com/DagDigg/unpaper/backend/pkg/dbentities"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // pgCommentToPB converts a postgres comment to protobuf
@@ -23,6 +23,10 @@ func pgCommentToPB(params pgCommentToPBParams) (*v1API.Comment, error) {
 	if err != nil {
 		return nil, err
 	}
+	mentions, err := dbentities.MentionsRawJSONToPB(params.c.Mentions)
+	if err != nil {
+		return nil, err
+	}
 	comment := &v1API.Comment{
 		Id:       params.c.ID,
 		Message:  params.c.Message.String,
@@ -36,13 +40,32 @@ func pgCommentToPB(params pgCommentToPBParams) (*v1API.Comment, error) {
 			TargetId:   params.c.ThreadTargetID.String,
 		},
 		HasAlreadyLiked: params.hasAlreadyLiked,
+		Mentions:        mentions,
+		CreatedAt:       timestamppb.New(params.c.CreatedAt),
+		Deleted:         params.c.DeletedAt.Valid,
+	}
+	if params.c.EditedAt.Valid {
+		comment.EditedAt = timestamppb.New(params.c.EditedAt.Time)
 	}
 
 	return comment, nil
 }
 
-func hasAlreadyLiked(userIDsWhoLikes []string, userID string) bool {
-	return helpers.StringSliceContains(userIDsWhoLikes, userID)
+// pgCommentsListToPB converts postgres comments to protobuf. liked is the set of comment ids liked by the user
+func pgCommentsListToPB(cmts []Comment, liked map[string]bool) ([]*v1API.Comment, error) {
+	res := []*v1API.Comment{}
+	for _, c := range cmts {
+		pbCmt, err := pgCommentToPB(pgCommentToPBParams{
+			c:               c,
+			hasAlreadyLiked: liked[c.ID],
+		})
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, pbCmt)
+	}
+
+	return res, nil
 }
 
 func pgThreadTypeToPB(t ThreadType) (v1API.ThreadType_Enum, error) {
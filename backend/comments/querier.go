@@ -7,11 +7,26 @@ import (
 )
 
 type Querier interface {
+	CountCommentsByPostIDs(ctx context.Context, postIds []string) ([]CountCommentsByPostIDsRow, error)
+	CountRepliesByParentIDs(ctx context.Context, parentIds []string) ([]CountRepliesByParentIDsRow, error)
 	CreateComment(ctx context.Context, arg CreateCommentParams) (Comment, error)
+	GetComment(ctx context.Context, id string) (Comment, error)
+	GetCommentLikers(ctx context.Context, arg GetCommentLikersParams) ([]GetCommentLikersRow, error)
 	GetComments(ctx context.Context, postID string) ([]Comment, error)
+	GetCommentsByIDs(ctx context.Context, ids []string) ([]Comment, error)
+	GetCommentsLevel(ctx context.Context, arg GetCommentsLevelParams) ([]GetCommentsLevelRow, error)
+	GetCommentsPage(ctx context.Context, arg GetCommentsPageParams) ([]GetCommentsPageRow, error)
+	GetLikedCommentIDs(ctx context.Context, arg GetLikedCommentIDsParams) ([]string, error)
+	GetRepliesByParentIDs(ctx context.Context, arg GetRepliesByParentIDsParams) ([]GetRepliesByParentIDsRow, error)
+	GetThreadSegments(ctx context.Context, arg GetThreadSegmentsParams) ([]Comment, error)
+	GetTopCommentsByPostIDs(ctx context.Context, arg GetTopCommentsByPostIDsParams) ([]Comment, error)
 	HasUserLikedComment(ctx context.Context, arg HasUserLikedCommentParams) (bool, error)
 	LikeComment(ctx context.Context, arg LikeCommentParams) (Comment, error)
+	RecordCommentViews(ctx context.Context, arg RecordCommentViewsParams) ([]string, error)
+	RefreshCommentScores(ctx context.Context, ids []string) error
 	RemoveLikeFromComment(ctx context.Context, arg RemoveLikeFromCommentParams) (Comment, error)
+	SoftDeleteComment(ctx context.Context, arg SoftDeleteCommentParams) (Comment, error)
+	UpdateCommentMessage(ctx context.Context, arg UpdateCommentMessageParams) (Comment, error)
 }
 
 var _ Querier = (*Queries)(nil)
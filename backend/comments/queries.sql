@@ -1,6 +1,11 @@
 -- name: CreateComment :one
-INSERT INTO comments (id, message, audio, author, parent_id, likes, post_id, thread_type, thread_target_id)
-VALUES ($1, $2, $3, $4, $5, 0, $6, $7, $8)
+WITH p AS (
+	UPDATE comments
+	SET replies_count = replies_count + 1
+	WHERE id = $5
+)
+INSERT INTO comments (id, message, audio, author, parent_id, likes, post_id, thread_type, thread_target_id, mentions, created_at)
+VALUES ($1, $2, $3, $4, $5, 0, $6, $7, $8, $9, $10)
 RETURNING *;
 
 -- name: GetComments :many
@@ -8,22 +13,197 @@ SELECT * FROM comments c WHERE c.post_id = $1 AND c.thread_type = 'post'
 UNION ALL
 (SELECT * FROM comments z WHERE z.post_id = $1 AND thread_type != 'post' ORDER BY likes DESC);
 
+-- name: GetCommentsPage :many
+SELECT c.*, (CASE sqlc.arg(sort)::VARCHAR(20)
+	WHEN 'newest' THEN EXTRACT(EPOCH FROM c.created_at)
+	WHEN 'top' THEN c.top_score
+	WHEN 'hot' THEN c.hot_score
+	WHEN 'controversial' THEN c.controversy_score
+	ELSE -EXTRACT(EPOCH FROM c.created_at)
+END)::FLOAT8 AS sort_score
+FROM comments c
+WHERE c.post_id = sqlc.arg(post_id)::VARCHAR(100) AND
+(NOT sqlc.arg(has_cursor)::BOOLEAN OR ((CASE sqlc.arg(sort)::VARCHAR(20)
+	WHEN 'newest' THEN EXTRACT(EPOCH FROM c.created_at)
+	WHEN 'top' THEN c.top_score
+	WHEN 'hot' THEN c.hot_score
+	WHEN 'controversial' THEN c.controversy_score
+	ELSE -EXTRACT(EPOCH FROM c.created_at)
+END)::FLOAT8, c.id) < (sqlc.arg(cursor_score)::FLOAT8, sqlc.arg(cursor_id)::VARCHAR(100)))
+ORDER BY sort_score DESC, c.id DESC
+LIMIT sqlc.arg(page_size)::INTEGER;
+
+-- name: CountCommentsByPostIDs :many
+SELECT post_id, COUNT(*) AS comments_count FROM comments
+WHERE post_id = ANY(sqlc.arg(post_ids)::VARCHAR(100)[])
+GROUP BY post_id;
+
+-- name: GetTopCommentsByPostIDs :many
+SELECT * FROM comments c
+WHERE c.post_id = ANY(sqlc.arg(post_ids)::VARCHAR(100)[]) AND c.id IN (
+	SELECT z.id FROM comments z
+	WHERE z.post_id = c.post_id AND z.deleted_at IS NULL
+	ORDER BY z.thread_type = 'post' DESC, z.likes DESC, z.created_at, z.id
+	LIMIT sqlc.arg(per_post)::INTEGER
+)
+ORDER BY c.post_id, c.thread_type = 'post' DESC, c.likes DESC, c.created_at, c.id;
+
 -- name: LikeComment :one
+WITH l AS (
+	INSERT INTO comment_likes (comment_id, user_id, created_at)
+	VALUES (sqlc.arg(id)::VARCHAR(100), sqlc.arg(user_id)::VARCHAR(100), sqlc.arg(created_at)::TIMESTAMPTZ)
+	ON CONFLICT (comment_id, user_id) DO NOTHING
+	RETURNING comment_id
+)
 UPDATE comments
-SET
-likes = likes + 1,
-user_ids_who_likes = array_append(user_ids_who_likes,sqlc.arg(user_id)::VARCHAR(100))
+SET likes = COALESCE(likes, 0) + (SELECT COUNT(*) FROM l)
 WHERE id = sqlc.arg(id)::VARCHAR(100)
 RETURNING *;
 
 -- name: RemoveLikeFromComment :one
+WITH l AS (
+	DELETE FROM comment_likes
+	WHERE comment_id = sqlc.arg(id)::VARCHAR(100) AND user_id = sqlc.arg(user_id)::VARCHAR(100)
+	RETURNING comment_id
+)
 UPDATE comments
-SET
-likes = likes - 1,
-user_ids_who_likes = array_remove(user_ids_who_likes,sqlc.arg(user_id)::VARCHAR(100))
+SET likes = COALESCE(likes, 0) - (SELECT COUNT(*) FROM l)
 WHERE id = sqlc.arg(id)::VARCHAR(100)
 RETURNING *;
 
-
 -- name: HasUserLikedComment :one
-SELECT EXISTS(SELECT 1 FROM comments WHERE id=$1 AND $2::VARCHAR(100)=ANY(user_ids_who_likes::VARCHAR(100)[]));
\ No newline at end of file
+SELECT EXISTS(SELECT 1 FROM comment_likes WHERE comment_id = sqlc.arg(comment_id)::VARCHAR(100) AND user_id = sqlc.arg(user_id)::VARCHAR(100));
+
+-- name: GetLikedCommentIDs :many
+SELECT comment_id FROM comment_likes
+WHERE comment_id = ANY(sqlc.arg(comment_ids)::VARCHAR(100)[]) AND user_id = sqlc.arg(user_id)::VARCHAR(100);
+
+-- name: GetCommentLikers :many
+SELECT l.user_id, u.username, l.created_at FROM comment_likes l
+JOIN users u ON u.id = l.user_id
+WHERE l.comment_id = sqlc.arg(comment_id)::VARCHAR(100)
+AND (NOT sqlc.arg(has_cursor)::BOOLEAN OR (l.created_at, l.user_id) < (sqlc.arg(cursor_created_at)::TIMESTAMPTZ, sqlc.arg(cursor_id)::VARCHAR(100)))
+ORDER BY l.created_at DESC, l.user_id DESC
+LIMIT sqlc.arg(page_size)::INTEGER;
+
+-- name: GetComment :one
+SELECT * FROM comments
+WHERE id = $1;
+
+-- name: GetCommentsByIDs :many
+SELECT * FROM comments
+WHERE id = ANY(sqlc.arg(ids)::VARCHAR(100)[]);
+
+-- name: UpdateCommentMessage :one
+WITH e AS (
+	INSERT INTO edits (id, target_type, target_id, editor_id, action, previous_message, created_at)
+	SELECT sqlc.arg(edit_id)::VARCHAR(100), 'comment', c.id, c.author, 'edit', c.message, sqlc.arg(edited_at)::TIMESTAMPTZ
+	FROM comments c
+	WHERE c.id = sqlc.arg(id)::VARCHAR(100) AND c.author = sqlc.arg(author)::VARCHAR(100) AND c.deleted_at IS NULL
+)
+UPDATE comments
+SET
+message = sqlc.arg(message)::TEXT,
+mentions = sqlc.arg(mentions)::JSON,
+edited_at = sqlc.arg(edited_at)::TIMESTAMPTZ
+WHERE id = sqlc.arg(id)::VARCHAR(100) AND author = sqlc.arg(author)::VARCHAR(100) AND deleted_at IS NULL
+RETURNING *;
+
+-- name: SoftDeleteComment :one
+WITH e AS (
+	INSERT INTO edits (id, target_type, target_id, editor_id, action, previous_message, created_at)
+	SELECT sqlc.arg(edit_id)::VARCHAR(100), 'comment', c.id, sqlc.arg(editor_id)::VARCHAR(100), 'delete', c.message, sqlc.arg(deleted_at)::TIMESTAMPTZ
+	FROM comments c
+	WHERE c.id = sqlc.arg(id)::VARCHAR(100) AND c.author = sqlc.arg(author)::VARCHAR(100) AND c.deleted_at IS NULL
+	RETURNING target_id
+), h AS (
+	DELETE FROM hashtag_uses
+	WHERE source_id IN (SELECT target_id FROM e)
+)
+UPDATE comments
+SET
+message = '[deleted]',
+audio = '{}',
+mentions = '[]',
+deleted_at = sqlc.arg(deleted_at)::TIMESTAMPTZ
+WHERE id = sqlc.arg(id)::VARCHAR(100) AND author = sqlc.arg(author)::VARCHAR(100) AND deleted_at IS NULL
+RETURNING *;
+
+-- name: GetCommentsLevel :many
+SELECT c.*, (CASE sqlc.arg(sort)::VARCHAR(20)
+	WHEN 'newest' THEN EXTRACT(EPOCH FROM c.created_at)
+	WHEN 'top' THEN c.top_score
+	WHEN 'hot' THEN c.hot_score
+	WHEN 'controversial' THEN c.controversy_score
+	ELSE -EXTRACT(EPOCH FROM c.created_at)
+END)::FLOAT8 AS sort_score
+FROM comments c
+WHERE c.post_id = sqlc.arg(post_id)::VARCHAR(100) AND
+(CASE WHEN sqlc.arg(parent_id)::VARCHAR(100) = '' THEN c.parent_id IS NULL ELSE c.parent_id = sqlc.arg(parent_id)::VARCHAR(100) END) AND
+(NOT sqlc.arg(has_cursor)::BOOLEAN OR ((CASE sqlc.arg(sort)::VARCHAR(20)
+	WHEN 'newest' THEN EXTRACT(EPOCH FROM c.created_at)
+	WHEN 'top' THEN c.top_score
+	WHEN 'hot' THEN c.hot_score
+	WHEN 'controversial' THEN c.controversy_score
+	ELSE -EXTRACT(EPOCH FROM c.created_at)
+END)::FLOAT8, c.id) < (sqlc.arg(cursor_score)::FLOAT8, sqlc.arg(cursor_id)::VARCHAR(100)))
+ORDER BY sort_score DESC, c.id DESC
+LIMIT sqlc.arg(page_size)::INTEGER;
+
+-- name: GetRepliesByParentIDs :many
+SELECT c.*, (CASE sqlc.arg(sort)::VARCHAR(20)
+	WHEN 'newest' THEN EXTRACT(EPOCH FROM c.created_at)
+	WHEN 'top' THEN c.top_score
+	WHEN 'hot' THEN c.hot_score
+	WHEN 'controversial' THEN c.controversy_score
+	ELSE -EXTRACT(EPOCH FROM c.created_at)
+END)::FLOAT8 AS sort_score
+FROM comments c
+WHERE c.parent_id = ANY(sqlc.arg(parent_ids)::VARCHAR(100)[]) AND c.id IN (
+	SELECT z.id FROM comments z
+	WHERE z.parent_id = c.parent_id
+	ORDER BY (CASE sqlc.arg(sort)::VARCHAR(20)
+		WHEN 'newest' THEN EXTRACT(EPOCH FROM z.created_at)
+		WHEN 'top' THEN z.top_score
+		WHEN 'hot' THEN z.hot_score
+		WHEN 'controversial' THEN z.controversy_score
+		ELSE -EXTRACT(EPOCH FROM z.created_at)
+	END)::FLOAT8 DESC, z.id DESC
+	LIMIT sqlc.arg(per_parent)::INTEGER
+)
+ORDER BY c.parent_id, sort_score DESC, c.id DESC;
+
+-- name: CountRepliesByParentIDs :many
+SELECT parent_id::VARCHAR(100) AS parent_id, COUNT(*) AS replies_count FROM comments
+WHERE parent_id = ANY(sqlc.arg(parent_ids)::VARCHAR(100)[])
+GROUP BY parent_id;
+
+-- name: RecordCommentViews :many
+WITH v AS (
+	INSERT INTO comment_views (comment_id, user_id, created_at)
+	SELECT c.id, sqlc.arg(user_id)::VARCHAR(100), sqlc.arg(created_at)::TIMESTAMPTZ FROM comments c
+	WHERE c.id = ANY(sqlc.arg(ids)::VARCHAR(100)[]) AND c.author != sqlc.arg(user_id)::VARCHAR(100)
+	ON CONFLICT (comment_id, user_id) DO NOTHING
+	RETURNING comment_id
+)
+UPDATE comments
+SET views = views + 1
+WHERE id IN (SELECT comment_id FROM v)
+RETURNING id;
+
+-- name: RefreshCommentScores :exec
+UPDATE comments
+SET
+top_score = (CASE WHEN GREATEST(views, COALESCE(likes, 0)) = 0 THEN 0 ELSE (
+	COALESCE(likes, 0)::FLOAT8 / GREATEST(views, COALESCE(likes, 0)) + 1.9208 / GREATEST(views, COALESCE(likes, 0)) -
+	1.96 * SQRT(COALESCE(likes, 0)::FLOAT8 * (GREATEST(views, COALESCE(likes, 0)) - COALESCE(likes, 0)) / POWER(GREATEST(views, COALESCE(likes, 0)), 3) + 0.9604 / POWER(GREATEST(views, COALESCE(likes, 0)), 2))
+) / (1 + 3.8416 / GREATEST(views, COALESCE(likes, 0))) END),
+hot_score = LOG(COALESCE(likes, 0) + 1) + EXTRACT(EPOCH FROM created_at) / 45000,
+controversy_score = (CASE WHEN COALESCE(likes, 0) = 0 OR replies_count = 0 THEN 0
+	ELSE POWER(COALESCE(likes, 0) + replies_count, LEAST(COALESCE(likes, 0), replies_count)::FLOAT8 / GREATEST(COALESCE(likes, 0), replies_count)) END)
+WHERE id = ANY(sqlc.arg(ids)::VARCHAR(100)[]);
+
+-- name: GetThreadSegments :many
+SELECT * FROM comments
+WHERE post_id = sqlc.arg(post_id)::VARCHAR(100) AND author = sqlc.arg(author)::VARCHAR(100) AND thread_type = 'post'
+ORDER BY created_at, id;
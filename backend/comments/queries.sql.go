@@ -7,14 +7,88 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"time"
 
 	"github.com/lib/pq"
 )
 
+const countCommentsByPostIDs = `-- name: CountCommentsByPostIDs :many
+SELECT post_id, COUNT(*) AS comments_count FROM comments
+WHERE post_id = ANY($1::VARCHAR(100)[])
+GROUP BY post_id
+`
+
+type CountCommentsByPostIDsRow struct {
+	PostID        string
+	CommentsCount int64
+}
+
+func (q *Queries) CountCommentsByPostIDs(ctx context.Context, postIds []string) ([]CountCommentsByPostIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, countCommentsByPostIDs, pq.Array(postIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountCommentsByPostIDsRow
+	for rows.Next() {
+		var i CountCommentsByPostIDsRow
+		if err := rows.Scan(&i.PostID, &i.CommentsCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countRepliesByParentIDs = `-- name: CountRepliesByParentIDs :many
+SELECT parent_id::VARCHAR(100) AS parent_id, COUNT(*) AS replies_count FROM comments
+WHERE parent_id = ANY($1::VARCHAR(100)[])
+GROUP BY parent_id
+`
+
+type CountRepliesByParentIDsRow struct {
+	ParentID     string
+	RepliesCount int64
+}
+
+func (q *Queries) CountRepliesByParentIDs(ctx context.Context, parentIds []string) ([]CountRepliesByParentIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, countRepliesByParentIDs, pq.Array(parentIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountRepliesByParentIDsRow
+	for rows.Next() {
+		var i CountRepliesByParentIDsRow
+		if err := rows.Scan(&i.ParentID, &i.RepliesCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createComment = `-- name: CreateComment :one
-INSERT INTO comments (id, message, audio, author, parent_id, likes, post_id, thread_type, thread_target_id)
-VALUES ($1, $2, $3, $4, $5, 0, $6, $7, $8)
-RETURNING likes, audio, author, parent_id, post_id, thread_type, id, thread_target_id, message, user_ids_who_likes
+WITH p AS (
+	UPDATE comments
+	SET replies_count = replies_count + 1
+	WHERE id = $5
+)
+INSERT INTO comments (id, message, audio, author, parent_id, likes, post_id, thread_type, thread_target_id, mentions, created_at)
+VALUES ($1, $2, $3, $4, $5, 0, $6, $7, $8, $9, $10)
+RETURNING likes, audio, author, parent_id, post_id, thread_type, id, thread_target_id, message, user_ids_who_likes, mentions, created_at, edited_at, deleted_at, views, replies_count, top_score, hot_score, controversy_score
 `
 
 type CreateCommentParams struct {
@@ -26,6 +100,8 @@ type CreateCommentParams struct {
 	PostID         string
 	ThreadType     string
 	ThreadTargetID sql.NullString
+	Mentions       json.RawMessage
+	CreatedAt      time.Time
 }
 
 func (q *Queries) CreateComment(ctx context.Context, arg CreateCommentParams) (Comment, error) {
@@ -38,6 +114,8 @@ func (q *Queries) CreateComment(ctx context.Context, arg CreateCommentParams) (C
 		arg.PostID,
 		arg.ThreadType,
 		arg.ThreadTargetID,
+		arg.Mentions,
+		arg.CreatedAt,
 	)
 	var i Comment
 	err := row.Scan(
@@ -51,14 +129,107 @@ func (q *Queries) CreateComment(ctx context.Context, arg CreateCommentParams) (C
 		&i.ThreadTargetID,
 		&i.Message,
 		pq.Array(&i.UserIdsWhoLikes),
+		&i.Mentions,
+		&i.CreatedAt,
+		&i.EditedAt,
+		&i.DeletedAt,
+		&i.Views,
+		&i.RepliesCount,
+		&i.TopScore,
+		&i.HotScore,
+		&i.ControversyScore,
 	)
 	return i, err
 }
 
+const getComment = `-- name: GetComment :one
+SELECT likes, audio, author, parent_id, post_id, thread_type, id, thread_target_id, message, user_ids_who_likes, mentions, created_at, edited_at, deleted_at, views, replies_count, top_score, hot_score, controversy_score FROM comments
+WHERE id = $1
+`
+
+func (q *Queries) GetComment(ctx context.Context, id string) (Comment, error) {
+	row := q.db.QueryRowContext(ctx, getComment, id)
+	var i Comment
+	err := row.Scan(
+		&i.Likes,
+		&i.Audio,
+		&i.Author,
+		&i.ParentID,
+		&i.PostID,
+		&i.ThreadType,
+		&i.ID,
+		&i.ThreadTargetID,
+		&i.Message,
+		pq.Array(&i.UserIdsWhoLikes),
+		&i.Mentions,
+		&i.CreatedAt,
+		&i.EditedAt,
+		&i.DeletedAt,
+		&i.Views,
+		&i.RepliesCount,
+		&i.TopScore,
+		&i.HotScore,
+		&i.ControversyScore,
+	)
+	return i, err
+}
+
+const getCommentLikers = `-- name: GetCommentLikers :many
+SELECT l.user_id, u.username, l.created_at FROM comment_likes l
+JOIN users u ON u.id = l.user_id
+WHERE l.comment_id = $1::VARCHAR(100)
+AND (NOT $2::BOOLEAN OR (l.created_at, l.user_id) < ($3::TIMESTAMPTZ, $4::VARCHAR(100)))
+ORDER BY l.created_at DESC, l.user_id DESC
+LIMIT $5::INTEGER
+`
+
+type GetCommentLikersParams struct {
+	CommentID       string
+	HasCursor       bool
+	CursorCreatedAt time.Time
+	CursorID        string
+	PageSize        int32
+}
+
+type GetCommentLikersRow struct {
+	UserID    string
+	Username  sql.NullString
+	CreatedAt time.Time
+}
+
+func (q *Queries) GetCommentLikers(ctx context.Context, arg GetCommentLikersParams) ([]GetCommentLikersRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCommentLikers,
+		arg.CommentID,
+		arg.HasCursor,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCommentLikersRow
+	for rows.Next() {
+		var i GetCommentLikersRow
+		if err := rows.Scan(&i.UserID, &i.Username, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getComments = `-- name: GetComments :many
-SELECT likes, audio, author, parent_id, post_id, thread_type, id, thread_target_id, message, user_ids_who_likes FROM comments c WHERE c.post_id = $1 AND c.thread_type = 'post'
+SELECT likes, audio, author, parent_id, post_id, thread_type, id, thread_target_id, message, user_ids_who_likes, mentions, created_at, edited_at, deleted_at, views, replies_count, top_score, hot_score, controversy_score FROM comments c WHERE c.post_id = $1 AND c.thread_type = 'post'
 UNION ALL
-(SELECT likes, audio, author, parent_id, post_id, thread_type, id, thread_target_id, message, user_ids_who_likes FROM comments z WHERE z.post_id = $1 AND thread_type != 'post' ORDER BY likes DESC)
+(SELECT likes, audio, author, parent_id, post_id, thread_type, id, thread_target_id, message, user_ids_who_likes, mentions, created_at, edited_at, deleted_at, views, replies_count, top_score, hot_score, controversy_score FROM comments z WHERE z.post_id = $1 AND thread_type != 'post' ORDER BY likes DESC)
 `
 
 func (q *Queries) GetComments(ctx context.Context, postID string) ([]Comment, error) {
@@ -81,6 +252,517 @@ func (q *Queries) GetComments(ctx context.Context, postID string) ([]Comment, er
 			&i.ThreadTargetID,
 			&i.Message,
 			pq.Array(&i.UserIdsWhoLikes),
+			&i.Mentions,
+			&i.CreatedAt,
+			&i.EditedAt,
+			&i.DeletedAt,
+			&i.Views,
+			&i.RepliesCount,
+			&i.TopScore,
+			&i.HotScore,
+			&i.ControversyScore,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCommentsByIDs = `-- name: GetCommentsByIDs :many
+SELECT likes, audio, author, parent_id, post_id, thread_type, id, thread_target_id, message, user_ids_who_likes, mentions, created_at, edited_at, deleted_at, views, replies_count, top_score, hot_score, controversy_score FROM comments
+WHERE id = ANY($1::VARCHAR(100)[])
+`
+
+func (q *Queries) GetCommentsByIDs(ctx context.Context, ids []string) ([]Comment, error) {
+	rows, err := q.db.QueryContext(ctx, getCommentsByIDs, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Comment
+	for rows.Next() {
+		var i Comment
+		if err := rows.Scan(
+			&i.Likes,
+			&i.Audio,
+			&i.Author,
+			&i.ParentID,
+			&i.PostID,
+			&i.ThreadType,
+			&i.ID,
+			&i.ThreadTargetID,
+			&i.Message,
+			pq.Array(&i.UserIdsWhoLikes),
+			&i.Mentions,
+			&i.CreatedAt,
+			&i.EditedAt,
+			&i.DeletedAt,
+			&i.Views,
+			&i.RepliesCount,
+			&i.TopScore,
+			&i.HotScore,
+			&i.ControversyScore,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCommentsLevel = `-- name: GetCommentsLevel :many
+SELECT c.likes, c.audio, c.author, c.parent_id, c.post_id, c.thread_type, c.id, c.thread_target_id, c.message, c.user_ids_who_likes, c.mentions, c.created_at, c.edited_at, c.deleted_at, c.views, c.replies_count, c.top_score, c.hot_score, c.controversy_score, (CASE $1::VARCHAR(20)
+	WHEN 'newest' THEN EXTRACT(EPOCH FROM c.created_at)
+	WHEN 'top' THEN c.top_score
+	WHEN 'hot' THEN c.hot_score
+	WHEN 'controversial' THEN c.controversy_score
+	ELSE -EXTRACT(EPOCH FROM c.created_at)
+END)::FLOAT8 AS sort_score
+FROM comments c
+WHERE c.post_id = $2::VARCHAR(100) AND
+(CASE WHEN $3::VARCHAR(100) = '' THEN c.parent_id IS NULL ELSE c.parent_id = $3::VARCHAR(100) END) AND
+(NOT $4::BOOLEAN OR ((CASE $1::VARCHAR(20)
+	WHEN 'newest' THEN EXTRACT(EPOCH FROM c.created_at)
+	WHEN 'top' THEN c.top_score
+	WHEN 'hot' THEN c.hot_score
+	WHEN 'controversial' THEN c.controversy_score
+	ELSE -EXTRACT(EPOCH FROM c.created_at)
+END)::FLOAT8, c.id) < ($5::FLOAT8, $6::VARCHAR(100)))
+ORDER BY sort_score DESC, c.id DESC
+LIMIT $7::INTEGER
+`
+
+type GetCommentsLevelParams struct {
+	Sort        string
+	PostID      string
+	ParentID    string
+	HasCursor   bool
+	CursorScore float64
+	CursorID    string
+	PageSize    int32
+}
+
+type GetCommentsLevelRow struct {
+	Likes            sql.NullInt32
+	Audio            json.RawMessage
+	Author           string
+	ParentID         sql.NullString
+	PostID           string
+	ThreadType       string
+	ID               string
+	ThreadTargetID   sql.NullString
+	Message          sql.NullString
+	UserIdsWhoLikes  []string
+	Mentions         json.RawMessage
+	CreatedAt        time.Time
+	EditedAt         sql.NullTime
+	DeletedAt        sql.NullTime
+	Views            int32
+	RepliesCount     int32
+	TopScore         float64
+	HotScore         float64
+	ControversyScore float64
+	SortScore        float64
+}
+
+func (q *Queries) GetCommentsLevel(ctx context.Context, arg GetCommentsLevelParams) ([]GetCommentsLevelRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCommentsLevel,
+		arg.Sort,
+		arg.PostID,
+		arg.ParentID,
+		arg.HasCursor,
+		arg.CursorScore,
+		arg.CursorID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCommentsLevelRow
+	for rows.Next() {
+		var i GetCommentsLevelRow
+		if err := rows.Scan(
+			&i.Likes,
+			&i.Audio,
+			&i.Author,
+			&i.ParentID,
+			&i.PostID,
+			&i.ThreadType,
+			&i.ID,
+			&i.ThreadTargetID,
+			&i.Message,
+			pq.Array(&i.UserIdsWhoLikes),
+			&i.Mentions,
+			&i.CreatedAt,
+			&i.EditedAt,
+			&i.DeletedAt,
+			&i.Views,
+			&i.RepliesCount,
+			&i.TopScore,
+			&i.HotScore,
+			&i.ControversyScore,
+			&i.SortScore,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCommentsPage = `-- name: GetCommentsPage :many
+SELECT c.likes, c.audio, c.author, c.parent_id, c.post_id, c.thread_type, c.id, c.thread_target_id, c.message, c.user_ids_who_likes, c.mentions, c.created_at, c.edited_at, c.deleted_at, c.views, c.replies_count, c.top_score, c.hot_score, c.controversy_score, (CASE $1::VARCHAR(20)
+	WHEN 'newest' THEN EXTRACT(EPOCH FROM c.created_at)
+	WHEN 'top' THEN c.top_score
+	WHEN 'hot' THEN c.hot_score
+	WHEN 'controversial' THEN c.controversy_score
+	ELSE -EXTRACT(EPOCH FROM c.created_at)
+END)::FLOAT8 AS sort_score
+FROM comments c
+WHERE c.post_id = $2::VARCHAR(100) AND
+(NOT $3::BOOLEAN OR ((CASE $1::VARCHAR(20)
+	WHEN 'newest' THEN EXTRACT(EPOCH FROM c.created_at)
+	WHEN 'top' THEN c.top_score
+	WHEN 'hot' THEN c.hot_score
+	WHEN 'controversial' THEN c.controversy_score
+	ELSE -EXTRACT(EPOCH FROM c.created_at)
+END)::FLOAT8, c.id) < ($4::FLOAT8, $5::VARCHAR(100)))
+ORDER BY sort_score DESC, c.id DESC
+LIMIT $6::INTEGER
+`
+
+type GetCommentsPageParams struct {
+	Sort        string
+	PostID      string
+	HasCursor   bool
+	CursorScore float64
+	CursorID    string
+	PageSize    int32
+}
+
+type GetCommentsPageRow struct {
+	Likes            sql.NullInt32
+	Audio            json.RawMessage
+	Author           string
+	ParentID         sql.NullString
+	PostID           string
+	ThreadType       string
+	ID               string
+	ThreadTargetID   sql.NullString
+	Message          sql.NullString
+	UserIdsWhoLikes  []string
+	Mentions         json.RawMessage
+	CreatedAt        time.Time
+	EditedAt         sql.NullTime
+	DeletedAt        sql.NullTime
+	Views            int32
+	RepliesCount     int32
+	TopScore         float64
+	HotScore         float64
+	ControversyScore float64
+	SortScore        float64
+}
+
+func (q *Queries) GetCommentsPage(ctx context.Context, arg GetCommentsPageParams) ([]GetCommentsPageRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCommentsPage,
+		arg.Sort,
+		arg.PostID,
+		arg.HasCursor,
+		arg.CursorScore,
+		arg.CursorID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCommentsPageRow
+	for rows.Next() {
+		var i GetCommentsPageRow
+		if err := rows.Scan(
+			&i.Likes,
+			&i.Audio,
+			&i.Author,
+			&i.ParentID,
+			&i.PostID,
+			&i.ThreadType,
+			&i.ID,
+			&i.ThreadTargetID,
+			&i.Message,
+			pq.Array(&i.UserIdsWhoLikes),
+			&i.Mentions,
+			&i.CreatedAt,
+			&i.EditedAt,
+			&i.DeletedAt,
+			&i.Views,
+			&i.RepliesCount,
+			&i.TopScore,
+			&i.HotScore,
+			&i.ControversyScore,
+			&i.SortScore,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLikedCommentIDs = `-- name: GetLikedCommentIDs :many
+SELECT comment_id FROM comment_likes
+WHERE comment_id = ANY($1::VARCHAR(100)[]) AND user_id = $2::VARCHAR(100)
+`
+
+type GetLikedCommentIDsParams struct {
+	CommentIds []string
+	UserID     string
+}
+
+func (q *Queries) GetLikedCommentIDs(ctx context.Context, arg GetLikedCommentIDsParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getLikedCommentIDs, pq.Array(arg.CommentIds), arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var comment_id string
+		if err := rows.Scan(&comment_id); err != nil {
+			return nil, err
+		}
+		items = append(items, comment_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRepliesByParentIDs = `-- name: GetRepliesByParentIDs :many
+SELECT c.likes, c.audio, c.author, c.parent_id, c.post_id, c.thread_type, c.id, c.thread_target_id, c.message, c.user_ids_who_likes, c.mentions, c.created_at, c.edited_at, c.deleted_at, c.views, c.replies_count, c.top_score, c.hot_score, c.controversy_score, (CASE $1::VARCHAR(20)
+	WHEN 'newest' THEN EXTRACT(EPOCH FROM c.created_at)
+	WHEN 'top' THEN c.top_score
+	WHEN 'hot' THEN c.hot_score
+	WHEN 'controversial' THEN c.controversy_score
+	ELSE -EXTRACT(EPOCH FROM c.created_at)
+END)::FLOAT8 AS sort_score
+FROM comments c
+WHERE c.parent_id = ANY($2::VARCHAR(100)[]) AND c.id IN (
+	SELECT z.id FROM comments z
+	WHERE z.parent_id = c.parent_id
+	ORDER BY (CASE $1::VARCHAR(20)
+		WHEN 'newest' THEN EXTRACT(EPOCH FROM z.created_at)
+		WHEN 'top' THEN z.top_score
+		WHEN 'hot' THEN z.hot_score
+		WHEN 'controversial' THEN z.controversy_score
+		ELSE -EXTRACT(EPOCH FROM z.created_at)
+	END)::FLOAT8 DESC, z.id DESC
+	LIMIT $3::INTEGER
+)
+ORDER BY c.parent_id, sort_score DESC, c.id DESC
+`
+
+type GetRepliesByParentIDsParams struct {
+	Sort      string
+	ParentIds []string
+	PerParent int32
+}
+
+type GetRepliesByParentIDsRow struct {
+	Likes            sql.NullInt32
+	Audio            json.RawMessage
+	Author           string
+	ParentID         sql.NullString
+	PostID           string
+	ThreadType       string
+	ID               string
+	ThreadTargetID   sql.NullString
+	Message          sql.NullString
+	UserIdsWhoLikes  []string
+	Mentions         json.RawMessage
+	CreatedAt        time.Time
+	EditedAt         sql.NullTime
+	DeletedAt        sql.NullTime
+	Views            int32
+	RepliesCount     int32
+	TopScore         float64
+	HotScore         float64
+	ControversyScore float64
+	SortScore        float64
+}
+
+func (q *Queries) GetRepliesByParentIDs(ctx context.Context, arg GetRepliesByParentIDsParams) ([]GetRepliesByParentIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getRepliesByParentIDs, arg.Sort, pq.Array(arg.ParentIds), arg.PerParent)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRepliesByParentIDsRow
+	for rows.Next() {
+		var i GetRepliesByParentIDsRow
+		if err := rows.Scan(
+			&i.Likes,
+			&i.Audio,
+			&i.Author,
+			&i.ParentID,
+			&i.PostID,
+			&i.ThreadType,
+			&i.ID,
+			&i.ThreadTargetID,
+			&i.Message,
+			pq.Array(&i.UserIdsWhoLikes),
+			&i.Mentions,
+			&i.CreatedAt,
+			&i.EditedAt,
+			&i.DeletedAt,
+			&i.Views,
+			&i.RepliesCount,
+			&i.TopScore,
+			&i.HotScore,
+			&i.ControversyScore,
+			&i.SortScore,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getThreadSegments = `-- name: GetThreadSegments :many
+SELECT likes, audio, author, parent_id, post_id, thread_type, id, thread_target_id, message, user_ids_who_likes, mentions, created_at, edited_at, deleted_at, views, replies_count, top_score, hot_score, controversy_score FROM comments
+WHERE post_id = $1::VARCHAR(100) AND author = $2::VARCHAR(100) AND thread_type = 'post'
+ORDER BY created_at, id
+`
+
+type GetThreadSegmentsParams struct {
+	PostID string
+	Author string
+}
+
+func (q *Queries) GetThreadSegments(ctx context.Context, arg GetThreadSegmentsParams) ([]Comment, error) {
+	rows, err := q.db.QueryContext(ctx, getThreadSegments, arg.PostID, arg.Author)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Comment
+	for rows.Next() {
+		var i Comment
+		if err := rows.Scan(
+			&i.Likes,
+			&i.Audio,
+			&i.Author,
+			&i.ParentID,
+			&i.PostID,
+			&i.ThreadType,
+			&i.ID,
+			&i.ThreadTargetID,
+			&i.Message,
+			pq.Array(&i.UserIdsWhoLikes),
+			&i.Mentions,
+			&i.CreatedAt,
+			&i.EditedAt,
+			&i.DeletedAt,
+			&i.Views,
+			&i.RepliesCount,
+			&i.TopScore,
+			&i.HotScore,
+			&i.ControversyScore,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTopCommentsByPostIDs = `-- name: GetTopCommentsByPostIDs :many
+SELECT likes, audio, author, parent_id, post_id, thread_type, id, thread_target_id, message, user_ids_who_likes, mentions, created_at, edited_at, deleted_at, views, replies_count, top_score, hot_score, controversy_score FROM comments c
+WHERE c.post_id = ANY($1::VARCHAR(100)[]) AND c.id IN (
+	SELECT z.id FROM comments z
+	WHERE z.post_id = c.post_id AND z.deleted_at IS NULL
+	ORDER BY z.thread_type = 'post' DESC, z.likes DESC, z.created_at, z.id
+	LIMIT $2::INTEGER
+)
+ORDER BY c.post_id, c.thread_type = 'post' DESC, c.likes DESC, c.created_at, c.id
+`
+
+type GetTopCommentsByPostIDsParams struct {
+	PostIds []string
+	PerPost int32
+}
+
+func (q *Queries) GetTopCommentsByPostIDs(ctx context.Context, arg GetTopCommentsByPostIDsParams) ([]Comment, error) {
+	rows, err := q.db.QueryContext(ctx, getTopCommentsByPostIDs, pq.Array(arg.PostIds), arg.PerPost)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Comment
+	for rows.Next() {
+		var i Comment
+		if err := rows.Scan(
+			&i.Likes,
+			&i.Audio,
+			&i.Author,
+			&i.ParentID,
+			&i.PostID,
+			&i.ThreadType,
+			&i.ID,
+			&i.ThreadTargetID,
+			&i.Message,
+			pq.Array(&i.UserIdsWhoLikes),
+			&i.Mentions,
+			&i.CreatedAt,
+			&i.EditedAt,
+			&i.DeletedAt,
+			&i.Views,
+			&i.RepliesCount,
+			&i.TopScore,
+			&i.HotScore,
+			&i.ControversyScore,
 		); err != nil {
 			return nil, err
 		}
@@ -96,37 +778,42 @@ func (q *Queries) GetComments(ctx context.Context, postID string) ([]Comment, er
 }
 
 const hasUserLikedComment = `-- name: HasUserLikedComment :one
-SELECT EXISTS(SELECT 1 FROM comments WHERE id=$1 AND $2::VARCHAR(100)=ANY(user_ids_who_likes::VARCHAR(100)[]))
+SELECT EXISTS(SELECT 1 FROM comment_likes WHERE comment_id = $1::VARCHAR(100) AND user_id = $2::VARCHAR(100))
 `
 
 type HasUserLikedCommentParams struct {
-	ID      string
-	Column2 string
+	CommentID string
+	UserID    string
 }
 
 func (q *Queries) HasUserLikedComment(ctx context.Context, arg HasUserLikedCommentParams) (bool, error) {
-	row := q.db.QueryRowContext(ctx, hasUserLikedComment, arg.ID, arg.Column2)
+	row := q.db.QueryRowContext(ctx, hasUserLikedComment, arg.CommentID, arg.UserID)
 	var exists bool
 	err := row.Scan(&exists)
 	return exists, err
 }
 
 const likeComment = `-- name: LikeComment :one
+WITH l AS (
+	INSERT INTO comment_likes (comment_id, user_id, created_at)
+	VALUES ($1::VARCHAR(100), $2::VARCHAR(100), $3::TIMESTAMPTZ)
+	ON CONFLICT (comment_id, user_id) DO NOTHING
+	RETURNING comment_id
+)
 UPDATE comments
-SET
-likes = likes + 1,
-user_ids_who_likes = array_append(user_ids_who_likes,$1::VARCHAR(100))
-WHERE id = $2::VARCHAR(100)
-RETURNING likes, audio, author, parent_id, post_id, thread_type, id, thread_target_id, message, user_ids_who_likes
+SET likes = COALESCE(likes, 0) + (SELECT COUNT(*) FROM l)
+WHERE id = $1::VARCHAR(100)
+RETURNING likes, audio, author, parent_id, post_id, thread_type, id, thread_target_id, message, user_ids_who_likes, mentions, created_at, edited_at, deleted_at, views, replies_count, top_score, hot_score, controversy_score
 `
 
 type LikeCommentParams struct {
-	UserID string
-	ID     string
+	ID        string
+	UserID    string
+	CreatedAt time.Time
 }
 
 func (q *Queries) LikeComment(ctx context.Context, arg LikeCommentParams) (Comment, error) {
-	row := q.db.QueryRowContext(ctx, likeComment, arg.UserID, arg.ID)
+	row := q.db.QueryRowContext(ctx, likeComment, arg.ID, arg.UserID, arg.CreatedAt)
 	var i Comment
 	err := row.Scan(
 		&i.Likes,
@@ -139,26 +826,220 @@ func (q *Queries) LikeComment(ctx context.Context, arg LikeCommentParams) (Comme
 		&i.ThreadTargetID,
 		&i.Message,
 		pq.Array(&i.UserIdsWhoLikes),
+		&i.Mentions,
+		&i.CreatedAt,
+		&i.EditedAt,
+		&i.DeletedAt,
+		&i.Views,
+		&i.RepliesCount,
+		&i.TopScore,
+		&i.HotScore,
+		&i.ControversyScore,
 	)
 	return i, err
 }
 
-const removeLikeFromComment = `-- name: RemoveLikeFromComment :one
+const recordCommentViews = `-- name: RecordCommentViews :many
+WITH v AS (
+	INSERT INTO comment_views (comment_id, user_id, created_at)
+	SELECT c.id, $1::VARCHAR(100), $2::TIMESTAMPTZ FROM comments c
+	WHERE c.id = ANY($3::VARCHAR(100)[]) AND c.author != $1::VARCHAR(100)
+	ON CONFLICT (comment_id, user_id) DO NOTHING
+	RETURNING comment_id
+)
+UPDATE comments
+SET views = views + 1
+WHERE id IN (SELECT comment_id FROM v)
+RETURNING id
+`
+
+type RecordCommentViewsParams struct {
+	UserID    string
+	CreatedAt time.Time
+	Ids       []string
+}
+
+func (q *Queries) RecordCommentViews(ctx context.Context, arg RecordCommentViewsParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, recordCommentViews, arg.UserID, arg.CreatedAt, pq.Array(arg.Ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const refreshCommentScores = `-- name: RefreshCommentScores :exec
 UPDATE comments
 SET
-likes = likes - 1,
-user_ids_who_likes = array_remove(user_ids_who_likes,$1::VARCHAR(100))
-WHERE id = $2::VARCHAR(100)
-RETURNING likes, audio, author, parent_id, post_id, thread_type, id, thread_target_id, message, user_ids_who_likes
+top_score = (CASE WHEN GREATEST(views, COALESCE(likes, 0)) = 0 THEN 0 ELSE (
+	COALESCE(likes, 0)::FLOAT8 / GREATEST(views, COALESCE(likes, 0)) + 1.9208 / GREATEST(views, COALESCE(likes, 0)) -
+	1.96 * SQRT(COALESCE(likes, 0)::FLOAT8 * (GREATEST(views, COALESCE(likes, 0)) - COALESCE(likes, 0)) / POWER(GREATEST(views, COALESCE(likes, 0)), 3) + 0.9604 / POWER(GREATEST(views, COALESCE(likes, 0)), 2))
+) / (1 + 3.8416 / GREATEST(views, COALESCE(likes, 0))) END),
+hot_score = LOG(COALESCE(likes, 0) + 1) + EXTRACT(EPOCH FROM created_at) / 45000,
+controversy_score = (CASE WHEN COALESCE(likes, 0) = 0 OR replies_count = 0 THEN 0
+	ELSE POWER(COALESCE(likes, 0) + replies_count, LEAST(COALESCE(likes, 0), replies_count)::FLOAT8 / GREATEST(COALESCE(likes, 0), replies_count)) END)
+WHERE id = ANY($1::VARCHAR(100)[])
+`
+
+func (q *Queries) RefreshCommentScores(ctx context.Context, ids []string) error {
+	_, err := q.db.ExecContext(ctx, refreshCommentScores, pq.Array(ids))
+	return err
+}
+
+const removeLikeFromComment = `-- name: RemoveLikeFromComment :one
+WITH l AS (
+	DELETE FROM comment_likes
+	WHERE comment_id = $1::VARCHAR(100) AND user_id = $2::VARCHAR(100)
+	RETURNING comment_id
+)
+UPDATE comments
+SET likes = COALESCE(likes, 0) - (SELECT COUNT(*) FROM l)
+WHERE id = $1::VARCHAR(100)
+RETURNING likes, audio, author, parent_id, post_id, thread_type, id, thread_target_id, message, user_ids_who_likes, mentions, created_at, edited_at, deleted_at, views, replies_count, top_score, hot_score, controversy_score
 `
 
 type RemoveLikeFromCommentParams struct {
-	UserID string
 	ID     string
+	UserID string
 }
 
 func (q *Queries) RemoveLikeFromComment(ctx context.Context, arg RemoveLikeFromCommentParams) (Comment, error) {
-	row := q.db.QueryRowContext(ctx, removeLikeFromComment, arg.UserID, arg.ID)
+	row := q.db.QueryRowContext(ctx, removeLikeFromComment, arg.ID, arg.UserID)
+	var i Comment
+	err := row.Scan(
+		&i.Likes,
+		&i.Audio,
+		&i.Author,
+		&i.ParentID,
+		&i.PostID,
+		&i.ThreadType,
+		&i.ID,
+		&i.ThreadTargetID,
+		&i.Message,
+		pq.Array(&i.UserIdsWhoLikes),
+		&i.Mentions,
+		&i.CreatedAt,
+		&i.EditedAt,
+		&i.DeletedAt,
+		&i.Views,
+		&i.RepliesCount,
+		&i.TopScore,
+		&i.HotScore,
+		&i.ControversyScore,
+	)
+	return i, err
+}
+
+const softDeleteComment = `-- name: SoftDeleteComment :one
+WITH e AS (
+	INSERT INTO edits (id, target_type, target_id, editor_id, action, previous_message, created_at)
+	SELECT $4::VARCHAR(100), 'comment', c.id, $5::VARCHAR(100), 'delete', c.message, $1::TIMESTAMPTZ
+	FROM comments c
+	WHERE c.id = $2::VARCHAR(100) AND c.author = $3::VARCHAR(100) AND c.deleted_at IS NULL
+	RETURNING target_id
+), h AS (
+	DELETE FROM hashtag_uses
+	WHERE source_id IN (SELECT target_id FROM e)
+)
+UPDATE comments
+SET
+message = '[deleted]',
+audio = '{}',
+mentions = '[]',
+deleted_at = $1::TIMESTAMPTZ
+WHERE id = $2::VARCHAR(100) AND author = $3::VARCHAR(100) AND deleted_at IS NULL
+RETURNING likes, audio, author, parent_id, post_id, thread_type, id, thread_target_id, message, user_ids_who_likes, mentions, created_at, edited_at, deleted_at, views, replies_count, top_score, hot_score, controversy_score
+`
+
+type SoftDeleteCommentParams struct {
+	DeletedAt time.Time
+	ID        string
+	Author    string
+	EditID    string
+	EditorID  string
+}
+
+func (q *Queries) SoftDeleteComment(ctx context.Context, arg SoftDeleteCommentParams) (Comment, error) {
+	row := q.db.QueryRowContext(ctx, softDeleteComment,
+		arg.DeletedAt,
+		arg.ID,
+		arg.Author,
+		arg.EditID,
+		arg.EditorID,
+	)
+	var i Comment
+	err := row.Scan(
+		&i.Likes,
+		&i.Audio,
+		&i.Author,
+		&i.ParentID,
+		&i.PostID,
+		&i.ThreadType,
+		&i.ID,
+		&i.ThreadTargetID,
+		&i.Message,
+		pq.Array(&i.UserIdsWhoLikes),
+		&i.Mentions,
+		&i.CreatedAt,
+		&i.EditedAt,
+		&i.DeletedAt,
+		&i.Views,
+		&i.RepliesCount,
+		&i.TopScore,
+		&i.HotScore,
+		&i.ControversyScore,
+	)
+	return i, err
+}
+
+const updateCommentMessage = `-- name: UpdateCommentMessage :one
+WITH e AS (
+	INSERT INTO edits (id, target_type, target_id, editor_id, action, previous_message, created_at)
+	SELECT $6::VARCHAR(100), 'comment', c.id, c.author, 'edit', c.message, $3::TIMESTAMPTZ
+	FROM comments c
+	WHERE c.id = $4::VARCHAR(100) AND c.author = $5::VARCHAR(100) AND c.deleted_at IS NULL
+)
+UPDATE comments
+SET
+message = $1::TEXT,
+mentions = $2::JSON,
+edited_at = $3::TIMESTAMPTZ
+WHERE id = $4::VARCHAR(100) AND author = $5::VARCHAR(100) AND deleted_at IS NULL
+RETURNING likes, audio, author, parent_id, post_id, thread_type, id, thread_target_id, message, user_ids_who_likes, mentions, created_at, edited_at, deleted_at, views, replies_count, top_score, hot_score, controversy_score
+`
+
+type UpdateCommentMessageParams struct {
+	Message  string
+	Mentions json.RawMessage
+	EditedAt time.Time
+	ID       string
+	Author   string
+	EditID   string
+}
+
+func (q *Queries) UpdateCommentMessage(ctx context.Context, arg UpdateCommentMessageParams) (Comment, error) {
+	row := q.db.QueryRowContext(ctx, updateCommentMessage,
+		arg.Message,
+		arg.Mentions,
+		arg.EditedAt,
+		arg.ID,
+		arg.Author,
+		arg.EditID,
+	)
 	var i Comment
 	err := row.Scan(
 		&i.Likes,
@@ -171,6 +1052,15 @@ func (q *Queries) RemoveLikeFromComment(ctx context.Context, arg RemoveLikeFromC
 		&i.ThreadTargetID,
 		&i.Message,
 		pq.Array(&i.UserIdsWhoLikes),
+		&i.Mentions,
+		&i.CreatedAt,
+		&i.EditedAt,
+		&i.DeletedAt,
+		&i.Views,
+		&i.RepliesCount,
+		&i.TopScore,
+		&i.HotScore,
+		&i.ControversyScore,
 	)
 	return i, err
 }
@@ -0,0 +1,62 @@
+package comments
+
+import (
+	"context"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+)
+
+// GetThreadParams are the parameters for retrieving the thread of a post
+type GetThreadParams struct {
+	PostID string
+	// Author is the post author, the only one who can continue the thread
+	Author string
+	// RepliesSort is the order of the replies to every segment
+	RepliesSort Sort
+	// RepliesPerSegment is the maximum number of replies returned for every segment
+	RepliesPerSegment int32
+}
+
+// GetThread returns the segments continuing the post, written by its author, from the oldest.
+// Every segment comes with its first direct replies, and the cursor for loading the remaining ones with GetCommentTree
+func (d *Directory) GetThread(ctx context.Context, userID string, params GetThreadParams) ([]*v1API.CommentNode, error) {
+	res, err := d.querier.GetThreadSegments(ctx, GetThreadSegmentsParams{
+		PostID: params.PostID,
+		Author: params.Author,
+	})
+	if err != nil {
+		return nil, err
+	}
+	cmts, err := d.commentsToPB(ctx, userID, res)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := []*v1API.CommentNode{}
+	level := map[string]*v1API.CommentNode{}
+	ids := []string{}
+	for _, c := range cmts {
+		node := &v1API.CommentNode{
+			Comment: c,
+			Replies: []*v1API.CommentNode{},
+		}
+		segments = append(segments, node)
+		level[c.Id] = node
+		ids = append(ids, c.Id)
+	}
+	if len(ids) == 0 {
+		return segments, nil
+	}
+
+	parentIDs, err := d.countReplies(ctx, level, ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(parentIDs) > 0 {
+		if _, _, err := d.addReplies(ctx, userID, level, parentIDs, params.RepliesSort, params.RepliesPerSegment); err != nil {
+			return nil, err
+		}
+	}
+
+	return segments, nil
+}
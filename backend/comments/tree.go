@@ -0,0 +1,212 @@
+package comments
+
+import (
+	"context"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/pagination"
+)
+
+// Sort refers to the order of the comments on each level of a tree, or of a page of comments
+type Sort string
+
+const (
+	// SortOldest orders comments from the oldest
+	SortOldest Sort = "oldest"
+	// SortNewest orders comments from the newest
+	SortNewest Sort = "newest"
+	// SortTop orders comments by the lower bound of the Wilson score interval of likes over views,
+	// so that a few likes of a rarely seen comment do not outrank a widely liked one
+	SortTop Sort = "top"
+	// SortHot orders comments by likes decayed over time: ten times the likes make up for 12.5 hours of age
+	SortHot Sort = "hot"
+	// SortControversial orders comments having both many likes and many replies, in a similar number
+	SortControversial Sort = "controversial"
+)
+
+// GetCommentTreeParams are the parameters for retrieving a comments tree
+type GetCommentTreeParams struct {
+	PostID string
+	// ParentID is the comment whose replies are the first level of the tree.
+	// The first level is made of top level comments when empty
+	ParentID string
+	Sort     Sort
+	// MaxDepth is the number of levels returned
+	MaxDepth int32
+	// PageSize is the number of comments of the first level,
+	// and the maximum number of replies returned for every comment
+	PageSize int32
+	Cursor   *pagination.Cursor
+}
+
+// GetCommentTree returns a page of comments along with their nested replies, up to MaxDepth levels.
+// Every level is retrieved with a fixed number of queries, regardless of the number of comments.
+// The next page cursor of the first level is returned along with the tree
+func (d *Directory) GetCommentTree(ctx context.Context, userID string, params GetCommentTreeParams) ([]*v1API.CommentNode, string, error) {
+	levelParams := GetCommentsLevelParams{
+		Sort:     string(params.Sort),
+		PostID:   params.PostID,
+		ParentID: params.ParentID,
+		PageSize: params.PageSize,
+	}
+	if params.Cursor != nil {
+		levelParams.HasCursor = true
+		levelParams.CursorScore = params.Cursor.Score
+		levelParams.CursorID = params.Cursor.ID
+	}
+	rows, err := d.querier.GetCommentsLevel(ctx, levelParams)
+	if err != nil {
+		return nil, "", err
+	}
+
+	liked, err := d.GetLikedCommentIDs(ctx, userID, scoredRowsIDs(rows))
+	if err != nil {
+		return nil, "", err
+	}
+
+	roots := []*v1API.CommentNode{}
+	level := map[string]*v1API.CommentNode{}
+	levelIDs := []string{}
+	for _, r := range rows {
+		node, err := scoredRowToNode(r, liked)
+		if err != nil {
+			return nil, "", err
+		}
+		roots = append(roots, node)
+		level[r.ID] = node
+		levelIDs = append(levelIDs, r.ID)
+	}
+	nextCursor := ""
+	if len(rows) > 0 {
+		nextCursor = pagination.NextCursor(len(rows), params.PageSize, scoredRowCursor(rows[len(rows)-1]))
+	}
+
+	for depth := int32(1); len(levelIDs) > 0; depth++ {
+		parentIDs, err := d.countReplies(ctx, level, levelIDs)
+		if err != nil {
+			return nil, "", err
+		}
+		if depth >= params.MaxDepth || len(parentIDs) == 0 {
+			break
+		}
+		level, levelIDs, err = d.addReplies(ctx, userID, level, parentIDs, params.Sort, params.PageSize)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	return roots, nextCursor, nil
+}
+
+// countReplies sets the number of direct replies of the level nodes, returning the ids of the ones having replies
+func (d *Directory) countReplies(ctx context.Context, level map[string]*v1API.CommentNode, ids []string) ([]string, error) {
+	counts, err := d.querier.CountRepliesByParentIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	parentIDs := []string{}
+	for _, c := range counts {
+		level[c.ParentID].RepliesCount = int32(c.RepliesCount)
+		parentIDs = append(parentIDs, c.ParentID)
+	}
+
+	return parentIDs, nil
+}
+
+// addReplies appends at most `perParent` replies to every parent node, setting the cursor of the remaining ones.
+// The replies are returned keyed by id, along with their ids, as the next level of the tree
+func (d *Directory) addReplies(ctx context.Context, userID string, level map[string]*v1API.CommentNode, parentIDs []string, sort Sort, perParent int32) (map[string]*v1API.CommentNode, []string, error) {
+	replyRows, err := d.querier.GetRepliesByParentIDs(ctx, GetRepliesByParentIDsParams{
+		Sort:      string(sort),
+		ParentIds: parentIDs,
+		PerParent: perParent,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	replyIDs := []string{}
+	for _, rr := range replyRows {
+		replyIDs = append(replyIDs, rr.ID)
+	}
+	liked, err := d.GetLikedCommentIDs(ctx, userID, replyIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nextLevel := map[string]*v1API.CommentNode{}
+	nextLevelIDs := []string{}
+	lastReply := map[string]GetCommentsLevelRow{}
+	for _, rr := range replyRows {
+		r := GetCommentsLevelRow(rr)
+		node, err := scoredRowToNode(r, liked)
+		if err != nil {
+			return nil, nil, err
+		}
+		parent := level[r.ParentID.String]
+		parent.Replies = append(parent.Replies, node)
+		lastReply[r.ParentID.String] = r
+		nextLevel[r.ID] = node
+		nextLevelIDs = append(nextLevelIDs, r.ID)
+	}
+	for parentID, r := range lastReply {
+		parent := level[parentID]
+		if parent.RepliesCount > int32(len(parent.Replies)) {
+			parent.RepliesNextCursor = scoredRowCursor(r).Encode()
+		}
+	}
+
+	return nextLevel, nextLevelIDs, nil
+}
+
+func scoredRowToNode(r GetCommentsLevelRow, liked map[string]bool) (*v1API.CommentNode, error) {
+	c, err := pgCommentToPB(pgCommentToPBParams{
+		c:               scoredRowToComment(r),
+		hasAlreadyLiked: liked[r.ID],
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1API.CommentNode{
+		Comment: c,
+		Replies: []*v1API.CommentNode{},
+	}, nil
+}
+
+func scoredRowsIDs(rows []GetCommentsLevelRow) []string {
+	ids := []string{}
+	for _, r := range rows {
+		ids = append(ids, r.ID)
+	}
+
+	return ids
+}
+
+func scoredRowCursor(r GetCommentsLevelRow) pagination.Cursor {
+	return pagination.Cursor{CreatedAt: r.CreatedAt, Score: r.SortScore, ID: r.ID}
+}
+
+func scoredRowToComment(r GetCommentsLevelRow) Comment {
+	return Comment{
+		Likes:            r.Likes,
+		Audio:            r.Audio,
+		Author:           r.Author,
+		ParentID:         r.ParentID,
+		PostID:           r.PostID,
+		ThreadType:       r.ThreadType,
+		ID:               r.ID,
+		ThreadTargetID:   r.ThreadTargetID,
+		Message:          r.Message,
+		UserIdsWhoLikes:  r.UserIdsWhoLikes,
+		Mentions:         r.Mentions,
+		CreatedAt:        r.CreatedAt,
+		EditedAt:         r.EditedAt,
+		DeletedAt:        r.DeletedAt,
+		Views:            r.Views,
+		RepliesCount:     r.RepliesCount,
+		TopScore:         r.TopScore,
+		HotScore:         r.HotScore,
+		ControversyScore: r.ControversyScore,
+	}
+}
@@ -451,6 +451,29 @@ func (d Directory) GetUserIDsSubscribedToRoom(ctx context.Context, roomID string
 	return res, nil
 }
 
+// GetRoomSubscriptionsForUserIDByRoomIDs returns the subscriptions of the user to the rooms along with the user id of the room owners
+func (d Directory) GetRoomSubscriptionsForUserIDByRoomIDs(ctx context.Context, userID string, roomIDs []string) ([]GetRoomSubscriptionsForUserIDByRoomIDsRow, error) {
+	if len(roomIDs) == 0 {
+		return []GetRoomSubscriptionsForUserIDByRoomIDsRow{}, nil
+	}
+
+	return d.querier.GetRoomSubscriptionsForUserIDByRoomIDs(ctx, GetRoomSubscriptionsForUserIDByRoomIDsParams{
+		UserID:  userID,
+		RoomIds: roomIDs,
+	})
+}
+
+// GetRoomOwnerIDs returns the ids of the users whose connected account receives the room subscriptions payments.
+// Rooms nobody has subscribed to yet have no known owner
+func (d Directory) GetRoomOwnerIDs(ctx context.Context, roomID string) ([]string, error) {
+	return d.querier.GetRoomOwnerIDs(ctx, roomID)
+}
+
+// GrantsAccess reports whether a subscription in the status gives access to the room content
+func (s SubscriptionStatus) GrantsAccess() bool {
+	return s == SubscriptionStatusActive || s == SubscriptionStatusTrialing
+}
+
 func getPBPriceByCustomerID(ctx context.Context, q Querier, customerID string) (*v1API.Price, error) {
 	if customerID == "" {
 		return nil, ErrMissingCustomerID
@@ -24,8 +24,10 @@ type Querier interface {
 	GetDefaultPMByUserID(ctx context.Context, userID string) (StripeDefaultPaymentMethod, error)
 	GetPriceByCustomerID(ctx context.Context, customerID string) (StripePrice, error)
 	GetPriceByUserID(ctx context.Context, userID string) (StripePrice, error)
+	GetRoomOwnerIDs(ctx context.Context, roomID string) ([]string, error)
 	GetRoomSubscriptionForUserID(ctx context.Context, arg GetRoomSubscriptionForUserIDParams) (RoomSubscription, error)
 	GetRoomSubscriptionsForUserID(ctx context.Context, userID string) ([]RoomSubscription, error)
+	GetRoomSubscriptionsForUserIDByRoomIDs(ctx context.Context, arg GetRoomSubscriptionsForUserIDByRoomIDsParams) ([]GetRoomSubscriptionsForUserIDByRoomIDsRow, error)
 	GetSubscription(ctx context.Context, id string) (StripeSubscription, error)
 	GetSubscriptionByCustomerID(ctx context.Context, customerID string) (StripeSubscription, error)
 	GetSubscriptionByUserID(ctx context.Context, userID string) (StripeSubscription, error)
@@ -168,4 +168,14 @@ SELECT EXISTS(SELECT 1 FROM connected_accounts WHERE account_id = $1);
 UPDATE connected_accounts
 SET can_receive_payments = $1
 WHERE account_id = $2
-RETURNING *;
\ No newline at end of file
+RETURNING *;
+
+-- name: GetRoomSubscriptionsForUserIDByRoomIDs :many
+SELECT rs.room_id, rs.status, ca.user_id AS owner_user_id FROM room_subscriptions rs
+JOIN connected_accounts ca ON ca.account_id = rs.account_id
+WHERE rs.user_id = sqlc.arg(user_id)::VARCHAR(100) AND rs.room_id = ANY(sqlc.arg(room_ids)::VARCHAR[]);
+
+-- name: GetRoomOwnerIDs :many
+SELECT DISTINCT ca.user_id FROM room_subscriptions rs
+JOIN connected_accounts ca ON ca.account_id = rs.account_id
+WHERE rs.room_id = $1;
@@ -8,6 +8,8 @@ import (
 	"database/sql"
 	"encoding/json"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 const clearCustomerPaymentMethods = `-- name: ClearCustomerPaymentMethods :exec
@@ -304,6 +306,35 @@ func (q *Queries) GetPriceByUserID(ctx context.Context, userID string) (StripePr
 	return i, err
 }
 
+const getRoomOwnerIDs = `-- name: GetRoomOwnerIDs :many
+SELECT DISTINCT ca.user_id FROM room_subscriptions rs
+JOIN connected_accounts ca ON ca.account_id = rs.account_id
+WHERE rs.room_id = $1
+`
+
+func (q *Queries) GetRoomOwnerIDs(ctx context.Context, roomID string) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getRoomOwnerIDs, roomID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var user_id string
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getRoomSubscriptionForUserID = `-- name: GetRoomSubscriptionForUserID :one
 SELECT latest_invoice, current_period_end, customer_id, connected_customer_id, account_id, id, status, room_id, room_subscription_type, user_id FROM room_subscriptions
 WHERE room_id = $1 AND user_id = $2
@@ -371,6 +402,46 @@ func (q *Queries) GetRoomSubscriptionsForUserID(ctx context.Context, userID stri
 	return items, nil
 }
 
+const getRoomSubscriptionsForUserIDByRoomIDs = `-- name: GetRoomSubscriptionsForUserIDByRoomIDs :many
+SELECT rs.room_id, rs.status, ca.user_id AS owner_user_id FROM room_subscriptions rs
+JOIN connected_accounts ca ON ca.account_id = rs.account_id
+WHERE rs.user_id = $1::VARCHAR(100) AND rs.room_id = ANY($2::VARCHAR[])
+`
+
+type GetRoomSubscriptionsForUserIDByRoomIDsParams struct {
+	UserID  string
+	RoomIds []string
+}
+
+type GetRoomSubscriptionsForUserIDByRoomIDsRow struct {
+	RoomID      string
+	Status      string
+	OwnerUserID string
+}
+
+func (q *Queries) GetRoomSubscriptionsForUserIDByRoomIDs(ctx context.Context, arg GetRoomSubscriptionsForUserIDByRoomIDsParams) ([]GetRoomSubscriptionsForUserIDByRoomIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getRoomSubscriptionsForUserIDByRoomIDs, arg.UserID, pq.Array(arg.RoomIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRoomSubscriptionsForUserIDByRoomIDsRow
+	for rows.Next() {
+		var i GetRoomSubscriptionsForUserIDByRoomIDsRow
+		if err := rows.Scan(&i.RoomID, &i.Status, &i.OwnerUserID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getSubscription = `-- name: GetSubscription :one
 SELECT current_period_end, latest_invoice, id, user_id, customer_id, status from stripe_subscriptions
 WHERE id = $1
@@ -0,0 +1,69 @@
+package edits
+
+import (
+	"context"
+	"database/sql"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+)
+
+// Directory is the directory which operates on db table 'edits'
+type Directory struct {
+	// querier is an interface containing all of the
+	// directory methods. Must be created with edits.NewDirectory(db)
+	querier Querier
+	db      *sql.DB
+}
+
+// NewDirectory creates a new edits directory
+func NewDirectory(db *sql.DB) *Directory {
+	return &Directory{db: db, querier: New(db)}
+}
+
+// Close closes Directory database connection
+func (d Directory) Close() error {
+	return d.db.Close()
+}
+
+// TargetType refers to the kind of content which has been edited
+type TargetType string
+
+const (
+	// TargetTypePost refers to edits of posts
+	TargetTypePost TargetType = "post"
+	// TargetTypeComment refers to edits of comments
+	TargetTypeComment TargetType = "comment"
+)
+
+// Action refers to the kind of change made to the content
+type Action string
+
+const (
+	// ActionEdit is a change of the content message
+	ActionEdit Action = "edit"
+	// ActionDelete is a soft delete of the content
+	ActionDelete Action = "delete"
+)
+
+// GetEdits returns the edit history of the target, from the latest edit.
+// Entries are inserted by the posts and comments update and delete queries
+func (d Directory) GetEdits(ctx context.Context, targetType TargetType, targetID string) ([]*v1API.Edit, error) {
+	res, err := d.querier.GetEdits(ctx, GetEditsParams{
+		TargetType: string(targetType),
+		TargetID:   targetID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	edits := []*v1API.Edit{}
+	for _, e := range res {
+		pbEdit, err := pgEditToPB(e)
+		if err != nil {
+			return nil, err
+		}
+		edits = append(edits, pbEdit)
+	}
+
+	return edits, nil
+}
@@ -0,0 +1,64 @@
+package edits
+
+import (
+	"fmt"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func pgEditToPB(e Edit) (*v1API.Edit, error) {
+	targetType, err := PGTargetTypeToPB(TargetType(e.TargetType))
+	if err != nil {
+		return nil, err
+	}
+	action, err := pgActionToPB(Action(e.Action))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1API.Edit{
+		Id:              e.ID,
+		TargetType:      targetType,
+		TargetId:        e.TargetID,
+		EditorId:        e.EditorID,
+		Action:          action,
+		PreviousMessage: e.PreviousMessage.String,
+		CreatedAt:       timestamppb.New(e.CreatedAt),
+	}, nil
+}
+
+// PGTargetTypeToPB converts a postgres edit target type to protobuf
+func PGTargetTypeToPB(t TargetType) (v1API.EditTarget_Enum, error) {
+	switch t {
+	case TargetTypePost:
+		return v1API.EditTarget_POST, nil
+	case TargetTypeComment:
+		return v1API.EditTarget_COMMENT, nil
+	default:
+		return 0, fmt.Errorf("invalid edit target type: %q", t)
+	}
+}
+
+// PBTargetTypeToPG converts a protobuf edit target type to postgres
+func PBTargetTypeToPG(t v1API.EditTarget_Enum) (TargetType, error) {
+	switch t {
+	case v1API.EditTarget_POST:
+		return TargetTypePost, nil
+	case v1API.EditTarget_COMMENT:
+		return TargetTypeComment, nil
+	default:
+		return "", fmt.Errorf("invalid edit target type: %v", t)
+	}
+}
+
+func pgActionToPB(a Action) (v1API.EditAction_Enum, error) {
+	switch a {
+	case ActionEdit:
+		return v1API.EditAction_EDIT, nil
+	case ActionDelete:
+		return v1API.EditAction_DELETE, nil
+	default:
+		return 0, fmt.Errorf("invalid edit action: %q", a)
+	}
+}
@@ -0,0 +1,4 @@
+-- name: GetEdits :many
+SELECT * FROM edits
+WHERE target_type = $1 AND target_id = $2
+ORDER BY created_at DESC;
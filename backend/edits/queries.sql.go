@@ -0,0 +1,50 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: queries.sql
+
+package edits
+
+import (
+	"context"
+)
+
+const getEdits = `-- name: GetEdits :many
+SELECT id, target_type, target_id, editor_id, action, previous_message, created_at FROM edits
+WHERE target_type = $1 AND target_id = $2
+ORDER BY created_at DESC
+`
+
+type GetEditsParams struct {
+	TargetType string
+	TargetID   string
+}
+
+func (q *Queries) GetEdits(ctx context.Context, arg GetEditsParams) ([]Edit, error) {
+	rows, err := q.db.QueryContext(ctx, getEdits, arg.TargetType, arg.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Edit
+	for rows.Next() {
+		var i Edit
+		if err := rows.Scan(
+			&i.ID,
+			&i.TargetType,
+			&i.TargetID,
+			&i.EditorID,
+			&i.Action,
+			&i.PreviousMessage,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -81,3 +81,16 @@ func (d Directory) GetFollowingCount(ctx context.Context, userID string) (int64,
 func (d Directory) GetFollowersCount(ctx context.Context, userID string) (int64, error) {
 	return d.querier.GetFollowersCount(ctx, userID)
 }
+
+// GetFollowerIDs returns the ids of the users following the passed param userID
+func (d Directory) GetFollowerIDs(ctx context.Context, userID string) ([]string, error) {
+	return d.querier.GetFollowerIDs(ctx, userID)
+}
+
+// GetLargeFollowingUserIDs returns the ids of the users followed by userID which have at least minFollowers followers
+func (d Directory) GetLargeFollowingUserIDs(ctx context.Context, userID string, minFollowers int64) ([]string, error) {
+	return d.querier.GetLargeFollowingUserIDs(ctx, GetLargeFollowingUserIDsParams{
+		FollowerUserID: userID,
+		MinFollowers:   minFollowers,
+	})
+}
@@ -8,10 +8,12 @@ import (
 
 type Querier interface {
 	FollowUser(ctx context.Context, arg FollowUserParams) (FollowUserRow, error)
+	GetFollowerIDs(ctx context.Context, followingUserID string) ([]string, error)
 	GetFollowers(ctx context.Context, followingUserID string) ([]GetFollowersRow, error)
 	GetFollowersCount(ctx context.Context, followingUserID string) (int64, error)
 	GetFollowing(ctx context.Context, followerUserID string) ([]GetFollowingRow, error)
 	GetFollowingCount(ctx context.Context, followerUserID string) (int64, error)
+	GetLargeFollowingUserIDs(ctx context.Context, arg GetLargeFollowingUserIDsParams) ([]string, error)
 	IsFollowingUser(ctx context.Context, arg IsFollowingUserParams) (bool, error)
 	UnfollowUser(ctx context.Context, arg UnfollowUserParams) (UnfollowUserRow, error)
 }
@@ -24,23 +24,38 @@ RETURNING *;
 SELECT f.*, u.* FROM follows f
 JOIN users u ON f.following_user_id = u.id
 WHERE follower_user_id=$1
-AND follow_date > unfollow_date OR unfollow_date IS NULL;
+AND (follow_date > unfollow_date OR unfollow_date IS NULL);
 
 -- name: GetFollowers :many
 SELECT f.*, u.* FROM follows f
 JOIN users u ON f.follower_user_id = u.id
 WHERE following_user_id=$1
-AND follow_date > unfollow_date OR unfollow_date IS NULL;
+AND (follow_date > unfollow_date OR unfollow_date IS NULL);
 
 -- name: GetFollowersCount :one
 SELECT COUNT(*) FROM follows 
 WHERE following_user_id=$1
-AND follow_date > unfollow_date  OR unfollow_date IS NULL;
+AND (follow_date > unfollow_date OR unfollow_date IS NULL);
 
 -- name: GetFollowingCount :one
 SELECT COUNT(*) FROM follows 
 WHERE follower_user_id=$1
-AND follow_date > unfollow_date  OR unfollow_date IS NULL;
+AND (follow_date > unfollow_date OR unfollow_date IS NULL);
 
 -- name: IsFollowingUser :one
-SELECT EXISTS(SELECT * FROM follows WHERE follower_user_id=$1 AND following_user_id=$2 AND (follow_date > unfollow_date OR unfollow_date IS NULL));
\ No newline at end of file
+SELECT EXISTS(SELECT * FROM follows WHERE follower_user_id=$1 AND following_user_id=$2 AND (follow_date > unfollow_date OR unfollow_date IS NULL));
+
+-- name: GetFollowerIDs :many
+SELECT follower_user_id FROM follows
+WHERE following_user_id=$1
+AND (follow_date > unfollow_date OR unfollow_date IS NULL);
+
+-- name: GetLargeFollowingUserIDs :many
+SELECT f.following_user_id FROM follows f
+WHERE f.follower_user_id = sqlc.arg(follower_user_id)::VARCHAR(100)
+AND (f.follow_date > f.unfollow_date OR f.unfollow_date IS NULL)
+AND (
+	SELECT COUNT(*) FROM follows z
+	WHERE z.following_user_id = f.following_user_id
+	AND (z.follow_date > z.unfollow_date OR z.unfollow_date IS NULL)
+) >= sqlc.arg(min_followers)::BIGINT;
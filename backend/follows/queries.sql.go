@@ -17,7 +17,7 @@ WITH f AS (
 	DO UPDATE SET follow_date = EXCLUDED.follow_date
 	RETURNING follower_user_id, following_user_id, follow_date, unfollow_date
 )
-SELECT f.follower_user_id, f.following_user_id, f.follow_date, f.unfollow_date, u.email_verified, u.password_changed_at, u.email, u.password, u.id, u.family_name, u.type, u.given_name, u.username
+SELECT f.follower_user_id, f.following_user_id, f.follow_date, f.unfollow_date, u.email_verified, u.password_changed_at, u.email, u.password, u.id, u.family_name, u.type, u.given_name, u.username, u.message_requests_policy, u.is_moderator, u.suspended_until
 FROM f
 JOIN users u ON f.following_user_id = u.id
 `
@@ -29,19 +29,22 @@ type FollowUserParams struct {
 }
 
 type FollowUserRow struct {
-	FollowerUserID    string
-	FollowingUserID   string
-	FollowDate        time.Time
-	UnfollowDate      sql.NullTime
-	EmailVerified     sql.NullBool
-	PasswordChangedAt sql.NullTime
-	Email             string
-	Password          sql.NullString
-	ID                string
-	FamilyName        sql.NullString
-	Type              string
-	GivenName         sql.NullString
-	Username          sql.NullString
+	FollowerUserID        string
+	FollowingUserID       string
+	FollowDate            time.Time
+	UnfollowDate          sql.NullTime
+	EmailVerified         sql.NullBool
+	PasswordChangedAt     sql.NullTime
+	Email                 string
+	Password              sql.NullString
+	ID                    string
+	FamilyName            sql.NullString
+	Type                  string
+	GivenName             sql.NullString
+	Username              sql.NullString
+	MessageRequestsPolicy string
+	IsModerator           bool
+	SuspendedUntil        sql.NullTime
 }
 
 func (q *Queries) FollowUser(ctx context.Context, arg FollowUserParams) (FollowUserRow, error) {
@@ -61,31 +64,66 @@ func (q *Queries) FollowUser(ctx context.Context, arg FollowUserParams) (FollowU
 		&i.Type,
 		&i.GivenName,
 		&i.Username,
+		&i.MessageRequestsPolicy,
+		&i.IsModerator,
+		&i.SuspendedUntil,
 	)
 	return i, err
 }
 
+const getFollowerIDs = `-- name: GetFollowerIDs :many
+SELECT follower_user_id FROM follows
+WHERE following_user_id=$1
+AND (follow_date > unfollow_date OR unfollow_date IS NULL)
+`
+
+func (q *Queries) GetFollowerIDs(ctx context.Context, followingUserID string) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getFollowerIDs, followingUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var follower_user_id string
+		if err := rows.Scan(&follower_user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, follower_user_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getFollowers = `-- name: GetFollowers :many
-SELECT f.follower_user_id, f.following_user_id, f.follow_date, f.unfollow_date, u.email_verified, u.password_changed_at, u.email, u.password, u.id, u.family_name, u.type, u.given_name, u.username FROM follows f
+SELECT f.follower_user_id, f.following_user_id, f.follow_date, f.unfollow_date, u.email_verified, u.password_changed_at, u.email, u.password, u.id, u.family_name, u.type, u.given_name, u.username, u.message_requests_policy, u.is_moderator, u.suspended_until FROM follows f
 JOIN users u ON f.follower_user_id = u.id
 WHERE following_user_id=$1
-AND follow_date > unfollow_date OR unfollow_date IS NULL
+AND (follow_date > unfollow_date OR unfollow_date IS NULL)
 `
 
 type GetFollowersRow struct {
-	FollowerUserID    string
-	FollowingUserID   string
-	FollowDate        time.Time
-	UnfollowDate      sql.NullTime
-	EmailVerified     sql.NullBool
-	PasswordChangedAt sql.NullTime
-	Email             string
-	Password          sql.NullString
-	ID                string
-	FamilyName        sql.NullString
-	Type              string
-	GivenName         sql.NullString
-	Username          sql.NullString
+	FollowerUserID        string
+	FollowingUserID       string
+	FollowDate            time.Time
+	UnfollowDate          sql.NullTime
+	EmailVerified         sql.NullBool
+	PasswordChangedAt     sql.NullTime
+	Email                 string
+	Password              sql.NullString
+	ID                    string
+	FamilyName            sql.NullString
+	Type                  string
+	GivenName             sql.NullString
+	Username              sql.NullString
+	MessageRequestsPolicy string
+	IsModerator           bool
+	SuspendedUntil        sql.NullTime
 }
 
 func (q *Queries) GetFollowers(ctx context.Context, followingUserID string) ([]GetFollowersRow, error) {
@@ -111,6 +149,9 @@ func (q *Queries) GetFollowers(ctx context.Context, followingUserID string) ([]G
 			&i.Type,
 			&i.GivenName,
 			&i.Username,
+			&i.MessageRequestsPolicy,
+			&i.IsModerator,
+			&i.SuspendedUntil,
 		); err != nil {
 			return nil, err
 		}
@@ -128,7 +169,7 @@ func (q *Queries) GetFollowers(ctx context.Context, followingUserID string) ([]G
 const getFollowersCount = `-- name: GetFollowersCount :one
 SELECT COUNT(*) FROM follows 
 WHERE following_user_id=$1
-AND follow_date > unfollow_date  OR unfollow_date IS NULL
+AND (follow_date > unfollow_date OR unfollow_date IS NULL)
 `
 
 func (q *Queries) GetFollowersCount(ctx context.Context, followingUserID string) (int64, error) {
@@ -139,26 +180,29 @@ func (q *Queries) GetFollowersCount(ctx context.Context, followingUserID string)
 }
 
 const getFollowing = `-- name: GetFollowing :many
-SELECT f.follower_user_id, f.following_user_id, f.follow_date, f.unfollow_date, u.email_verified, u.password_changed_at, u.email, u.password, u.id, u.family_name, u.type, u.given_name, u.username FROM follows f
+SELECT f.follower_user_id, f.following_user_id, f.follow_date, f.unfollow_date, u.email_verified, u.password_changed_at, u.email, u.password, u.id, u.family_name, u.type, u.given_name, u.username, u.message_requests_policy, u.is_moderator, u.suspended_until FROM follows f
 JOIN users u ON f.following_user_id = u.id
 WHERE follower_user_id=$1
-AND follow_date > unfollow_date OR unfollow_date IS NULL
+AND (follow_date > unfollow_date OR unfollow_date IS NULL)
 `
 
 type GetFollowingRow struct {
-	FollowerUserID    string
-	FollowingUserID   string
-	FollowDate        time.Time
-	UnfollowDate      sql.NullTime
-	EmailVerified     sql.NullBool
-	PasswordChangedAt sql.NullTime
-	Email             string
-	Password          sql.NullString
-	ID                string
-	FamilyName        sql.NullString
-	Type              string
-	GivenName         sql.NullString
-	Username          sql.NullString
+	FollowerUserID        string
+	FollowingUserID       string
+	FollowDate            time.Time
+	UnfollowDate          sql.NullTime
+	EmailVerified         sql.NullBool
+	PasswordChangedAt     sql.NullTime
+	Email                 string
+	Password              sql.NullString
+	ID                    string
+	FamilyName            sql.NullString
+	Type                  string
+	GivenName             sql.NullString
+	Username              sql.NullString
+	MessageRequestsPolicy string
+	IsModerator           bool
+	SuspendedUntil        sql.NullTime
 }
 
 func (q *Queries) GetFollowing(ctx context.Context, followerUserID string) ([]GetFollowingRow, error) {
@@ -184,6 +228,9 @@ func (q *Queries) GetFollowing(ctx context.Context, followerUserID string) ([]Ge
 			&i.Type,
 			&i.GivenName,
 			&i.Username,
+			&i.MessageRequestsPolicy,
+			&i.IsModerator,
+			&i.SuspendedUntil,
 		); err != nil {
 			return nil, err
 		}
@@ -201,7 +248,7 @@ func (q *Queries) GetFollowing(ctx context.Context, followerUserID string) ([]Ge
 const getFollowingCount = `-- name: GetFollowingCount :one
 SELECT COUNT(*) FROM follows 
 WHERE follower_user_id=$1
-AND follow_date > unfollow_date  OR unfollow_date IS NULL
+AND (follow_date > unfollow_date OR unfollow_date IS NULL)
 `
 
 func (q *Queries) GetFollowingCount(ctx context.Context, followerUserID string) (int64, error) {
@@ -211,6 +258,45 @@ func (q *Queries) GetFollowingCount(ctx context.Context, followerUserID string)
 	return count, err
 }
 
+const getLargeFollowingUserIDs = `-- name: GetLargeFollowingUserIDs :many
+SELECT f.following_user_id FROM follows f
+WHERE f.follower_user_id = $1::VARCHAR(100)
+AND (f.follow_date > f.unfollow_date OR f.unfollow_date IS NULL)
+AND (
+	SELECT COUNT(*) FROM follows z
+	WHERE z.following_user_id = f.following_user_id
+	AND (z.follow_date > z.unfollow_date OR z.unfollow_date IS NULL)
+) >= $2::BIGINT
+`
+
+type GetLargeFollowingUserIDsParams struct {
+	FollowerUserID string
+	MinFollowers   int64
+}
+
+func (q *Queries) GetLargeFollowingUserIDs(ctx context.Context, arg GetLargeFollowingUserIDsParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getLargeFollowingUserIDs, arg.FollowerUserID, arg.MinFollowers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var following_user_id string
+		if err := rows.Scan(&following_user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, following_user_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const isFollowingUser = `-- name: IsFollowingUser :one
 SELECT EXISTS(SELECT follower_user_id, following_user_id, follow_date, unfollow_date FROM follows WHERE follower_user_id=$1 AND following_user_id=$2 AND (follow_date > unfollow_date OR unfollow_date IS NULL))
 `
@@ -235,7 +321,7 @@ WHERE
 following_user_id = u.id
 AND follower_user_id=$2
 AND following_user_id=$3
-RETURNING email_verified, password_changed_at, email, password, id, family_name, type, given_name, username, follower_user_id, following_user_id, follow_date, unfollow_date
+RETURNING email_verified, password_changed_at, email, password, id, family_name, type, given_name, username, message_requests_policy, is_moderator, suspended_until, follower_user_id, following_user_id, follow_date, unfollow_date
 `
 
 type UnfollowUserParams struct {
@@ -245,19 +331,22 @@ type UnfollowUserParams struct {
 }
 
 type UnfollowUserRow struct {
-	EmailVerified     sql.NullBool
-	PasswordChangedAt sql.NullTime
-	Email             string
-	Password          sql.NullString
-	ID                string
-	FamilyName        sql.NullString
-	Type              string
-	GivenName         sql.NullString
-	Username          sql.NullString
-	FollowerUserID    string
-	FollowingUserID   string
-	FollowDate        time.Time
-	UnfollowDate      sql.NullTime
+	EmailVerified         sql.NullBool
+	PasswordChangedAt     sql.NullTime
+	Email                 string
+	Password              sql.NullString
+	ID                    string
+	FamilyName            sql.NullString
+	Type                  string
+	GivenName             sql.NullString
+	Username              sql.NullString
+	MessageRequestsPolicy string
+	IsModerator           bool
+	SuspendedUntil        sql.NullTime
+	FollowerUserID        string
+	FollowingUserID       string
+	FollowDate            time.Time
+	UnfollowDate          sql.NullTime
 }
 
 func (q *Queries) UnfollowUser(ctx context.Context, arg UnfollowUserParams) (UnfollowUserRow, error) {
@@ -273,6 +362,9 @@ func (q *Queries) UnfollowUser(ctx context.Context, arg UnfollowUserParams) (Unf
 		&i.Type,
 		&i.GivenName,
 		&i.Username,
+		&i.MessageRequestsPolicy,
+		&i.IsModerator,
+		&i.SuspendedUntil,
 		&i.FollowerUserID,
 		&i.FollowingUserID,
 		&i.FollowDate,
@@ -0,0 +1,128 @@
+// Package hashtags parses `#hashtag` tokens and tracks their usage in posts and comments
+package hashtags
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+)
+
+// MaxHashtagLength is the maximum length, in characters, of a hashtag
+const MaxHashtagLength = 50
+
+// Parse returns the normalized hashtags found in text, deduplicated and in order of appearance.
+// A hashtag must be at the beginning of the text or preceded by a character which cannot belong to it,
+// and must contain at least a letter, so that "#1" is not matched. Hashtags are lowercased
+func Parse(text string) []string {
+	res := []string{}
+	seen := map[string]bool{}
+	runes := []rune(text)
+
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '#' {
+			continue
+		}
+		if i > 0 && (isHashtagRune(runes[i-1]) || runes[i-1] == '#') {
+			continue
+		}
+
+		end := i + 1
+		hasLetter := false
+		for end < len(runes) && isHashtagRune(runes[end]) {
+			hasLetter = hasLetter || unicode.IsLetter(runes[end])
+			end++
+		}
+
+		name := strings.ToLower(string(runes[i+1 : end]))
+		i = end - 1
+		if !hasLetter || len([]rune(name)) > MaxHashtagLength || seen[name] {
+			continue
+		}
+		seen[name] = true
+		res = append(res, name)
+	}
+
+	return res
+}
+
+func isHashtagRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// PBTrendWindowToDuration converts a protobuf trending window to its duration
+func PBTrendWindowToDuration(w v1API.HashtagTrendWindow_Enum) (time.Duration, error) {
+	switch w {
+	case v1API.HashtagTrendWindow_DAY:
+		return 24 * time.Hour, nil
+	case v1API.HashtagTrendWindow_WEEK:
+		return 7 * 24 * time.Hour, nil
+	case v1API.HashtagTrendWindow_MONTH:
+		return 30 * 24 * time.Hour, nil
+	default:
+		return 0, fmt.Errorf("invalid trending window: %v", w)
+	}
+}
+
+// Directory is the directory which operates on db tables 'hashtags' and 'hashtag_uses'
+type Directory struct {
+	// querier is an interface containing all of the
+	// directory methods. Must be created with hashtags.NewDirectory(db)
+	querier Querier
+	db      *sql.DB
+}
+
+// NewDirectory creates a new hashtags directory
+func NewDirectory(db *sql.DB) *Directory {
+	return &Directory{db: db, querier: New(db)}
+}
+
+// Close closes Directory database connection
+func (d Directory) Close() error {
+	return d.db.Close()
+}
+
+// SetHashtags replaces the hashtags used by a post or comment, identified by sourceID, with names.
+// Hashtags still in use keep their original usage time, so that editing a message does not bump them
+func (d Directory) SetHashtags(ctx context.Context, postID, sourceID string, names []string) error {
+	if names == nil {
+		names = []string{}
+	}
+	return d.querier.SetHashtags(ctx, SetHashtagsParams{
+		Names:     names,
+		PostID:    postID,
+		SourceID:  sourceID,
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+// GetTrendingHashtags returns the hashtags most used in public posts and comments within window, from the hottest.
+// Every use is weighted with an exponential decay whose half-life is a quarter of the window,
+// so that recent uses count more than older ones
+func (d Directory) GetTrendingHashtags(ctx context.Context, window time.Duration, limit int32) ([]*v1API.Hashtag, error) {
+	now := time.Now().UTC()
+	res, err := d.querier.GetTrendingHashtags(ctx, GetTrendingHashtagsParams{
+		Now:             now,
+		HalfLifeSeconds: (window / 4).Seconds(),
+		Since:           now.Add(-window),
+		MaxResults:      limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hashtags := []*v1API.Hashtag{}
+	for _, r := range res {
+		hashtags = append(hashtags, &v1API.Hashtag{
+			Name:      r.Hashtag,
+			UsesCount: int32(r.UsesCount),
+			Score:     r.Score,
+		})
+	}
+
+	return hashtags, nil
+}
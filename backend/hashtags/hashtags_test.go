@@ -0,0 +1,33 @@
+package hashtags_test
+
+import (
+	"testing"
+
+	"github.com/DagDigg/unpaper/backend/hashtags"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []string
+	}{
+		{name: "empty text", in: "", want: []string{}},
+		{name: "single hashtag", in: "#jazz", want: []string{"jazz"}},
+		{name: "lowercased and deduplicated", in: "#Jazz and #jazz", want: []string{"jazz"}},
+		{name: "in order of appearance", in: "new #lofi beat #chill_vibes", want: []string{"lofi", "chill_vibes"}},
+		{name: "trailing punctuation", in: "so #good!", want: []string{"good"}},
+		{name: "numbers only", in: "we are #1", want: []string{}},
+		{name: "letters and numbers", in: "#2021hits", want: []string{"2021hits"}},
+		{name: "inside a word", in: "c#sharp", want: []string{}},
+		{name: "double hash", in: "##double", want: []string{}},
+		{name: "unicode letters", in: "#café", want: []string{"café"}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, hashtags.Parse(tt.in))
+		})
+	}
+}
@@ -0,0 +1,309 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package hashtags
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+type Block struct {
+	UserID        string
+	BlockedUserID string
+	CreatedAt     time.Time
+}
+
+type Bookmark struct {
+	UserID     string
+	PostID     string
+	Collection string
+	CreatedAt  time.Time
+}
+
+type Comment struct {
+	Likes            sql.NullInt32
+	Audio            json.RawMessage
+	Author           string
+	ParentID         sql.NullString
+	PostID           string
+	ThreadType       string
+	ID               string
+	ThreadTargetID   sql.NullString
+	Message          sql.NullString
+	UserIdsWhoLikes  []string
+	Mentions         json.RawMessage
+	CreatedAt        time.Time
+	EditedAt         sql.NullTime
+	DeletedAt        sql.NullTime
+	Views            int32
+	RepliesCount     int32
+	TopScore         float64
+	HotScore         float64
+	ControversyScore float64
+}
+
+type CommentLike struct {
+	CommentID string
+	UserID    string
+	CreatedAt time.Time
+}
+
+type CommentView struct {
+	CommentID string
+	UserID    string
+	CreatedAt time.Time
+}
+
+type ConnectedAccount struct {
+	CanReceivePayments bool
+	UserID             string
+	CustomerID         string
+	AccountID          string
+}
+
+type ConnectedCustomer struct {
+	UserID              string
+	CustomerID          string
+	ConnectedCustomerID string
+	AccountID           string
+}
+
+type Customer struct {
+	TrialUsed  sql.NullBool
+	ID         string
+	CustomerID string
+	FirstName  string
+	LastName   string
+	AccountID  sql.NullString
+}
+
+type Edit struct {
+	ID              string
+	TargetType      string
+	TargetID        string
+	EditorID        string
+	Action          string
+	PreviousMessage sql.NullString
+	CreatedAt       time.Time
+}
+
+type Follow struct {
+	FollowerUserID  string
+	FollowingUserID string
+	FollowDate      time.Time
+	UnfollowDate    sql.NullTime
+}
+
+type Hashtag struct {
+	Name      string
+	CreatedAt time.Time
+}
+
+type HashtagUse struct {
+	Hashtag   string
+	PostID    string
+	SourceID  string
+	CreatedAt time.Time
+}
+
+type List struct {
+	AllowedUsers json.RawMessage
+	ID           string
+	Name         string
+	OwnerUserID  string
+}
+
+type Mix struct {
+	ID          string
+	UserID      string
+	Category    string
+	PostIds     []string
+	Background  json.RawMessage
+	RequestedAt time.Time
+	Title       string
+}
+
+type ModerationAction struct {
+	ID             string
+	ReportID       string
+	ModeratorID    string
+	Action         string
+	TargetType     string
+	TargetID       string
+	TargetAuthorID string
+	Note           string
+	CreatedAt      time.Time
+}
+
+type Notification struct {
+	ID                  string
+	UserIDToNotify      string
+	UserIDWhoFiredEvent string
+	Date                time.Time
+	Read                bool
+	TriggerID           sql.NullString
+	EventID             string
+	Content             sql.NullString
+	Count               int32
+	CollapseKey         sql.NullString
+}
+
+type Playback struct {
+	ID         string
+	PostID     string
+	UserID     string
+	ListenedMs int32
+	Completed  bool
+	Counted    bool
+	CreatedAt  time.Time
+}
+
+type Poll struct {
+	PostID            string
+	MultipleChoice    bool
+	ClosesAt          time.Time
+	ResultsNotifiedAt sql.NullTime
+	CreatedAt         time.Time
+}
+
+type PollOption struct {
+	PostID   string
+	Position int32
+	Text     string
+}
+
+type PollVote struct {
+	PostID    string
+	UserID    string
+	Options   []int32
+	CreatedAt time.Time
+}
+
+type Post struct {
+	Likes                  sql.NullInt32
+	Audio                  json.RawMessage
+	ID                     string
+	Author                 string
+	Message                string
+	UserIdsWhoLikes        []string
+	CreatedAt              time.Time
+	Mentions               json.RawMessage
+	Category               string
+	Tags                   []string
+	EditedAt               sql.NullTime
+	DeletedAt              sql.NullTime
+	QuotedPostID           sql.NullString
+	Visibility             string
+	VisibilityListIds      []string
+	Status                 string
+	PublishAt              sql.NullTime
+	Plays                  int32
+	SupportersRoomID       sql.NullString
+	SupportersPurchaseType sql.NullString
+}
+
+type PostLike struct {
+	PostID    string
+	UserID    string
+	CreatedAt time.Time
+}
+
+type Report struct {
+	ID             string
+	TargetType     string
+	TargetID       string
+	ConversationID sql.NullString
+	TargetAuthorID string
+	Content        string
+	Status         string
+	ClaimedBy      sql.NullString
+	ClaimedAt      sql.NullTime
+	Resolution     sql.NullString
+	ResolvedBy     sql.NullString
+	ResolvedAt     sql.NullTime
+	OpenedAt       time.Time
+	LastReportedAt time.Time
+}
+
+type ReportEntry struct {
+	ReportID   string
+	ReporterID string
+	Reason     string
+	Details    string
+	CreatedAt  time.Time
+}
+
+type Repost struct {
+	PostID    string
+	UserID    string
+	CreatedAt time.Time
+}
+
+type RoomSubscription struct {
+	LatestInvoice        json.RawMessage
+	CurrentPeriodEnd     sql.NullTime
+	CustomerID           string
+	ConnectedCustomerID  string
+	AccountID            string
+	ID                   string
+	Status               string
+	RoomID               string
+	RoomSubscriptionType string
+	UserID               string
+}
+
+type StripeDefaultPaymentMethod struct {
+	ExpMonth   int32
+	ExpYear    int32
+	IsDefault  sql.NullBool
+	ID         string
+	LastFour   string
+	UserID     string
+	CustomerID string
+}
+
+type StripePrice struct {
+	CustomerID string
+	ID         string
+	UserID     string
+	Plan       string
+	Active     bool
+}
+
+type StripeSubscription struct {
+	CurrentPeriodEnd time.Time
+	LatestInvoice    json.RawMessage
+	ID               string
+	UserID           string
+	CustomerID       string
+	Status           string
+}
+
+type ThreadSubscription struct {
+	PostID    string
+	UserID    string
+	CreatedAt time.Time
+}
+
+type TrendingPost struct {
+	Generation time.Time
+	PostID     string
+	Category   string
+	Score      float64
+}
+
+type User struct {
+	EmailVerified         sql.NullBool
+	PasswordChangedAt     sql.NullTime
+	Email                 string
+	Password              sql.NullString
+	ID                    string
+	FamilyName            sql.NullString
+	Type                  string
+	GivenName             sql.NullString
+	Username              sql.NullString
+	MessageRequestsPolicy string
+	IsModerator           bool
+	SuspendedUntil        sql.NullTime
+}
@@ -0,0 +1,14 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package hashtags
+
+import (
+	"context"
+)
+
+type Querier interface {
+	GetTrendingHashtags(ctx context.Context, arg GetTrendingHashtagsParams) ([]GetTrendingHashtagsRow, error)
+	SetHashtags(ctx context.Context, arg SetHashtagsParams) error
+}
+
+var _ Querier = (*Queries)(nil)
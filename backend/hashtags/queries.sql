@@ -0,0 +1,23 @@
+-- name: SetHashtags :exec
+WITH d AS (
+	DELETE FROM hashtag_uses
+	WHERE source_id = sqlc.arg(source_id)::VARCHAR(100) AND NOT (hashtag = ANY(sqlc.arg(names)::VARCHAR(100)[]))
+), h AS (
+	INSERT INTO hashtags (name, created_at)
+	SELECT unnest(sqlc.arg(names)::VARCHAR(100)[]), sqlc.arg(created_at)::TIMESTAMPTZ
+	ON CONFLICT (name) DO NOTHING
+)
+INSERT INTO hashtag_uses (hashtag, post_id, source_id, created_at)
+SELECT unnest(sqlc.arg(names)::VARCHAR(100)[]), sqlc.arg(post_id)::VARCHAR(100), sqlc.arg(source_id)::VARCHAR(100), sqlc.arg(created_at)::TIMESTAMPTZ
+ON CONFLICT (hashtag, source_id) DO NOTHING;
+
+-- name: GetTrendingHashtags :many
+SELECT u.hashtag, COUNT(*) AS uses_count,
+SUM(EXP(-LN(2) * EXTRACT(EPOCH FROM sqlc.arg(now)::TIMESTAMPTZ - u.created_at) / sqlc.arg(half_life_seconds)::FLOAT8))::FLOAT8 AS score
+FROM hashtag_uses u
+JOIN posts p ON p.id = u.post_id
+WHERE u.created_at > sqlc.arg(since)::TIMESTAMPTZ AND
+p.deleted_at IS NULL AND p.status = 'published' AND p.visibility = 'public'
+GROUP BY u.hashtag
+ORDER BY score DESC, u.hashtag
+LIMIT sqlc.arg(max_results)::INTEGER;
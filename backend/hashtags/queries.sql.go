@@ -0,0 +1,95 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: queries.sql
+
+package hashtags
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const getTrendingHashtags = `-- name: GetTrendingHashtags :many
+SELECT u.hashtag, COUNT(*) AS uses_count,
+SUM(EXP(-LN(2) * EXTRACT(EPOCH FROM $1::TIMESTAMPTZ - u.created_at) / $2::FLOAT8))::FLOAT8 AS score
+FROM hashtag_uses u
+JOIN posts p ON p.id = u.post_id
+WHERE u.created_at > $3::TIMESTAMPTZ AND
+p.deleted_at IS NULL AND p.status = 'published' AND p.visibility = 'public'
+GROUP BY u.hashtag
+ORDER BY score DESC, u.hashtag
+LIMIT $4::INTEGER
+`
+
+type GetTrendingHashtagsParams struct {
+	Now             time.Time
+	HalfLifeSeconds float64
+	Since           time.Time
+	MaxResults      int32
+}
+
+type GetTrendingHashtagsRow struct {
+	Hashtag   string
+	UsesCount int64
+	Score     float64
+}
+
+func (q *Queries) GetTrendingHashtags(ctx context.Context, arg GetTrendingHashtagsParams) ([]GetTrendingHashtagsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTrendingHashtags,
+		arg.Now,
+		arg.HalfLifeSeconds,
+		arg.Since,
+		arg.MaxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTrendingHashtagsRow
+	for rows.Next() {
+		var i GetTrendingHashtagsRow
+		if err := rows.Scan(&i.Hashtag, &i.UsesCount, &i.Score); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setHashtags = `-- name: SetHashtags :exec
+WITH d AS (
+	DELETE FROM hashtag_uses
+	WHERE source_id = $3::VARCHAR(100) AND NOT (hashtag = ANY($1::VARCHAR(100)[]))
+), h AS (
+	INSERT INTO hashtags (name, created_at)
+	SELECT unnest($1::VARCHAR(100)[]), $4::TIMESTAMPTZ
+	ON CONFLICT (name) DO NOTHING
+)
+INSERT INTO hashtag_uses (hashtag, post_id, source_id, created_at)
+SELECT unnest($1::VARCHAR(100)[]), $2::VARCHAR(100), $3::VARCHAR(100), $4::TIMESTAMPTZ
+ON CONFLICT (hashtag, source_id) DO NOTHING
+`
+
+type SetHashtagsParams struct {
+	Names     []string
+	PostID    string
+	SourceID  string
+	CreatedAt time.Time
+}
+
+func (q *Queries) SetHashtags(ctx context.Context, arg SetHashtagsParams) error {
+	_, err := q.db.ExecContext(ctx, setHashtags,
+		pq.Array(arg.Names),
+		arg.PostID,
+		arg.SourceID,
+		arg.CreatedAt,
+	)
+	return err
+}
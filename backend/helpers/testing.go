@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/DagDigg/unpaper/backend/pkg/dbschema"
 	"github.com/go-redis/redis/v8"
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/ory/dockertest/v3"
@@ -115,6 +116,16 @@ func StartDatabase(tb testing.TB, pgURL *url.URL) *url.URL {
 		tb.Fatalf("error migrating up: %v", err)
 	}
 
+	// Apply the objects which are not part of the migrations
+	db, err := sql.Open("pgx", pgURL.String())
+	if err != nil {
+		tb.Fatalf("error opening database: %v", err)
+	}
+	defer db.Close()
+	if err := dbschema.Ensure(context.Background(), db); err != nil {
+		tb.Fatalf("error applying database schema: %v", err)
+	}
+
 	// Return the postgres URL, which is exactly
 	// the one passed as parameter, but with the host
 	// set with the docker one
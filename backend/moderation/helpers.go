@@ -0,0 +1,223 @@
+package moderation
+
+import (
+	"fmt"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func pgReportToPB(r GetReportRow) (*v1API.Report, error) {
+	targetType, err := PGTargetTypeToPB(TargetType(r.TargetType))
+	if err != nil {
+		return nil, err
+	}
+	status, err := pgStatusToPB(Status(r.Status))
+	if err != nil {
+		return nil, err
+	}
+
+	report := &v1API.Report{
+		Id:             r.ID,
+		TargetType:     targetType,
+		TargetId:       r.TargetID,
+		ConversationId: r.ConversationID.String,
+		TargetAuthorId: r.TargetAuthorID,
+		Content:        r.Content,
+		Status:         status,
+		ReportsCount:   r.ReportsCount,
+		Entries:        []*v1API.ReportEntry{},
+		ClaimedBy:      r.ClaimedBy.String,
+		ResolvedBy:     r.ResolvedBy.String,
+		OpenedAt:       timestamppb.New(r.OpenedAt),
+		LastReportedAt: timestamppb.New(r.LastReportedAt),
+	}
+	if r.ClaimedAt.Valid {
+		report.ClaimedAt = timestamppb.New(r.ClaimedAt.Time)
+	}
+	if r.Resolution.Valid {
+		resolution, err := pgActionToPB(Action(r.Resolution.String))
+		if err != nil {
+			return nil, err
+		}
+		report.Resolution = resolution
+	}
+	if r.ResolvedAt.Valid {
+		report.ResolvedAt = timestamppb.New(r.ResolvedAt.Time)
+	}
+
+	return report, nil
+}
+
+func pgReportEntryToPB(e ReportEntry) (*v1API.ReportEntry, error) {
+	reason, err := pgReasonToPB(Reason(e.Reason))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1API.ReportEntry{
+		ReporterId: e.ReporterID,
+		Reason:     reason,
+		Details:    e.Details,
+		CreatedAt:  timestamppb.New(e.CreatedAt),
+	}, nil
+}
+
+func pgModerationActionToPB(a ModerationAction) (*v1API.ModerationLogEntry, error) {
+	action, err := pgActionToPB(Action(a.Action))
+	if err != nil {
+		return nil, err
+	}
+	targetType, err := PGTargetTypeToPB(TargetType(a.TargetType))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1API.ModerationLogEntry{
+		Id:             a.ID,
+		ReportId:       a.ReportID,
+		ModeratorId:    a.ModeratorID,
+		Action:         action,
+		TargetType:     targetType,
+		TargetId:       a.TargetID,
+		TargetAuthorId: a.TargetAuthorID,
+		Note:           a.Note,
+		CreatedAt:      timestamppb.New(a.CreatedAt),
+	}, nil
+}
+
+// PGTargetTypeToPB converts a postgres report target type to protobuf
+func PGTargetTypeToPB(t TargetType) (v1API.ReportTargetType_Enum, error) {
+	switch t {
+	case TargetTypePost:
+		return v1API.ReportTargetType_POST, nil
+	case TargetTypeComment:
+		return v1API.ReportTargetType_COMMENT, nil
+	case TargetTypeChatMessage:
+		return v1API.ReportTargetType_CHAT_MESSAGE, nil
+	case TargetTypeUser:
+		return v1API.ReportTargetType_USER, nil
+	default:
+		return 0, fmt.Errorf("invalid report target type: %q", t)
+	}
+}
+
+// PBTargetTypeToPG converts a protobuf report target type to postgres
+func PBTargetTypeToPG(t v1API.ReportTargetType_Enum) (TargetType, error) {
+	switch t {
+	case v1API.ReportTargetType_POST:
+		return TargetTypePost, nil
+	case v1API.ReportTargetType_COMMENT:
+		return TargetTypeComment, nil
+	case v1API.ReportTargetType_CHAT_MESSAGE:
+		return TargetTypeChatMessage, nil
+	case v1API.ReportTargetType_USER:
+		return TargetTypeUser, nil
+	default:
+		return "", fmt.Errorf("invalid report target type: %v", t)
+	}
+}
+
+func pgReasonToPB(r Reason) (v1API.ReportReason_Enum, error) {
+	switch r {
+	case ReasonSpam:
+		return v1API.ReportReason_SPAM, nil
+	case ReasonHarassment:
+		return v1API.ReportReason_HARASSMENT, nil
+	case ReasonHate:
+		return v1API.ReportReason_HATE, nil
+	case ReasonViolence:
+		return v1API.ReportReason_VIOLENCE, nil
+	case ReasonSexual:
+		return v1API.ReportReason_SEXUAL, nil
+	case ReasonMisinformation:
+		return v1API.ReportReason_MISINFORMATION, nil
+	case ReasonOther:
+		return v1API.ReportReason_OTHER, nil
+	default:
+		return 0, fmt.Errorf("invalid report reason: %q", r)
+	}
+}
+
+// PBReasonToPG converts a protobuf report reason to postgres
+func PBReasonToPG(r v1API.ReportReason_Enum) (Reason, error) {
+	switch r {
+	case v1API.ReportReason_SPAM:
+		return ReasonSpam, nil
+	case v1API.ReportReason_HARASSMENT:
+		return ReasonHarassment, nil
+	case v1API.ReportReason_HATE:
+		return ReasonHate, nil
+	case v1API.ReportReason_VIOLENCE:
+		return ReasonViolence, nil
+	case v1API.ReportReason_SEXUAL:
+		return ReasonSexual, nil
+	case v1API.ReportReason_MISINFORMATION:
+		return ReasonMisinformation, nil
+	case v1API.ReportReason_OTHER:
+		return ReasonOther, nil
+	default:
+		return "", fmt.Errorf("invalid report reason: %v", r)
+	}
+}
+
+func pgStatusToPB(s Status) (v1API.ReportStatus_Enum, error) {
+	switch s {
+	case StatusOpen:
+		return v1API.ReportStatus_OPEN, nil
+	case StatusClaimed:
+		return v1API.ReportStatus_CLAIMED, nil
+	case StatusResolved:
+		return v1API.ReportStatus_RESOLVED, nil
+	default:
+		return 0, fmt.Errorf("invalid report status: %q", s)
+	}
+}
+
+// PBStatusToPG converts a protobuf report status to postgres
+func PBStatusToPG(s v1API.ReportStatus_Enum) (Status, error) {
+	switch s {
+	case v1API.ReportStatus_OPEN:
+		return StatusOpen, nil
+	case v1API.ReportStatus_CLAIMED:
+		return StatusClaimed, nil
+	case v1API.ReportStatus_RESOLVED:
+		return StatusResolved, nil
+	default:
+		return "", fmt.Errorf("invalid report status: %v", s)
+	}
+}
+
+func pgActionToPB(a Action) (v1API.ModerationAction_Enum, error) {
+	switch a {
+	case ActionClaim:
+		return v1API.ModerationAction_CLAIM, nil
+	case ActionDismiss:
+		return v1API.ModerationAction_DISMISS, nil
+	case ActionRemoveContent:
+		return v1API.ModerationAction_REMOVE_CONTENT, nil
+	case ActionWarn:
+		return v1API.ModerationAction_WARN, nil
+	case ActionSuspend:
+		return v1API.ModerationAction_SUSPEND, nil
+	default:
+		return 0, fmt.Errorf("invalid moderation action: %q", a)
+	}
+}
+
+// PBResolutionToPG converts a protobuf moderation action resolving a report to postgres.
+// Claims are not resolutions
+func PBResolutionToPG(a v1API.ModerationAction_Enum) (Action, error) {
+	switch a {
+	case v1API.ModerationAction_DISMISS:
+		return ActionDismiss, nil
+	case v1API.ModerationAction_REMOVE_CONTENT:
+		return ActionRemoveContent, nil
+	case v1API.ModerationAction_WARN:
+		return ActionWarn, nil
+	case v1API.ModerationAction_SUSPEND:
+		return ActionSuspend, nil
+	default:
+		return "", fmt.Errorf("invalid resolution: %v", a)
+	}
+}
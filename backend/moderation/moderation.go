@@ -0,0 +1,247 @@
+package moderation
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+)
+
+// Directory is the directory which operates on db tables 'reports', 'report_entries' and 'moderation_actions'
+type Directory struct {
+	// querier is an interface containing all of the
+	// directory methods. Must be created with moderation.NewDirectory(db)
+	querier Querier
+	db      *sql.DB
+}
+
+// NewDirectory creates a new moderation directory
+func NewDirectory(db *sql.DB) *Directory {
+	return &Directory{db: db, querier: New(db)}
+}
+
+// Close closes Directory database connection
+func (d Directory) Close() error {
+	return d.db.Close()
+}
+
+const (
+	// ClaimTTL is the time after which a claimed report can be taken over by another moderator
+	ClaimTTL = 30 * time.Minute
+	// MaxDetailsLength is the maximum length of the details of a report
+	MaxDetailsLength = 1000
+)
+
+// TargetType refers to the kind of content which has been reported
+type TargetType string
+
+const (
+	// TargetTypePost refers to reports of posts
+	TargetTypePost TargetType = "post"
+	// TargetTypeComment refers to reports of comments
+	TargetTypeComment TargetType = "comment"
+	// TargetTypeChatMessage refers to reports of chat messages
+	TargetTypeChatMessage TargetType = "chat_message"
+	// TargetTypeUser refers to reports of users
+	TargetTypeUser TargetType = "user"
+)
+
+// Reason is the reason of a report
+type Reason string
+
+const (
+	// ReasonSpam is unsolicited or repetitive content
+	ReasonSpam Reason = "spam"
+	// ReasonHarassment is content targeting a person
+	ReasonHarassment Reason = "harassment"
+	// ReasonHate is hateful content
+	ReasonHate Reason = "hate"
+	// ReasonViolence is violent or threatening content
+	ReasonViolence Reason = "violence"
+	// ReasonSexual is sexual content
+	ReasonSexual Reason = "sexual"
+	// ReasonMisinformation is misleading content
+	ReasonMisinformation Reason = "misinformation"
+	// ReasonOther is any other reason, explained by the report details
+	ReasonOther Reason = "other"
+)
+
+// Status is the review status of a report
+type Status string
+
+const (
+	// StatusOpen is a report waiting for a moderator
+	StatusOpen Status = "open"
+	// StatusClaimed is a report being reviewed by a moderator
+	StatusClaimed Status = "claimed"
+	// StatusResolved is a report which has been acted upon
+	StatusResolved Status = "resolved"
+)
+
+// Action is a moderator action recorded in the audit trail
+type Action string
+
+const (
+	// ActionClaim is the claim of a report for review
+	ActionClaim Action = "claim"
+	// ActionDismiss resolves a report leaving the target untouched
+	ActionDismiss Action = "dismiss"
+	// ActionRemoveContent resolves a report removing the reported content
+	ActionRemoveContent Action = "remove_content"
+	// ActionWarn resolves a report warning the target author
+	ActionWarn Action = "warn"
+	// ActionSuspend resolves a report suspending the target author
+	ActionSuspend Action = "suspend"
+)
+
+// CreateReport records the report of the target, returning the id of the report which aggregates it.
+// Repeated reports of the same target are aggregated, and a reporter counts once per round of reports
+func (d Directory) CreateReport(ctx context.Context, params CreateReportParams) (string, error) {
+	if params.CreatedAt.IsZero() {
+		params.CreatedAt = time.Now().UTC()
+	}
+
+	return d.querier.CreateReport(ctx, params)
+}
+
+// GetReport returns the report along with the entries of its current round
+func (d Directory) GetReport(ctx context.Context, id string) (*v1API.Report, error) {
+	res, err := d.querier.GetReport(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.reportWithEntries(ctx, res)
+}
+
+// ListReports returns a page of the reports with the status, from the oldest opened
+func (d Directory) ListReports(ctx context.Context, params ListReportsParams) ([]*v1API.Report, error) {
+	res, err := d.querier.ListReports(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := []*v1API.Report{}
+	ids := []string{}
+	for _, r := range res {
+		pbReport, err := pgReportToPB(GetReportRow(r))
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, pbReport)
+		ids = append(ids, r.ID)
+	}
+	if err := d.addEntries(ctx, ids, reports); err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
+
+// ClaimReport assigns an open report to the moderator, recording the claim in the audit trail.
+// Claims older than ClaimTTL can be taken over. It returns sql.ErrNoRows when the report cannot be claimed
+func (d Directory) ClaimReport(ctx context.Context, params ClaimReportParams) (*v1API.Report, error) {
+	if params.CreatedAt.IsZero() {
+		params.CreatedAt = time.Now().UTC()
+	}
+	if params.StaleBefore.IsZero() {
+		params.StaleBefore = params.CreatedAt.Add(-ClaimTTL)
+	}
+	res, err := d.querier.ClaimReport(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.reportWithEntries(ctx, GetReportRow(res))
+}
+
+// ResolveReport resolves a report claimed by the moderator, recording the action in the audit trail.
+// apply is called with the resolved report while its row is locked by the resolution, which is rolled back
+// if apply fails: concurrent resolutions wait for it, so the action is applied only by the moderator holding the claim.
+// It returns sql.ErrNoRows when the report is not claimed by the moderator
+func (d Directory) ResolveReport(ctx context.Context, params ResolveReportParams, apply func(*v1API.Report) error) (*v1API.Report, error) {
+	if params.CreatedAt.IsZero() {
+		params.CreatedAt = time.Now().UTC()
+	}
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	res, err := New(tx).ResolveReport(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	report, err := pgReportToPB(GetReportRow(res))
+	if err != nil {
+		return nil, err
+	}
+	if err := apply(report); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return d.reportWithEntries(ctx, GetReportRow(res))
+}
+
+// GetModerationLog returns the audit trail of the report, from the first action
+func (d Directory) GetModerationLog(ctx context.Context, reportID string) ([]*v1API.ModerationLogEntry, error) {
+	res, err := d.querier.GetModerationLog(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []*v1API.ModerationLogEntry{}
+	for _, a := range res {
+		pbEntry, err := pgModerationActionToPB(a)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, pbEntry)
+	}
+
+	return entries, nil
+}
+
+func (d Directory) reportWithEntries(ctx context.Context, r GetReportRow) (*v1API.Report, error) {
+	report, err := pgReportToPB(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.addEntries(ctx, []string{r.ID}, []*v1API.Report{report}); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// addEntries retrieves the entries of the current round of the reports, adding them to their report
+func (d Directory) addEntries(ctx context.Context, ids []string, reports []*v1API.Report) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	res, err := d.querier.GetReportEntries(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	byID := map[string]*v1API.Report{}
+	for _, r := range reports {
+		byID[r.Id] = r
+	}
+	for _, e := range res {
+		pbEntry, err := pgReportEntryToPB(e)
+		if err != nil {
+			return err
+		}
+		if r, ok := byID[e.ReportID]; ok {
+			r.Entries = append(r.Entries, pbEntry)
+		}
+	}
+
+	return nil
+}
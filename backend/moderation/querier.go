@@ -0,0 +1,19 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package moderation
+
+import (
+	"context"
+)
+
+type Querier interface {
+	ClaimReport(ctx context.Context, arg ClaimReportParams) (ClaimReportRow, error)
+	CreateReport(ctx context.Context, arg CreateReportParams) (string, error)
+	GetModerationLog(ctx context.Context, reportID string) ([]ModerationAction, error)
+	GetReport(ctx context.Context, id string) (GetReportRow, error)
+	GetReportEntries(ctx context.Context, reportIds []string) ([]ReportEntry, error)
+	ListReports(ctx context.Context, arg ListReportsParams) ([]ListReportsRow, error)
+	ResolveReport(ctx context.Context, arg ResolveReportParams) (ResolveReportRow, error)
+}
+
+var _ Querier = (*Queries)(nil)
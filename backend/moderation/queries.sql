@@ -0,0 +1,97 @@
+-- name: CreateReport :one
+WITH r AS (
+	INSERT INTO reports (id, target_type, target_id, conversation_id, target_author_id, content, status, opened_at, last_reported_at)
+	VALUES (
+		sqlc.arg(id)::VARCHAR(100), sqlc.arg(target_type)::VARCHAR(100), sqlc.arg(target_id)::VARCHAR(100),
+		NULLIF(sqlc.arg(conversation_id)::VARCHAR(100), ''), sqlc.arg(target_author_id)::VARCHAR(100), sqlc.arg(content)::TEXT,
+		'open', sqlc.arg(created_at)::TIMESTAMPTZ, sqlc.arg(created_at)::TIMESTAMPTZ
+	)
+	-- Reports of the same target are aggregated. A resolved target is reopened with a new round of reports
+	ON CONFLICT (target_type, target_id) DO UPDATE
+	SET
+	content = EXCLUDED.content,
+	conversation_id = EXCLUDED.conversation_id,
+	last_reported_at = EXCLUDED.last_reported_at,
+	status = CASE WHEN reports.status = 'resolved' THEN 'open' ELSE reports.status END,
+	opened_at = CASE WHEN reports.status = 'resolved' THEN EXCLUDED.opened_at ELSE reports.opened_at END,
+	claimed_by = CASE WHEN reports.status = 'resolved' THEN NULL ELSE reports.claimed_by END,
+	claimed_at = CASE WHEN reports.status = 'resolved' THEN NULL ELSE reports.claimed_at END,
+	resolution = CASE WHEN reports.status = 'resolved' THEN NULL ELSE reports.resolution END,
+	resolved_by = CASE WHEN reports.status = 'resolved' THEN NULL ELSE reports.resolved_by END,
+	resolved_at = CASE WHEN reports.status = 'resolved' THEN NULL ELSE reports.resolved_at END
+	RETURNING id
+)
+INSERT INTO report_entries (report_id, reporter_id, reason, details, created_at)
+SELECT r.id, sqlc.arg(reporter_id)::VARCHAR(100), sqlc.arg(reason)::VARCHAR(100), sqlc.arg(details)::TEXT, sqlc.arg(created_at)::TIMESTAMPTZ
+FROM r
+ON CONFLICT (report_id, reporter_id) DO UPDATE
+SET reason = EXCLUDED.reason, details = EXCLUDED.details, created_at = EXCLUDED.created_at
+RETURNING report_id;
+
+-- name: GetReport :one
+SELECT r.*, (
+	SELECT COUNT(*) FROM report_entries e
+	WHERE e.report_id = r.id AND e.created_at >= r.opened_at
+)::INTEGER AS reports_count
+FROM reports r
+WHERE r.id = $1;
+
+-- name: ListReports :many
+SELECT r.*, (
+	SELECT COUNT(*) FROM report_entries e
+	WHERE e.report_id = r.id AND e.created_at >= r.opened_at
+)::INTEGER AS reports_count
+FROM reports r
+WHERE r.status = sqlc.arg(status)::VARCHAR(100) AND
+(NOT sqlc.arg(has_cursor)::BOOLEAN OR (r.opened_at, r.id) > (sqlc.arg(cursor_opened_at)::TIMESTAMPTZ, sqlc.arg(cursor_id)::VARCHAR(100)))
+ORDER BY r.opened_at ASC, r.id ASC
+LIMIT sqlc.arg(page_size)::INTEGER;
+
+-- name: GetReportEntries :many
+SELECT e.* FROM report_entries e
+JOIN reports r ON r.id = e.report_id
+WHERE e.report_id = ANY(sqlc.arg(report_ids)::VARCHAR[]) AND e.created_at >= r.opened_at
+ORDER BY e.created_at ASC;
+
+-- name: ClaimReport :one
+WITH r AS (
+	UPDATE reports
+	SET status = 'claimed', claimed_by = sqlc.arg(moderator_id)::VARCHAR(100), claimed_at = sqlc.arg(created_at)::TIMESTAMPTZ
+	WHERE reports.id = sqlc.arg(id)::VARCHAR(100) AND (
+		reports.status = 'open' OR
+		-- Stale claims can be taken over
+		reports.status = 'claimed' AND (reports.claimed_by = sqlc.arg(moderator_id)::VARCHAR(100) OR reports.claimed_at < sqlc.arg(stale_before)::TIMESTAMPTZ)
+	)
+	RETURNING *
+), a AS (
+	INSERT INTO moderation_actions (id, report_id, moderator_id, action, target_type, target_id, target_author_id, note, created_at)
+	SELECT sqlc.arg(action_id)::VARCHAR(100), r.id, r.claimed_by, 'claim', r.target_type, r.target_id, r.target_author_id, '', r.claimed_at
+	FROM r
+)
+SELECT r.*, (
+	SELECT COUNT(*) FROM report_entries e
+	WHERE e.report_id = r.id AND e.created_at >= r.opened_at
+)::INTEGER AS reports_count
+FROM r;
+
+-- name: ResolveReport :one
+WITH r AS (
+	UPDATE reports
+	SET status = 'resolved', resolution = sqlc.arg(action)::VARCHAR(100), resolved_by = sqlc.arg(moderator_id)::VARCHAR(100), resolved_at = sqlc.arg(created_at)::TIMESTAMPTZ
+	WHERE reports.id = sqlc.arg(id)::VARCHAR(100) AND reports.status = 'claimed' AND reports.claimed_by = sqlc.arg(moderator_id)::VARCHAR(100)
+	RETURNING *
+), a AS (
+	INSERT INTO moderation_actions (id, report_id, moderator_id, action, target_type, target_id, target_author_id, note, created_at)
+	SELECT sqlc.arg(action_id)::VARCHAR(100), r.id, r.resolved_by, r.resolution, r.target_type, r.target_id, r.target_author_id, sqlc.arg(note)::TEXT, r.resolved_at
+	FROM r
+)
+SELECT r.*, (
+	SELECT COUNT(*) FROM report_entries e
+	WHERE e.report_id = r.id AND e.created_at >= r.opened_at
+)::INTEGER AS reports_count
+FROM r;
+
+-- name: GetModerationLog :many
+SELECT * FROM moderation_actions
+WHERE report_id = $1
+ORDER BY created_at ASC, id ASC;
@@ -0,0 +1,434 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: queries.sql
+
+package moderation
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const claimReport = `-- name: ClaimReport :one
+WITH r AS (
+	UPDATE reports
+	SET status = 'claimed', claimed_by = $1::VARCHAR(100), claimed_at = $2::TIMESTAMPTZ
+	WHERE reports.id = $3::VARCHAR(100) AND (
+		reports.status = 'open' OR
+		-- Stale claims can be taken over
+		reports.status = 'claimed' AND (reports.claimed_by = $1::VARCHAR(100) OR reports.claimed_at < $4::TIMESTAMPTZ)
+	)
+	RETURNING id, target_type, target_id, conversation_id, target_author_id, content, status, claimed_by, claimed_at, resolution, resolved_by, resolved_at, opened_at, last_reported_at
+), a AS (
+	INSERT INTO moderation_actions (id, report_id, moderator_id, action, target_type, target_id, target_author_id, note, created_at)
+	SELECT $5::VARCHAR(100), r.id, r.claimed_by, 'claim', r.target_type, r.target_id, r.target_author_id, '', r.claimed_at
+	FROM r
+)
+SELECT r.id, r.target_type, r.target_id, r.conversation_id, r.target_author_id, r.content, r.status, r.claimed_by, r.claimed_at, r.resolution, r.resolved_by, r.resolved_at, r.opened_at, r.last_reported_at, (
+	SELECT COUNT(*) FROM report_entries e
+	WHERE e.report_id = r.id AND e.created_at >= r.opened_at
+)::INTEGER AS reports_count
+FROM r
+`
+
+type ClaimReportParams struct {
+	ModeratorID string
+	CreatedAt   time.Time
+	ID          string
+	StaleBefore time.Time
+	ActionID    string
+}
+
+type ClaimReportRow struct {
+	ID             string
+	TargetType     string
+	TargetID       string
+	ConversationID sql.NullString
+	TargetAuthorID string
+	Content        string
+	Status         string
+	ClaimedBy      sql.NullString
+	ClaimedAt      sql.NullTime
+	Resolution     sql.NullString
+	ResolvedBy     sql.NullString
+	ResolvedAt     sql.NullTime
+	OpenedAt       time.Time
+	LastReportedAt time.Time
+	ReportsCount   int32
+}
+
+func (q *Queries) ClaimReport(ctx context.Context, arg ClaimReportParams) (ClaimReportRow, error) {
+	row := q.db.QueryRowContext(ctx, claimReport,
+		arg.ModeratorID,
+		arg.CreatedAt,
+		arg.ID,
+		arg.StaleBefore,
+		arg.ActionID,
+	)
+	var i ClaimReportRow
+	err := row.Scan(
+		&i.ID,
+		&i.TargetType,
+		&i.TargetID,
+		&i.ConversationID,
+		&i.TargetAuthorID,
+		&i.Content,
+		&i.Status,
+		&i.ClaimedBy,
+		&i.ClaimedAt,
+		&i.Resolution,
+		&i.ResolvedBy,
+		&i.ResolvedAt,
+		&i.OpenedAt,
+		&i.LastReportedAt,
+		&i.ReportsCount,
+	)
+	return i, err
+}
+
+const createReport = `-- name: CreateReport :one
+WITH r AS (
+	INSERT INTO reports (id, target_type, target_id, conversation_id, target_author_id, content, status, opened_at, last_reported_at)
+	VALUES (
+		$5::VARCHAR(100), $6::VARCHAR(100), $7::VARCHAR(100),
+		NULLIF($8::VARCHAR(100), ''), $9::VARCHAR(100), $10::TEXT,
+		'open', $4::TIMESTAMPTZ, $4::TIMESTAMPTZ
+	)
+	-- Reports of the same target are aggregated. A resolved target is reopened with a new round of reports
+	ON CONFLICT (target_type, target_id) DO UPDATE
+	SET
+	content = EXCLUDED.content,
+	conversation_id = EXCLUDED.conversation_id,
+	last_reported_at = EXCLUDED.last_reported_at,
+	status = CASE WHEN reports.status = 'resolved' THEN 'open' ELSE reports.status END,
+	opened_at = CASE WHEN reports.status = 'resolved' THEN EXCLUDED.opened_at ELSE reports.opened_at END,
+	claimed_by = CASE WHEN reports.status = 'resolved' THEN NULL ELSE reports.claimed_by END,
+	claimed_at = CASE WHEN reports.status = 'resolved' THEN NULL ELSE reports.claimed_at END,
+	resolution = CASE WHEN reports.status = 'resolved' THEN NULL ELSE reports.resolution END,
+	resolved_by = CASE WHEN reports.status = 'resolved' THEN NULL ELSE reports.resolved_by END,
+	resolved_at = CASE WHEN reports.status = 'resolved' THEN NULL ELSE reports.resolved_at END
+	RETURNING id
+)
+INSERT INTO report_entries (report_id, reporter_id, reason, details, created_at)
+SELECT r.id, $1::VARCHAR(100), $2::VARCHAR(100), $3::TEXT, $4::TIMESTAMPTZ
+FROM r
+ON CONFLICT (report_id, reporter_id) DO UPDATE
+SET reason = EXCLUDED.reason, details = EXCLUDED.details, created_at = EXCLUDED.created_at
+RETURNING report_id
+`
+
+type CreateReportParams struct {
+	ReporterID     string
+	Reason         string
+	Details        string
+	CreatedAt      time.Time
+	ID             string
+	TargetType     string
+	TargetID       string
+	ConversationID string
+	TargetAuthorID string
+	Content        string
+}
+
+func (q *Queries) CreateReport(ctx context.Context, arg CreateReportParams) (string, error) {
+	row := q.db.QueryRowContext(ctx, createReport,
+		arg.ReporterID,
+		arg.Reason,
+		arg.Details,
+		arg.CreatedAt,
+		arg.ID,
+		arg.TargetType,
+		arg.TargetID,
+		arg.ConversationID,
+		arg.TargetAuthorID,
+		arg.Content,
+	)
+	var report_id string
+	err := row.Scan(&report_id)
+	return report_id, err
+}
+
+const getModerationLog = `-- name: GetModerationLog :many
+SELECT id, report_id, moderator_id, action, target_type, target_id, target_author_id, note, created_at FROM moderation_actions
+WHERE report_id = $1
+ORDER BY created_at ASC, id ASC
+`
+
+func (q *Queries) GetModerationLog(ctx context.Context, reportID string) ([]ModerationAction, error) {
+	rows, err := q.db.QueryContext(ctx, getModerationLog, reportID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ModerationAction
+	for rows.Next() {
+		var i ModerationAction
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReportID,
+			&i.ModeratorID,
+			&i.Action,
+			&i.TargetType,
+			&i.TargetID,
+			&i.TargetAuthorID,
+			&i.Note,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReport = `-- name: GetReport :one
+SELECT r.id, r.target_type, r.target_id, r.conversation_id, r.target_author_id, r.content, r.status, r.claimed_by, r.claimed_at, r.resolution, r.resolved_by, r.resolved_at, r.opened_at, r.last_reported_at, (
+	SELECT COUNT(*) FROM report_entries e
+	WHERE e.report_id = r.id AND e.created_at >= r.opened_at
+)::INTEGER AS reports_count
+FROM reports r
+WHERE r.id = $1
+`
+
+type GetReportRow struct {
+	ID             string
+	TargetType     string
+	TargetID       string
+	ConversationID sql.NullString
+	TargetAuthorID string
+	Content        string
+	Status         string
+	ClaimedBy      sql.NullString
+	ClaimedAt      sql.NullTime
+	Resolution     sql.NullString
+	ResolvedBy     sql.NullString
+	ResolvedAt     sql.NullTime
+	OpenedAt       time.Time
+	LastReportedAt time.Time
+	ReportsCount   int32
+}
+
+func (q *Queries) GetReport(ctx context.Context, id string) (GetReportRow, error) {
+	row := q.db.QueryRowContext(ctx, getReport, id)
+	var i GetReportRow
+	err := row.Scan(
+		&i.ID,
+		&i.TargetType,
+		&i.TargetID,
+		&i.ConversationID,
+		&i.TargetAuthorID,
+		&i.Content,
+		&i.Status,
+		&i.ClaimedBy,
+		&i.ClaimedAt,
+		&i.Resolution,
+		&i.ResolvedBy,
+		&i.ResolvedAt,
+		&i.OpenedAt,
+		&i.LastReportedAt,
+		&i.ReportsCount,
+	)
+	return i, err
+}
+
+const getReportEntries = `-- name: GetReportEntries :many
+SELECT e.report_id, e.reporter_id, e.reason, e.details, e.created_at FROM report_entries e
+JOIN reports r ON r.id = e.report_id
+WHERE e.report_id = ANY($1::VARCHAR[]) AND e.created_at >= r.opened_at
+ORDER BY e.created_at ASC
+`
+
+func (q *Queries) GetReportEntries(ctx context.Context, reportIds []string) ([]ReportEntry, error) {
+	rows, err := q.db.QueryContext(ctx, getReportEntries, pq.Array(reportIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReportEntry
+	for rows.Next() {
+		var i ReportEntry
+		if err := rows.Scan(
+			&i.ReportID,
+			&i.ReporterID,
+			&i.Reason,
+			&i.Details,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReports = `-- name: ListReports :many
+SELECT r.id, r.target_type, r.target_id, r.conversation_id, r.target_author_id, r.content, r.status, r.claimed_by, r.claimed_at, r.resolution, r.resolved_by, r.resolved_at, r.opened_at, r.last_reported_at, (
+	SELECT COUNT(*) FROM report_entries e
+	WHERE e.report_id = r.id AND e.created_at >= r.opened_at
+)::INTEGER AS reports_count
+FROM reports r
+WHERE r.status = $1::VARCHAR(100) AND
+(NOT $2::BOOLEAN OR (r.opened_at, r.id) > ($3::TIMESTAMPTZ, $4::VARCHAR(100)))
+ORDER BY r.opened_at ASC, r.id ASC
+LIMIT $5::INTEGER
+`
+
+type ListReportsParams struct {
+	Status         string
+	HasCursor      bool
+	CursorOpenedAt time.Time
+	CursorID       string
+	PageSize       int32
+}
+
+type ListReportsRow struct {
+	ID             string
+	TargetType     string
+	TargetID       string
+	ConversationID sql.NullString
+	TargetAuthorID string
+	Content        string
+	Status         string
+	ClaimedBy      sql.NullString
+	ClaimedAt      sql.NullTime
+	Resolution     sql.NullString
+	ResolvedBy     sql.NullString
+	ResolvedAt     sql.NullTime
+	OpenedAt       time.Time
+	LastReportedAt time.Time
+	ReportsCount   int32
+}
+
+func (q *Queries) ListReports(ctx context.Context, arg ListReportsParams) ([]ListReportsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listReports,
+		arg.Status,
+		arg.HasCursor,
+		arg.CursorOpenedAt,
+		arg.CursorID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListReportsRow
+	for rows.Next() {
+		var i ListReportsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TargetType,
+			&i.TargetID,
+			&i.ConversationID,
+			&i.TargetAuthorID,
+			&i.Content,
+			&i.Status,
+			&i.ClaimedBy,
+			&i.ClaimedAt,
+			&i.Resolution,
+			&i.ResolvedBy,
+			&i.ResolvedAt,
+			&i.OpenedAt,
+			&i.LastReportedAt,
+			&i.ReportsCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const resolveReport = `-- name: ResolveReport :one
+WITH r AS (
+	UPDATE reports
+	SET status = 'resolved', resolution = $1::VARCHAR(100), resolved_by = $2::VARCHAR(100), resolved_at = $3::TIMESTAMPTZ
+	WHERE reports.id = $4::VARCHAR(100) AND reports.status = 'claimed' AND reports.claimed_by = $2::VARCHAR(100)
+	RETURNING id, target_type, target_id, conversation_id, target_author_id, content, status, claimed_by, claimed_at, resolution, resolved_by, resolved_at, opened_at, last_reported_at
+), a AS (
+	INSERT INTO moderation_actions (id, report_id, moderator_id, action, target_type, target_id, target_author_id, note, created_at)
+	SELECT $5::VARCHAR(100), r.id, r.resolved_by, r.resolution, r.target_type, r.target_id, r.target_author_id, $6::TEXT, r.resolved_at
+	FROM r
+)
+SELECT r.id, r.target_type, r.target_id, r.conversation_id, r.target_author_id, r.content, r.status, r.claimed_by, r.claimed_at, r.resolution, r.resolved_by, r.resolved_at, r.opened_at, r.last_reported_at, (
+	SELECT COUNT(*) FROM report_entries e
+	WHERE e.report_id = r.id AND e.created_at >= r.opened_at
+)::INTEGER AS reports_count
+FROM r
+`
+
+type ResolveReportParams struct {
+	Action      string
+	ModeratorID string
+	CreatedAt   time.Time
+	ID          string
+	ActionID    string
+	Note        string
+}
+
+type ResolveReportRow struct {
+	ID             string
+	TargetType     string
+	TargetID       string
+	ConversationID sql.NullString
+	TargetAuthorID string
+	Content        string
+	Status         string
+	ClaimedBy      sql.NullString
+	ClaimedAt      sql.NullTime
+	Resolution     sql.NullString
+	ResolvedBy     sql.NullString
+	ResolvedAt     sql.NullTime
+	OpenedAt       time.Time
+	LastReportedAt time.Time
+	ReportsCount   int32
+}
+
+func (q *Queries) ResolveReport(ctx context.Context, arg ResolveReportParams) (ResolveReportRow, error) {
+	row := q.db.QueryRowContext(ctx, resolveReport,
+		arg.Action,
+		arg.ModeratorID,
+		arg.CreatedAt,
+		arg.ID,
+		arg.ActionID,
+		arg.Note,
+	)
+	var i ResolveReportRow
+	err := row.Scan(
+		&i.ID,
+		&i.TargetType,
+		&i.TargetID,
+		&i.ConversationID,
+		&i.TargetAuthorID,
+		&i.Content,
+		&i.Status,
+		&i.ClaimedBy,
+		&i.ClaimedAt,
+		&i.Resolution,
+		&i.ResolvedBy,
+		&i.ResolvedAt,
+		&i.OpenedAt,
+		&i.LastReportedAt,
+		&i.ReportsCount,
+	)
+	return i, err
+}
@@ -31,6 +31,7 @@ func pgNotificationToPB(n CreateNotificationRow) (*v1API.Notification, error) {
 		},
 		Content: n.Content.String,
 		Read:    n.Read,
+		Count:   n.Count,
 	}, nil
 }
 
@@ -68,6 +69,18 @@ func pgEventIDToPB(e EventID) (v1API.EventID_Enum, error) {
 		return v1API.EventID_COMMENT, nil
 	case EventIDFollow:
 		return v1API.EventID_FOLLOW, nil
+	case EventIDNewMessage:
+		return v1API.EventID_NEW_MESSAGE, nil
+	case EventIDMention:
+		return v1API.EventID_MENTION, nil
+	case EventIDRepost:
+		return v1API.EventID_REPOST, nil
+	case EventIDModerationWarning:
+		return v1API.EventID_MODERATION_WARNING, nil
+	case EventIDPollClosed:
+		return v1API.EventID_POLL_CLOSED, nil
+	case EventIDThreadSegment:
+		return v1API.EventID_THREAD_SEGMENT, nil
 	default:
 		return 0, fmt.Errorf("invalid event id received: %v", e)
 	}
@@ -83,6 +96,18 @@ func getEventTextByID(evtID EventID) (EventText, error) {
 		return EventTextComment, nil
 	case EventIDFollow:
 		return EventTextFollow, nil
+	case EventIDNewMessage:
+		return EventTextNewMessage, nil
+	case EventIDMention:
+		return EventTextMention, nil
+	case EventIDRepost:
+		return EventTextRepost, nil
+	case EventIDModerationWarning:
+		return EventTextModerationWarning, nil
+	case EventIDPollClosed:
+		return EventTextPollClosed, nil
+	case EventIDThreadSegment:
+		return EventTextThreadSegment, nil
 	default:
 		return "", fmt.Errorf("invalid event id received: %v", evtID)
 	}
@@ -42,6 +42,18 @@ const (
 	EventIDComment EventID = "COMMENT"
 	// EventIDFollow 'follow' event
 	EventIDFollow EventID = "FOLLOW"
+	// EventIDNewMessage 'new message' chat event
+	EventIDNewMessage EventID = "NEW_MESSAGE"
+	// EventIDMention 'mention' event
+	EventIDMention EventID = "MENTION"
+	// EventIDRepost 'repost' event
+	EventIDRepost EventID = "REPOST"
+	// EventIDModerationWarning moderator warning event
+	EventIDModerationWarning EventID = "MODERATION_WARNING"
+	// EventIDPollClosed poll closed event
+	EventIDPollClosed EventID = "POLL_CLOSED"
+	// EventIDThreadSegment new segment of a subscribed thread event
+	EventIDThreadSegment EventID = "THREAD_SEGMENT"
 )
 
 const (
@@ -53,6 +65,18 @@ const (
 	EventTextComment EventText = "commented your post"
 	// EventTextFollow used on a `follow` event
 	EventTextFollow EventText = "started following you!"
+	// EventTextNewMessage used on a `new message` chat event
+	EventTextNewMessage EventText = "sent you a message"
+	// EventTextMention used on a `mention` event
+	EventTextMention EventText = "mentioned you"
+	// EventTextRepost used on a `repost` event
+	EventTextRepost EventText = "reposted your post!"
+	// EventTextModerationWarning used on a moderator warning event
+	EventTextModerationWarning EventText = "warned you about your content"
+	// EventTextPollClosed used on a poll closed event
+	EventTextPollClosed EventText = "your poll has closed"
+	// EventTextThreadSegment used on a new segment of a subscribed thread event
+	EventTextThreadSegment EventText = "continued a thread you follow"
 )
 
 // CreateNotification insert a new notification into db
@@ -111,3 +135,16 @@ func (d Directory) GetAllNotifications(ctx context.Context, userID string) ([]*v
 
 	return pgGetAllNotificationsListToPB(res)
 }
+
+// UpsertCollapsedNotification creates a collapsible notification, or atomically increments the events count
+// of the unread one with the same receiver, trigger id and event id, updating its date, sender and content
+// with the ones of the latest event.
+// Unread collapsible notifications hold a unique collapse key, which is cleared once they are read
+func (d Directory) UpsertCollapsedNotification(ctx context.Context, params UpsertCollapsedNotificationParams) (*v1API.Notification, error) {
+	res, err := d.querier.UpsertCollapsedNotification(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgNotificationToPB(CreateNotificationRow(res))
+}
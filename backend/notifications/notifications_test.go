@@ -3,10 +3,12 @@ package notifications_test
 import (
 	"context"
 	"database/sql"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/DagDigg/unpaper/backend/notifications"
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
 	v1Testing "github.com/DagDigg/unpaper/backend/pkg/service/v1/testing"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -36,3 +38,86 @@ func TestCreateNotification(t *testing.T) {
 		assert.Equal(usr.Username, n.UserWhoFiredEvent.Username)
 	})
 }
+
+func TestUpsertCollapsedNotification(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	ws := v1Testing.GetWrappedServer(t)
+	dir := notifications.NewDirectory(ws.Server.GetDB())
+	usr, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+	assert.Nil(err)
+
+	upsert := func(receiverID string, triggerID sql.NullString, content string) (*v1API.Notification, error) {
+		return dir.UpsertCollapsedNotification(context.Background(), notifications.UpsertCollapsedNotificationParams{
+			ID:                  uuid.NewString(),
+			UserIDToNotify:      receiverID,
+			UserIDWhoFiredEvent: usr.Id,
+			TriggerID:           triggerID,
+			EventID:             string(notifications.EventIDNewMessage),
+			Date:                time.Now(),
+			Content:             sql.NullString{String: content, Valid: true},
+		})
+	}
+
+	t.Run("When collapsing an unread notification", func(t *testing.T) {
+		receiverID := uuid.NewString()
+		triggerID := sql.NullString{String: uuid.NewString(), Valid: true}
+		n, err := upsert(receiverID, triggerID, "first message")
+		assert.Nil(err)
+		assert.Equal(int32(1), n.Count)
+
+		collapsed, err := upsert(receiverID, triggerID, "latest message")
+		assert.Nil(err)
+		assert.Equal(n.Id, collapsed.Id)
+		assert.Equal(int32(2), collapsed.Count)
+		assert.Equal("latest message", collapsed.Content)
+		assert.Equal(usr.Username, collapsed.UserWhoFiredEvent.Username)
+	})
+
+	t.Run("When collapsing concurrently", func(t *testing.T) {
+		receiverID := uuid.NewString()
+		triggerID := sql.NullString{String: uuid.NewString(), Valid: true}
+		const events = 10
+		var wg sync.WaitGroup
+		ids := make(chan string, events)
+		for i := 0; i < events; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				n, err := upsert(receiverID, triggerID, "message")
+				assert.Nil(err)
+				if n != nil {
+					ids <- n.Id
+				}
+			}()
+		}
+		wg.Wait()
+		close(ids)
+
+		unique := map[string]bool{}
+		for id := range ids {
+			unique[id] = true
+		}
+		assert.Len(unique, 1)
+
+		n, err := upsert(receiverID, triggerID, "message")
+		assert.Nil(err)
+		assert.Equal(int32(events+1), n.Count)
+	})
+
+	t.Run("When the notification has been read", func(t *testing.T) {
+		ctx := context.Background()
+		receiverID := uuid.NewString()
+		triggerID := sql.NullString{String: uuid.NewString(), Valid: true}
+		n, err := upsert(receiverID, triggerID, "first message")
+		assert.Nil(err)
+		_, err = dir.ReadNotification(ctx, n.Id)
+		assert.Nil(err)
+
+		next, err := upsert(receiverID, triggerID, "latest message")
+		assert.Nil(err)
+		assert.NotEqual(n.Id, next.Id)
+		assert.Equal(int32(1), next.Count)
+	})
+}
@@ -14,6 +14,7 @@ type Querier interface {
 	GetUnreadNotifications(ctx context.Context, userIDToNotify string) ([]GetUnreadNotificationsRow, error)
 	NotificationAlreadyExists(ctx context.Context, arg NotificationAlreadyExistsParams) (bool, error)
 	ReadNotification(ctx context.Context, id string) (ReadNotificationRow, error)
+	UpsertCollapsedNotification(ctx context.Context, arg UpsertCollapsedNotificationParams) (UpsertCollapsedNotificationRow, error)
 }
 
 var _ Querier = (*Queries)(nil)
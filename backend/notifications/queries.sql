@@ -22,7 +22,7 @@ WHERE user_id_to_notify=$1 AND read=false ORDER BY date DESC;
 -- name: ReadNotification :one
 WITH n AS (
 	UPDATE notifications ns
-	SET read=true 
+	SET read=true, collapse_key=NULL
 	WHERE ns.id=$1
 	RETURNING *
 )
@@ -58,4 +58,21 @@ UNION ALL
 SELECT n.*, u.username FROM notifications n JOIN users u
 ON u.id = n.user_id_who_fired_event
 WHERE n.user_id_to_notify=$1 AND n.read=false
-ORDER BY date DESC;
\ No newline at end of file
+ORDER BY date DESC;
+
+-- name: UpsertCollapsedNotification :one
+WITH n AS (
+	INSERT INTO notifications
+	(id, user_id_to_notify, user_id_who_fired_event, trigger_id, event_id, date, content, collapse_key)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, concat_ws(':', $2, $4, $5))
+	ON CONFLICT (collapse_key)
+	DO UPDATE SET
+	count=notifications.count+1,
+	date=EXCLUDED.date,
+	user_id_who_fired_event=EXCLUDED.user_id_who_fired_event,
+	content=EXCLUDED.content
+	RETURNING *
+)
+SELECT n.*, u.username
+FROM n
+JOIN users u ON n.user_id_who_fired_event = u.id;
@@ -14,9 +14,9 @@ WITH n AS (
     INSERT INTO notifications 
 	(id, user_id_to_notify, user_id_who_fired_event, trigger_id, event_id, date, content)
 	VALUES ($1, $2, $3, $4, $5, $6, $7)
-	RETURNING id, user_id_to_notify, user_id_who_fired_event, date, read, trigger_id, event_id, content
+	RETURNING id, user_id_to_notify, user_id_who_fired_event, date, read, trigger_id, event_id, content, count, collapse_key
 )
-SELECT n.id, n.user_id_to_notify, n.user_id_who_fired_event, n.date, n.read, n.trigger_id, n.event_id, n.content, u.username
+SELECT n.id, n.user_id_to_notify, n.user_id_who_fired_event, n.date, n.read, n.trigger_id, n.event_id, n.content, n.count, n.collapse_key, u.username
 FROM n
 JOIN users u ON n.user_id_who_fired_event = u.id
 `
@@ -40,6 +40,8 @@ type CreateNotificationRow struct {
 	TriggerID           sql.NullString
 	EventID             string
 	Content             sql.NullString
+	Count               int32
+	CollapseKey         sql.NullString
 	Username            sql.NullString
 }
 
@@ -63,17 +65,19 @@ func (q *Queries) CreateNotification(ctx context.Context, arg CreateNotification
 		&i.TriggerID,
 		&i.EventID,
 		&i.Content,
+		&i.Count,
+		&i.CollapseKey,
 		&i.Username,
 	)
 	return i, err
 }
 
 const getAllNotifications = `-- name: GetAllNotifications :many
-SELECT n.id, n.user_id_to_notify, n.user_id_who_fired_event, n.date, n.read, n.trigger_id, n.event_id, n.content, u.username FROM notifications n JOIN users u
+SELECT n.id, n.user_id_to_notify, n.user_id_who_fired_event, n.date, n.read, n.trigger_id, n.event_id, n.content, n.count, n.collapse_key, u.username FROM notifications n JOIN users u
 ON u.id = n.user_id_who_fired_event
 WHERE n.user_id_to_notify=$1 AND n.read=true
 UNION ALL
-SELECT n.id, n.user_id_to_notify, n.user_id_who_fired_event, n.date, n.read, n.trigger_id, n.event_id, n.content, u.username FROM notifications n JOIN users u
+SELECT n.id, n.user_id_to_notify, n.user_id_who_fired_event, n.date, n.read, n.trigger_id, n.event_id, n.content, n.count, n.collapse_key, u.username FROM notifications n JOIN users u
 ON u.id = n.user_id_who_fired_event
 WHERE n.user_id_to_notify=$1 AND n.read=false
 ORDER BY date DESC
@@ -88,6 +92,8 @@ type GetAllNotificationsRow struct {
 	TriggerID           sql.NullString
 	EventID             string
 	Content             sql.NullString
+	Count               int32
+	CollapseKey         sql.NullString
 	Username            sql.NullString
 }
 
@@ -109,6 +115,8 @@ func (q *Queries) GetAllNotifications(ctx context.Context, userIDToNotify string
 			&i.TriggerID,
 			&i.EventID,
 			&i.Content,
+			&i.Count,
+			&i.CollapseKey,
 			&i.Username,
 		); err != nil {
 			return nil, err
@@ -125,7 +133,7 @@ func (q *Queries) GetAllNotifications(ctx context.Context, userIDToNotify string
 }
 
 const getNotification = `-- name: GetNotification :one
-SELECT n.id, n.user_id_to_notify, n.user_id_who_fired_event, n.date, n.read, n.trigger_id, n.event_id, n.content, u.username FROM notifications n JOIN users u
+SELECT n.id, n.user_id_to_notify, n.user_id_who_fired_event, n.date, n.read, n.trigger_id, n.event_id, n.content, n.count, n.collapse_key, u.username FROM notifications n JOIN users u
 ON u.id = n.user_id_who_fired_event
 WHERE
 user_id_to_notify=$1 AND
@@ -150,6 +158,8 @@ type GetNotificationRow struct {
 	TriggerID           sql.NullString
 	EventID             string
 	Content             sql.NullString
+	Count               int32
+	CollapseKey         sql.NullString
 	Username            sql.NullString
 }
 
@@ -170,13 +180,15 @@ func (q *Queries) GetNotification(ctx context.Context, arg GetNotificationParams
 		&i.TriggerID,
 		&i.EventID,
 		&i.Content,
+		&i.Count,
+		&i.CollapseKey,
 		&i.Username,
 	)
 	return i, err
 }
 
 const getNotificationByID = `-- name: GetNotificationByID :one
-SELECT n.id, n.user_id_to_notify, n.user_id_who_fired_event, n.date, n.read, n.trigger_id, n.event_id, n.content, u.username FROM notifications n JOIN users u
+SELECT n.id, n.user_id_to_notify, n.user_id_who_fired_event, n.date, n.read, n.trigger_id, n.event_id, n.content, n.count, n.collapse_key, u.username FROM notifications n JOIN users u
 ON u.id = n.user_id_who_fired_event
 WHERE n.id=$1
 `
@@ -190,6 +202,8 @@ type GetNotificationByIDRow struct {
 	TriggerID           sql.NullString
 	EventID             string
 	Content             sql.NullString
+	Count               int32
+	CollapseKey         sql.NullString
 	Username            sql.NullString
 }
 
@@ -205,13 +219,15 @@ func (q *Queries) GetNotificationByID(ctx context.Context, id string) (GetNotifi
 		&i.TriggerID,
 		&i.EventID,
 		&i.Content,
+		&i.Count,
+		&i.CollapseKey,
 		&i.Username,
 	)
 	return i, err
 }
 
 const getUnreadNotifications = `-- name: GetUnreadNotifications :many
-SELECT n.id, n.user_id_to_notify, n.user_id_who_fired_event, n.date, n.read, n.trigger_id, n.event_id, n.content, u.username FROM notifications n JOIN users u
+SELECT n.id, n.user_id_to_notify, n.user_id_who_fired_event, n.date, n.read, n.trigger_id, n.event_id, n.content, n.count, n.collapse_key, u.username FROM notifications n JOIN users u
 ON u.id = n.user_id_who_fired_event
 WHERE user_id_to_notify=$1 AND read=false ORDER BY date DESC
 `
@@ -225,6 +241,8 @@ type GetUnreadNotificationsRow struct {
 	TriggerID           sql.NullString
 	EventID             string
 	Content             sql.NullString
+	Count               int32
+	CollapseKey         sql.NullString
 	Username            sql.NullString
 }
 
@@ -246,6 +264,8 @@ func (q *Queries) GetUnreadNotifications(ctx context.Context, userIDToNotify str
 			&i.TriggerID,
 			&i.EventID,
 			&i.Content,
+			&i.Count,
+			&i.CollapseKey,
 			&i.Username,
 		); err != nil {
 			return nil, err
@@ -294,11 +314,11 @@ func (q *Queries) NotificationAlreadyExists(ctx context.Context, arg Notificatio
 const readNotification = `-- name: ReadNotification :one
 WITH n AS (
 	UPDATE notifications ns
-	SET read=true 
+	SET read=true, collapse_key=NULL
 	WHERE ns.id=$1
-	RETURNING id, user_id_to_notify, user_id_who_fired_event, date, read, trigger_id, event_id, content
+	RETURNING id, user_id_to_notify, user_id_who_fired_event, date, read, trigger_id, event_id, content, count, collapse_key
 )
-SELECT n.id, n.user_id_to_notify, n.user_id_who_fired_event, n.date, n.read, n.trigger_id, n.event_id, n.content, u.username
+SELECT n.id, n.user_id_to_notify, n.user_id_who_fired_event, n.date, n.read, n.trigger_id, n.event_id, n.content, n.count, n.collapse_key, u.username
 FROM n
 JOIN users u ON n.user_id_who_fired_event = u.id
 `
@@ -312,6 +332,8 @@ type ReadNotificationRow struct {
 	TriggerID           sql.NullString
 	EventID             string
 	Content             sql.NullString
+	Count               int32
+	CollapseKey         sql.NullString
 	Username            sql.NullString
 }
 
@@ -327,6 +349,77 @@ func (q *Queries) ReadNotification(ctx context.Context, id string) (ReadNotifica
 		&i.TriggerID,
 		&i.EventID,
 		&i.Content,
+		&i.Count,
+		&i.CollapseKey,
+		&i.Username,
+	)
+	return i, err
+}
+
+const upsertCollapsedNotification = `-- name: UpsertCollapsedNotification :one
+WITH n AS (
+	INSERT INTO notifications
+	(id, user_id_to_notify, user_id_who_fired_event, trigger_id, event_id, date, content, collapse_key)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, concat_ws(':', $2, $4, $5))
+	ON CONFLICT (collapse_key)
+	DO UPDATE SET
+	count=notifications.count+1,
+	date=EXCLUDED.date,
+	user_id_who_fired_event=EXCLUDED.user_id_who_fired_event,
+	content=EXCLUDED.content
+	RETURNING id, user_id_to_notify, user_id_who_fired_event, date, read, trigger_id, event_id, content, count, collapse_key
+)
+SELECT n.id, n.user_id_to_notify, n.user_id_who_fired_event, n.date, n.read, n.trigger_id, n.event_id, n.content, n.count, n.collapse_key, u.username
+FROM n
+JOIN users u ON n.user_id_who_fired_event = u.id
+`
+
+type UpsertCollapsedNotificationParams struct {
+	ID                  string
+	UserIDToNotify      string
+	UserIDWhoFiredEvent string
+	TriggerID           sql.NullString
+	EventID             string
+	Date                time.Time
+	Content             sql.NullString
+}
+
+type UpsertCollapsedNotificationRow struct {
+	ID                  string
+	UserIDToNotify      string
+	UserIDWhoFiredEvent string
+	Date                time.Time
+	Read                bool
+	TriggerID           sql.NullString
+	EventID             string
+	Content             sql.NullString
+	Count               int32
+	CollapseKey         sql.NullString
+	Username            sql.NullString
+}
+
+func (q *Queries) UpsertCollapsedNotification(ctx context.Context, arg UpsertCollapsedNotificationParams) (UpsertCollapsedNotificationRow, error) {
+	row := q.db.QueryRowContext(ctx, upsertCollapsedNotification,
+		arg.ID,
+		arg.UserIDToNotify,
+		arg.UserIDWhoFiredEvent,
+		arg.TriggerID,
+		arg.EventID,
+		arg.Date,
+		arg.Content,
+	)
+	var i UpsertCollapsedNotificationRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserIDToNotify,
+		&i.UserIDWhoFiredEvent,
+		&i.Date,
+		&i.Read,
+		&i.TriggerID,
+		&i.EventID,
+		&i.Content,
+		&i.Count,
+		&i.CollapseKey,
 		&i.Username,
 	)
 	return i, err
@@ -67,6 +67,58 @@ func (UserType) EnumDescriptor() ([]byte, []int) {
 	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{0}
 }
 
+type MessageRequestsPolicy_Enum int32
+
+const (
+	// Conversations started by users not followed are requests
+	MessageRequestsPolicy_FOLLOWED_ONLY MessageRequestsPolicy_Enum = 0
+	// Anyone can start a conversation directly
+	MessageRequestsPolicy_EVERYONE MessageRequestsPolicy_Enum = 1
+	// Every new conversation is a request
+	MessageRequestsPolicy_NOBODY MessageRequestsPolicy_Enum = 2
+)
+
+// Enum value maps for MessageRequestsPolicy_Enum.
+var (
+	MessageRequestsPolicy_Enum_name = map[int32]string{
+		0: "FOLLOWED_ONLY",
+		1: "EVERYONE",
+		2: "NOBODY",
+	}
+	MessageRequestsPolicy_Enum_value = map[string]int32{
+		"FOLLOWED_ONLY": 0,
+		"EVERYONE":      1,
+		"NOBODY":        2,
+	}
+)
+
+func (x MessageRequestsPolicy_Enum) Enum() *MessageRequestsPolicy_Enum {
+	p := new(MessageRequestsPolicy_Enum)
+	*p = x
+	return p
+}
+
+func (x MessageRequestsPolicy_Enum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageRequestsPolicy_Enum) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_v1_auth_proto_enumTypes[1].Descriptor()
+}
+
+func (MessageRequestsPolicy_Enum) Type() protoreflect.EnumType {
+	return &file_api_proto_v1_auth_proto_enumTypes[1]
+}
+
+func (x MessageRequestsPolicy_Enum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageRequestsPolicy_Enum.Descriptor instead.
+func (MessageRequestsPolicy_Enum) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{12, 0}
+}
+
 type GoogleLoginRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -685,14 +737,15 @@ type User struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Api           string   `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
-	Id            string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Email         string   `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	GivenName     string   `protobuf:"bytes,4,opt,name=given_name,json=givenName,proto3" json:"given_name,omitempty"`
-	FamilyName    string   `protobuf:"bytes,5,opt,name=family_name,json=familyName,proto3" json:"family_name,omitempty"`
-	EmailVerified bool     `protobuf:"varint,6,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
-	Type          UserType `protobuf:"varint,7,opt,name=type,proto3,enum=v1.UserType" json:"type,omitempty"`
-	Username      string   `protobuf:"bytes,8,opt,name=username,proto3" json:"username,omitempty"`
+	Api                   string                     `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Id                    string                     `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Email                 string                     `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	GivenName             string                     `protobuf:"bytes,4,opt,name=given_name,json=givenName,proto3" json:"given_name,omitempty"`
+	FamilyName            string                     `protobuf:"bytes,5,opt,name=family_name,json=familyName,proto3" json:"family_name,omitempty"`
+	EmailVerified         bool                       `protobuf:"varint,6,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	Type                  UserType                   `protobuf:"varint,7,opt,name=type,proto3,enum=v1.UserType" json:"type,omitempty"`
+	Username              string                     `protobuf:"bytes,8,opt,name=username,proto3" json:"username,omitempty"`
+	MessageRequestsPolicy MessageRequestsPolicy_Enum `protobuf:"varint,9,opt,name=message_requests_policy,json=messageRequestsPolicy,proto3,enum=v1.MessageRequestsPolicy_Enum" json:"message_requests_policy,omitempty"`
 }
 
 func (x *User) Reset() {
@@ -783,6 +836,52 @@ func (x *User) GetUsername() string {
 	return ""
 }
 
+func (x *User) GetMessageRequestsPolicy() MessageRequestsPolicy_Enum {
+	if x != nil {
+		return x.MessageRequestsPolicy
+	}
+	return MessageRequestsPolicy_FOLLOWED_ONLY
+}
+
+// MessageRequestsPolicy denotes which new conversations are routed to the requests inbox
+type MessageRequestsPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MessageRequestsPolicy) Reset() {
+	*x = MessageRequestsPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_v1_auth_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageRequestsPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageRequestsPolicy) ProtoMessage() {}
+
+func (x *MessageRequestsPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_auth_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageRequestsPolicy.ProtoReflect.Descriptor instead.
+func (*MessageRequestsPolicy) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{12}
+}
+
 type UpdateUsernameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -794,7 +893,7 @@ type UpdateUsernameRequest struct {
 func (x *UpdateUsernameRequest) Reset() {
 	*x = UpdateUsernameRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_v1_auth_proto_msgTypes[12]
+		mi := &file_api_proto_v1_auth_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -807,7 +906,7 @@ func (x *UpdateUsernameRequest) String() string {
 func (*UpdateUsernameRequest) ProtoMessage() {}
 
 func (x *UpdateUsernameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_auth_proto_msgTypes[12]
+	mi := &file_api_proto_v1_auth_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -820,7 +919,7 @@ func (x *UpdateUsernameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUsernameRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUsernameRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{12}
+	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateUsernameRequest) GetUsername() string {
@@ -830,6 +929,53 @@ func (x *UpdateUsernameRequest) GetUsername() string {
 	return ""
 }
 
+type SetMessageRequestsPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy MessageRequestsPolicy_Enum `protobuf:"varint,1,opt,name=policy,proto3,enum=v1.MessageRequestsPolicy_Enum" json:"policy,omitempty"`
+}
+
+func (x *SetMessageRequestsPolicyRequest) Reset() {
+	*x = SetMessageRequestsPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_v1_auth_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMessageRequestsPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMessageRequestsPolicyRequest) ProtoMessage() {}
+
+func (x *SetMessageRequestsPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_auth_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMessageRequestsPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetMessageRequestsPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetMessageRequestsPolicyRequest) GetPolicy() MessageRequestsPolicy_Enum {
+	if x != nil {
+		return x.Policy
+	}
+	return MessageRequestsPolicy_FOLLOWED_ONLY
+}
+
 type ExtUserInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -842,7 +988,7 @@ type ExtUserInfoRequest struct {
 func (x *ExtUserInfoRequest) Reset() {
 	*x = ExtUserInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_v1_auth_proto_msgTypes[13]
+		mi := &file_api_proto_v1_auth_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -855,7 +1001,7 @@ func (x *ExtUserInfoRequest) String() string {
 func (*ExtUserInfoRequest) ProtoMessage() {}
 
 func (x *ExtUserInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_auth_proto_msgTypes[13]
+	mi := &file_api_proto_v1_auth_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -868,7 +1014,7 @@ func (x *ExtUserInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtUserInfoRequest.ProtoReflect.Descriptor instead.
 func (*ExtUserInfoRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{13}
+	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ExtUserInfoRequest) GetUserId() string {
@@ -901,7 +1047,7 @@ type ExtUserInfo struct {
 func (x *ExtUserInfo) Reset() {
 	*x = ExtUserInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_v1_auth_proto_msgTypes[14]
+		mi := &file_api_proto_v1_auth_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -914,7 +1060,7 @@ func (x *ExtUserInfo) String() string {
 func (*ExtUserInfo) ProtoMessage() {}
 
 func (x *ExtUserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_auth_proto_msgTypes[14]
+	mi := &file_api_proto_v1_auth_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -927,7 +1073,7 @@ func (x *ExtUserInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtUserInfo.ProtoReflect.Descriptor instead.
 func (*ExtUserInfo) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{14}
+	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ExtUserInfo) GetId() string {
@@ -984,7 +1130,7 @@ type CustomerInfo struct {
 func (x *CustomerInfo) Reset() {
 	*x = CustomerInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_v1_auth_proto_msgTypes[15]
+		mi := &file_api_proto_v1_auth_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -997,7 +1143,7 @@ func (x *CustomerInfo) String() string {
 func (*CustomerInfo) ProtoMessage() {}
 
 func (x *CustomerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_auth_proto_msgTypes[15]
+	mi := &file_api_proto_v1_auth_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1010,7 +1156,7 @@ func (x *CustomerInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomerInfo.ProtoReflect.Descriptor instead.
 func (*CustomerInfo) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{15}
+	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CustomerInfo) GetHasConnectedAccount() bool {
@@ -1038,7 +1184,7 @@ type ExtUserInfoResponse struct {
 func (x *ExtUserInfoResponse) Reset() {
 	*x = ExtUserInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_v1_auth_proto_msgTypes[16]
+		mi := &file_api_proto_v1_auth_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1051,7 +1197,7 @@ func (x *ExtUserInfoResponse) String() string {
 func (*ExtUserInfoResponse) ProtoMessage() {}
 
 func (x *ExtUserInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_auth_proto_msgTypes[16]
+	mi := &file_api_proto_v1_auth_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1064,7 +1210,7 @@ func (x *ExtUserInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtUserInfoResponse.ProtoReflect.Descriptor instead.
 func (*ExtUserInfoResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{16}
+	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ExtUserInfoResponse) GetUserInfo() *ExtUserInfo {
@@ -1086,7 +1232,7 @@ type ExtUserCustomerInfoResponse struct {
 func (x *ExtUserCustomerInfoResponse) Reset() {
 	*x = ExtUserCustomerInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_v1_auth_proto_msgTypes[17]
+		mi := &file_api_proto_v1_auth_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1099,7 +1245,7 @@ func (x *ExtUserCustomerInfoResponse) String() string {
 func (*ExtUserCustomerInfoResponse) ProtoMessage() {}
 
 func (x *ExtUserCustomerInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_auth_proto_msgTypes[17]
+	mi := &file_api_proto_v1_auth_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1112,7 +1258,7 @@ func (x *ExtUserCustomerInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtUserCustomerInfoResponse.ProtoReflect.Descriptor instead.
 func (*ExtUserCustomerInfoResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{17}
+	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ExtUserCustomerInfoResponse) GetUserInfo() *ExtUserInfo {
@@ -1140,7 +1286,7 @@ type FollowUserRequest struct {
 func (x *FollowUserRequest) Reset() {
 	*x = FollowUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_v1_auth_proto_msgTypes[18]
+		mi := &file_api_proto_v1_auth_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1153,7 +1299,7 @@ func (x *FollowUserRequest) String() string {
 func (*FollowUserRequest) ProtoMessage() {}
 
 func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_auth_proto_msgTypes[18]
+	mi := &file_api_proto_v1_auth_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1166,7 +1312,7 @@ func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FollowUserRequest.ProtoReflect.Descriptor instead.
 func (*FollowUserRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{18}
+	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *FollowUserRequest) GetUserIdToFollow() string {
@@ -1176,6 +1322,100 @@ func (x *FollowUserRequest) GetUserIdToFollow() string {
 	return ""
 }
 
+type BlockUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_v1_auth_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserRequest) ProtoMessage() {}
+
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_auth_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BlockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnblockUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *UnblockUserRequest) Reset() {
+	*x = UnblockUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_v1_auth_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserRequest) ProtoMessage() {}
+
+func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_auth_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnblockUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UnblockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 type GetFollowingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1187,7 +1427,7 @@ type GetFollowingRequest struct {
 func (x *GetFollowingRequest) Reset() {
 	*x = GetFollowingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_v1_auth_proto_msgTypes[19]
+		mi := &file_api_proto_v1_auth_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1200,7 +1440,7 @@ func (x *GetFollowingRequest) String() string {
 func (*GetFollowingRequest) ProtoMessage() {}
 
 func (x *GetFollowingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_auth_proto_msgTypes[19]
+	mi := &file_api_proto_v1_auth_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1213,7 +1453,7 @@ func (x *GetFollowingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFollowingRequest.ProtoReflect.Descriptor instead.
 func (*GetFollowingRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{19}
+	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetFollowingRequest) GetUserId() string {
@@ -1234,7 +1474,7 @@ type GetFollowingResponse struct {
 func (x *GetFollowingResponse) Reset() {
 	*x = GetFollowingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_v1_auth_proto_msgTypes[20]
+		mi := &file_api_proto_v1_auth_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1247,7 +1487,7 @@ func (x *GetFollowingResponse) String() string {
 func (*GetFollowingResponse) ProtoMessage() {}
 
 func (x *GetFollowingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_auth_proto_msgTypes[20]
+	mi := &file_api_proto_v1_auth_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1260,7 +1500,7 @@ func (x *GetFollowingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFollowingResponse.ProtoReflect.Descriptor instead.
 func (*GetFollowingResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{20}
+	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetFollowingResponse) GetUsers() []*ExtUserInfo {
@@ -1281,7 +1521,7 @@ type GetFollowersRequest struct {
 func (x *GetFollowersRequest) Reset() {
 	*x = GetFollowersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_v1_auth_proto_msgTypes[21]
+		mi := &file_api_proto_v1_auth_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1294,7 +1534,7 @@ func (x *GetFollowersRequest) String() string {
 func (*GetFollowersRequest) ProtoMessage() {}
 
 func (x *GetFollowersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_auth_proto_msgTypes[21]
+	mi := &file_api_proto_v1_auth_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1307,7 +1547,7 @@ func (x *GetFollowersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFollowersRequest.ProtoReflect.Descriptor instead.
 func (*GetFollowersRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{21}
+	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetFollowersRequest) GetUserId() string {
@@ -1328,7 +1568,7 @@ type GetFollowersResponse struct {
 func (x *GetFollowersResponse) Reset() {
 	*x = GetFollowersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_v1_auth_proto_msgTypes[22]
+		mi := &file_api_proto_v1_auth_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1341,7 +1581,7 @@ func (x *GetFollowersResponse) String() string {
 func (*GetFollowersResponse) ProtoMessage() {}
 
 func (x *GetFollowersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_auth_proto_msgTypes[22]
+	mi := &file_api_proto_v1_auth_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1354,7 +1594,7 @@ func (x *GetFollowersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFollowersResponse.ProtoReflect.Descriptor instead.
 func (*GetFollowersResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{22}
+	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetFollowersResponse) GetUsers() []*ExtUserInfo {
@@ -1375,7 +1615,7 @@ type GetFollowersCountRequest struct {
 func (x *GetFollowersCountRequest) Reset() {
 	*x = GetFollowersCountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_v1_auth_proto_msgTypes[23]
+		mi := &file_api_proto_v1_auth_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1388,7 +1628,7 @@ func (x *GetFollowersCountRequest) String() string {
 func (*GetFollowersCountRequest) ProtoMessage() {}
 
 func (x *GetFollowersCountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_auth_proto_msgTypes[23]
+	mi := &file_api_proto_v1_auth_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1401,7 +1641,7 @@ func (x *GetFollowersCountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFollowersCountRequest.ProtoReflect.Descriptor instead.
 func (*GetFollowersCountRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{23}
+	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetFollowersCountRequest) GetUserId() string {
@@ -1422,7 +1662,7 @@ type GetFollowersCountResponse struct {
 func (x *GetFollowersCountResponse) Reset() {
 	*x = GetFollowersCountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_v1_auth_proto_msgTypes[24]
+		mi := &file_api_proto_v1_auth_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1435,7 +1675,7 @@ func (x *GetFollowersCountResponse) String() string {
 func (*GetFollowersCountResponse) ProtoMessage() {}
 
 func (x *GetFollowersCountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_auth_proto_msgTypes[24]
+	mi := &file_api_proto_v1_auth_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1448,7 +1688,7 @@ func (x *GetFollowersCountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFollowersCountResponse.ProtoReflect.Descriptor instead.
 func (*GetFollowersCountResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{24}
+	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetFollowersCountResponse) GetFollowersCount() int64 {
@@ -1469,7 +1709,7 @@ type GetFollowingCountRequest struct {
 func (x *GetFollowingCountRequest) Reset() {
 	*x = GetFollowingCountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_v1_auth_proto_msgTypes[25]
+		mi := &file_api_proto_v1_auth_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1482,7 +1722,7 @@ func (x *GetFollowingCountRequest) String() string {
 func (*GetFollowingCountRequest) ProtoMessage() {}
 
 func (x *GetFollowingCountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_auth_proto_msgTypes[25]
+	mi := &file_api_proto_v1_auth_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1495,7 +1735,7 @@ func (x *GetFollowingCountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFollowingCountRequest.ProtoReflect.Descriptor instead.
 func (*GetFollowingCountRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{25}
+	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetFollowingCountRequest) GetUserId() string {
@@ -1516,7 +1756,7 @@ type GetFollowingCountResponse struct {
 func (x *GetFollowingCountResponse) Reset() {
 	*x = GetFollowingCountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_v1_auth_proto_msgTypes[26]
+		mi := &file_api_proto_v1_auth_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1529,7 +1769,7 @@ func (x *GetFollowingCountResponse) String() string {
 func (*GetFollowingCountResponse) ProtoMessage() {}
 
 func (x *GetFollowingCountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_auth_proto_msgTypes[26]
+	mi := &file_api_proto_v1_auth_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1542,7 +1782,7 @@ func (x *GetFollowingCountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFollowingCountResponse.ProtoReflect.Descriptor instead.
 func (*GetFollowingCountResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{26}
+	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetFollowingCountResponse) GetFollowingCount() int64 {
@@ -1609,8 +1849,8 @@ var file_api_proto_v1_auth_proto_rawDesc = []byte{
 	0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77,
 	0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61,
 	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x22, 0xe3,
-	0x01, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x01,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x22, 0xbb,
+	0x02, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
 	0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
@@ -1624,82 +1864,103 @@ var file_api_proto_v1_auth_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x54, 0x79,
 	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
 	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x33, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x49, 0x0a, 0x12, 0x45, 0x78, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x0b, 0x45, 0x78, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x69,
-	0x76, 0x65, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x67, 0x69, 0x76, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x61, 0x6d,
-	0x69, 0x6c, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x66, 0x6f, 0x6c,
-	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x46,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x22, 0x61, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x32, 0x0a, 0x15, 0x68, 0x61, 0x73, 0x5f, 0x63,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x68, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x43, 0x0a, 0x13, 0x45, 0x78,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22,
-	0x82, 0x01, 0x0a, 0x1b, 0x45, 0x78, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x35, 0x0a,
-	0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x3e, 0x0a, 0x11, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x11, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x54, 0x6f, 0x46, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x22, 0x2e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x22, 0x3d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x78, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x22, 0x2e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0x3d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x78, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x22, 0x33, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
-	0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x46, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72,
-	0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x33, 0x0a,
-	0x18, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x56, 0x0a, 0x17, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x4c, 0x0a, 0x15,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x33, 0x0a, 0x04, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x11, 0x0a,
+	0x0d, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x00,
+	0x12, 0x0c, 0x0a, 0x08, 0x45, 0x56, 0x45, 0x52, 0x59, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x0a,
+	0x0a, 0x06, 0x4e, 0x4f, 0x42, 0x4f, 0x44, 0x59, 0x10, 0x02, 0x22, 0x33, 0x0a, 0x15, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x59, 0x0a, 0x1f, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x45, 0x6e,
+	0x75, 0x6d, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x49, 0x0a, 0x12, 0x45, 0x78,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x0b, 0x45, 0x78, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x67,
+	0x69, 0x76, 0x65, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x61,
+	0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x22, 0x61, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x32, 0x0a, 0x15, 0x68, 0x61, 0x73, 0x5f,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x68, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x43, 0x0a, 0x13, 0x45,
+	0x78, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0x82, 0x01, 0x0a, 0x1b, 0x45, 0x78, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x35,
+	0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x3e, 0x0a, 0x11, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x11, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x54, 0x6f, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x22, 0x2b, 0x0a, 0x10, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
 	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x22, 0x44, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69,
-	0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x27, 0x0a, 0x0f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x2a, 0x23, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x00,
-	0x12, 0x0b, 0x0a, 0x07, 0x43, 0x52, 0x45, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x01, 0x42, 0x0c, 0x5a,
-	0x0a, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x49, 0x64, 0x22, 0x2d, 0x0a, 0x12, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x2e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x3d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x22, 0x2e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x3d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22,
+	0x33, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x33, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x44, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x2a, 0x23, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0b, 0x0a,
+	0x07, 0x43, 0x52, 0x45, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x01, 0x42, 0x0c, 0x5a, 0x0a, 0x70, 0x6b,
+	0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1714,50 +1975,57 @@ func file_api_proto_v1_auth_proto_rawDescGZIP() []byte {
 	return file_api_proto_v1_auth_proto_rawDescData
 }
 
-var file_api_proto_v1_auth_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_proto_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_api_proto_v1_auth_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_proto_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_api_proto_v1_auth_proto_goTypes = []interface{}{
-	(UserType)(0),                       // 0: v1.UserType
-	(*GoogleLoginRequest)(nil),          // 1: v1.GoogleLoginRequest
-	(*GoogleLoginResponse)(nil),         // 2: v1.GoogleLoginResponse
-	(*GoogleCallbackRequest)(nil),       // 3: v1.GoogleCallbackRequest
-	(*EmailSignupRequest)(nil),          // 4: v1.EmailSignupRequest
-	(*EmailSigninRequest)(nil),          // 5: v1.EmailSigninRequest
-	(*EmailVerifyRequest)(nil),          // 6: v1.EmailVerifyRequest
-	(*ChangePasswordRequest)(nil),       // 7: v1.ChangePasswordRequest
-	(*UserInfoRequest)(nil),             // 8: v1.UserInfoRequest
-	(*EmailCheckRequest)(nil),           // 9: v1.EmailCheckRequest
-	(*SendResetLinkRequest)(nil),        // 10: v1.SendResetLinkRequest
-	(*ResetPasswordRequest)(nil),        // 11: v1.ResetPasswordRequest
-	(*User)(nil),                        // 12: v1.User
-	(*UpdateUsernameRequest)(nil),       // 13: v1.UpdateUsernameRequest
-	(*ExtUserInfoRequest)(nil),          // 14: v1.ExtUserInfoRequest
-	(*ExtUserInfo)(nil),                 // 15: v1.ExtUserInfo
-	(*CustomerInfo)(nil),                // 16: v1.CustomerInfo
-	(*ExtUserInfoResponse)(nil),         // 17: v1.ExtUserInfoResponse
-	(*ExtUserCustomerInfoResponse)(nil), // 18: v1.ExtUserCustomerInfoResponse
-	(*FollowUserRequest)(nil),           // 19: v1.FollowUserRequest
-	(*GetFollowingRequest)(nil),         // 20: v1.GetFollowingRequest
-	(*GetFollowingResponse)(nil),        // 21: v1.GetFollowingResponse
-	(*GetFollowersRequest)(nil),         // 22: v1.GetFollowersRequest
-	(*GetFollowersResponse)(nil),        // 23: v1.GetFollowersResponse
-	(*GetFollowersCountRequest)(nil),    // 24: v1.GetFollowersCountRequest
-	(*GetFollowersCountResponse)(nil),   // 25: v1.GetFollowersCountResponse
-	(*GetFollowingCountRequest)(nil),    // 26: v1.GetFollowingCountRequest
-	(*GetFollowingCountResponse)(nil),   // 27: v1.GetFollowingCountResponse
+	(UserType)(0),                           // 0: v1.UserType
+	(MessageRequestsPolicy_Enum)(0),         // 1: v1.MessageRequestsPolicy.Enum
+	(*GoogleLoginRequest)(nil),              // 2: v1.GoogleLoginRequest
+	(*GoogleLoginResponse)(nil),             // 3: v1.GoogleLoginResponse
+	(*GoogleCallbackRequest)(nil),           // 4: v1.GoogleCallbackRequest
+	(*EmailSignupRequest)(nil),              // 5: v1.EmailSignupRequest
+	(*EmailSigninRequest)(nil),              // 6: v1.EmailSigninRequest
+	(*EmailVerifyRequest)(nil),              // 7: v1.EmailVerifyRequest
+	(*ChangePasswordRequest)(nil),           // 8: v1.ChangePasswordRequest
+	(*UserInfoRequest)(nil),                 // 9: v1.UserInfoRequest
+	(*EmailCheckRequest)(nil),               // 10: v1.EmailCheckRequest
+	(*SendResetLinkRequest)(nil),            // 11: v1.SendResetLinkRequest
+	(*ResetPasswordRequest)(nil),            // 12: v1.ResetPasswordRequest
+	(*User)(nil),                            // 13: v1.User
+	(*MessageRequestsPolicy)(nil),           // 14: v1.MessageRequestsPolicy
+	(*UpdateUsernameRequest)(nil),           // 15: v1.UpdateUsernameRequest
+	(*SetMessageRequestsPolicyRequest)(nil), // 16: v1.SetMessageRequestsPolicyRequest
+	(*ExtUserInfoRequest)(nil),              // 17: v1.ExtUserInfoRequest
+	(*ExtUserInfo)(nil),                     // 18: v1.ExtUserInfo
+	(*CustomerInfo)(nil),                    // 19: v1.CustomerInfo
+	(*ExtUserInfoResponse)(nil),             // 20: v1.ExtUserInfoResponse
+	(*ExtUserCustomerInfoResponse)(nil),     // 21: v1.ExtUserCustomerInfoResponse
+	(*FollowUserRequest)(nil),               // 22: v1.FollowUserRequest
+	(*BlockUserRequest)(nil),                // 23: v1.BlockUserRequest
+	(*UnblockUserRequest)(nil),              // 24: v1.UnblockUserRequest
+	(*GetFollowingRequest)(nil),             // 25: v1.GetFollowingRequest
+	(*GetFollowingResponse)(nil),            // 26: v1.GetFollowingResponse
+	(*GetFollowersRequest)(nil),             // 27: v1.GetFollowersRequest
+	(*GetFollowersResponse)(nil),            // 28: v1.GetFollowersResponse
+	(*GetFollowersCountRequest)(nil),        // 29: v1.GetFollowersCountRequest
+	(*GetFollowersCountResponse)(nil),       // 30: v1.GetFollowersCountResponse
+	(*GetFollowingCountRequest)(nil),        // 31: v1.GetFollowingCountRequest
+	(*GetFollowingCountResponse)(nil),       // 32: v1.GetFollowingCountResponse
 }
 var file_api_proto_v1_auth_proto_depIdxs = []int32{
 	0,  // 0: v1.User.type:type_name -> v1.UserType
-	15, // 1: v1.ExtUserInfoResponse.user_info:type_name -> v1.ExtUserInfo
-	15, // 2: v1.ExtUserCustomerInfoResponse.user_info:type_name -> v1.ExtUserInfo
-	16, // 3: v1.ExtUserCustomerInfoResponse.customer_info:type_name -> v1.CustomerInfo
-	15, // 4: v1.GetFollowingResponse.users:type_name -> v1.ExtUserInfo
-	15, // 5: v1.GetFollowersResponse.users:type_name -> v1.ExtUserInfo
-	6,  // [6:6] is the sub-list for method output_type
-	6,  // [6:6] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	1,  // 1: v1.User.message_requests_policy:type_name -> v1.MessageRequestsPolicy.Enum
+	1,  // 2: v1.SetMessageRequestsPolicyRequest.policy:type_name -> v1.MessageRequestsPolicy.Enum
+	18, // 3: v1.ExtUserInfoResponse.user_info:type_name -> v1.ExtUserInfo
+	18, // 4: v1.ExtUserCustomerInfoResponse.user_info:type_name -> v1.ExtUserInfo
+	19, // 5: v1.ExtUserCustomerInfoResponse.customer_info:type_name -> v1.CustomerInfo
+	18, // 6: v1.GetFollowingResponse.users:type_name -> v1.ExtUserInfo
+	18, // 7: v1.GetFollowersResponse.users:type_name -> v1.ExtUserInfo
+	8,  // [8:8] is the sub-list for method output_type
+	8,  // [8:8] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_v1_auth_proto_init() }
@@ -1911,7 +2179,7 @@ func file_api_proto_v1_auth_proto_init() {
 			}
 		}
 		file_api_proto_v1_auth_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateUsernameRequest); i {
+			switch v := v.(*MessageRequestsPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1923,7 +2191,7 @@ func file_api_proto_v1_auth_proto_init() {
 			}
 		}
 		file_api_proto_v1_auth_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExtUserInfoRequest); i {
+			switch v := v.(*UpdateUsernameRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1935,7 +2203,7 @@ func file_api_proto_v1_auth_proto_init() {
 			}
 		}
 		file_api_proto_v1_auth_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExtUserInfo); i {
+			switch v := v.(*SetMessageRequestsPolicyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1947,7 +2215,7 @@ func file_api_proto_v1_auth_proto_init() {
 			}
 		}
 		file_api_proto_v1_auth_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CustomerInfo); i {
+			switch v := v.(*ExtUserInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1959,7 +2227,7 @@ func file_api_proto_v1_auth_proto_init() {
 			}
 		}
 		file_api_proto_v1_auth_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExtUserInfoResponse); i {
+			switch v := v.(*ExtUserInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1971,7 +2239,7 @@ func file_api_proto_v1_auth_proto_init() {
 			}
 		}
 		file_api_proto_v1_auth_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExtUserCustomerInfoResponse); i {
+			switch v := v.(*CustomerInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1983,7 +2251,7 @@ func file_api_proto_v1_auth_proto_init() {
 			}
 		}
 		file_api_proto_v1_auth_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FollowUserRequest); i {
+			switch v := v.(*ExtUserInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1995,7 +2263,7 @@ func file_api_proto_v1_auth_proto_init() {
 			}
 		}
 		file_api_proto_v1_auth_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFollowingRequest); i {
+			switch v := v.(*ExtUserCustomerInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2007,7 +2275,7 @@ func file_api_proto_v1_auth_proto_init() {
 			}
 		}
 		file_api_proto_v1_auth_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFollowingResponse); i {
+			switch v := v.(*FollowUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2019,7 +2287,7 @@ func file_api_proto_v1_auth_proto_init() {
 			}
 		}
 		file_api_proto_v1_auth_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFollowersRequest); i {
+			switch v := v.(*BlockUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2031,7 +2299,7 @@ func file_api_proto_v1_auth_proto_init() {
 			}
 		}
 		file_api_proto_v1_auth_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFollowersResponse); i {
+			switch v := v.(*UnblockUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2043,7 +2311,7 @@ func file_api_proto_v1_auth_proto_init() {
 			}
 		}
 		file_api_proto_v1_auth_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFollowersCountRequest); i {
+			switch v := v.(*GetFollowingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2055,7 +2323,7 @@ func file_api_proto_v1_auth_proto_init() {
 			}
 		}
 		file_api_proto_v1_auth_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFollowersCountResponse); i {
+			switch v := v.(*GetFollowingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2067,7 +2335,7 @@ func file_api_proto_v1_auth_proto_init() {
 			}
 		}
 		file_api_proto_v1_auth_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFollowingCountRequest); i {
+			switch v := v.(*GetFollowersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2079,6 +2347,54 @@ func file_api_proto_v1_auth_proto_init() {
 			}
 		}
 		file_api_proto_v1_auth_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFollowersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_v1_auth_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFollowersCountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_v1_auth_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFollowersCountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_v1_auth_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFollowingCountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_v1_auth_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetFollowingCountResponse); i {
 			case 0:
 				return &v.state
@@ -2096,8 +2412,8 @@ func file_api_proto_v1_auth_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_proto_v1_auth_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   27,
+			NumEnums:      2,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
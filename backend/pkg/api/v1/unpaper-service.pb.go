@@ -97,332 +97,552 @@ var file_api_proto_v1_unpaper_service_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x61, 0x70, 0x69,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x78, 0x65, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1f, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x61, 0x70, 0x69, 0x32, 0xe6, 0x26, 0x0a, 0x0e, 0x55, 0x6e, 0x70, 0x61, 0x70,
-	0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x50, 0x69, 0x6e,
-	0x67, 0x12, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x13, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x68, 0x31, 0x2f, 0x76, 0x31, 0x2f, 0x50, 0x69, 0x6e,
-	0x67, 0x12, 0x3e, 0x0a, 0x0b, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x35, 0x0a, 0x0e, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x62,
-	0x61, 0x63, 0x6b, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x43,
-	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x0c, 0x47, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x4f, 0x6e, 0x65, 0x54, 0x61, 0x70, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x0b, 0x45, 0x6d,
-	0x61, 0x69, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x6d, 0x61, 0x69, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x0b, 0x45,
-	0x6d, 0x61, 0x69, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0b,
-	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x16, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0a, 0x45,
-	0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x41, 0x0a,
-	0x0d, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x18,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x6e,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x41, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x07, 0x53, 0x69,
-	0x67, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
+	0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x18, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f,
+	0x6c, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1f, 0x0a, 0x0b, 0x50, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x69, 0x32, 0xc6, 0x41, 0x0a, 0x0e, 0x55,
+	0x6e, 0x70, 0x61, 0x70, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x36, 0x0a,
+	0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x68, 0x31, 0x2f, 0x76, 0x31,
+	0x2f, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x3e, 0x0a, 0x0b, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0e, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x43,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x0c,
+	0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x4f, 0x6e, 0x65, 0x54, 0x61, 0x70, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2f,
+	0x0a, 0x0b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x12, 0x16, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x2f, 0x0a, 0x0b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x12, 0x16,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x3d, 0x0a, 0x0b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12,
+	0x16, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x3b, 0x0a, 0x0a, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x15, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x0e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x19,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x41, 0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4c, 0x69,
+	0x6e, 0x6b, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x41, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x49,
+	0x0a, 0x18, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x23, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x07, 0x53, 0x69, 0x67,
+	0x6e, 0x4f, 0x75, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4f,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3c, 0x0a, 0x0a, 0x46, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c,
-	0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x18, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x47, 0x65, 0x74,
-	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e,
-	0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x29, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0b, 0x45,
-	0x78, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x78, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x43,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x65, 0x72, 0x12, 0x65, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x69, 0x70, 0x65, 0x57, 0x65, 0x62, 0x68,
-	0x6f, 0x6f, 0x6b, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x70, 0x65, 0x57,
-	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x15, 0x2f,
-	0x68, 0x31, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x3a, 0x53, 0x74,
-	0x72, 0x69, 0x70, 0x65, 0x3a, 0x03, 0x72, 0x61, 0x77, 0x12, 0x74, 0x0a, 0x14, 0x53, 0x74, 0x72,
-	0x69, 0x70, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
-	0x6b, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x70, 0x65, 0x57, 0x65, 0x62,
-	0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1d, 0x2f, 0x68, 0x31,
-	0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x3a, 0x53, 0x74, 0x72, 0x69,
-	0x70, 0x65, 0x3a, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x3a, 0x03, 0x72, 0x61, 0x77, 0x12,
-	0x3b, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6c,
-	0x61, 0x6e, 0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x54, 0x6f, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0c,
-	0x52, 0x65, 0x74, 0x72, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x17, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69,
-	0x63, 0x65, 0x12, 0x56, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79,
-	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3c, 0x0a, 0x0a, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69,
+	0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x39, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x12, 0x14, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0b, 0x55, 0x6e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x08, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0b, 0x45, 0x78, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x78, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x0d, 0x53,
+	0x74, 0x72, 0x69, 0x70, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x18, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x70, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x22,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x15, 0x2f, 0x68, 0x31, 0x2f, 0x76, 0x31, 0x2f, 0x77,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x3a, 0x53, 0x74, 0x72, 0x69, 0x70, 0x65, 0x3a, 0x03, 0x72,
+	0x61, 0x77, 0x12, 0x74, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x69, 0x70, 0x65, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x70, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2a, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x24, 0x22, 0x1d, 0x2f, 0x68, 0x31, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x3a, 0x53, 0x74, 0x72, 0x69, 0x70, 0x65, 0x3a, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x3a, 0x03, 0x72, 0x61, 0x77, 0x12, 0x3b, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1a, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6c, 0x61, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0c, 0x52, 0x65, 0x74, 0x72, 0x79, 0x49, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x13, 0x47,
 	0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79,
-	0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x75, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
-	0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x75, 0x70,
-	0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x75, 0x70, 0x49, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x13,
-	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x12, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x50,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x41, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x0e, 0x49, 0x6e, 0x76,
-	0x6f, 0x69, 0x63, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19, 0x2e, 0x76, 0x31,
-	0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f,
-	0x69, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x4d, 0x61, 0x6b, 0x65, 0x44,
-	0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6b,
-	0x65, 0x44, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x22, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0f, 0x50, 0x61, 0x79, 0x52, 0x6f, 0x6f, 0x6d, 0x45,
-	0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79,
-	0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x53, 0x74, 0x72, 0x69, 0x70, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
+	0x49, 0x44, 0x12, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74,
+	0x75, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x75, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x74, 0x75, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x13, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1e, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x41, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x12, 0x38, 0x0a, 0x0e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0b, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x0b,
+	0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x16, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4b, 0x0a, 0x0c, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x6f, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a,
+	0x0f, 0x50, 0x61, 0x79, 0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x6e, 0x74,
+	0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3b, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x70, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x0c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x48, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x6e,
+	0x6b, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x49, 0x12, 0x1e,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x6e, 0x74,
+	0x72, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x6e, 0x74,
+	0x72, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4a, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x52, 0x6f,
+	0x6f, 0x6d, 0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x54, 0x6f, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x52,
+	0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x20, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a,
+	0x17, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5d, 0x0a, 0x15, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6e, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x12,
+	0x26, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x79, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x54, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x22, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x46, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x30, 0x01, 0x12, 0x3d, 0x0a, 0x0b,
+	0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x09, 0x53,
+	0x65, 0x6e, 0x64, 0x41, 0x77, 0x61, 0x72, 0x64, 0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x41, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x44, 0x61, 0x73, 0x68, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61,
-	0x72, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56,
-	0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x61,
-	0x6e, 0x63, 0x65, 0x50, 0x49, 0x12, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x49, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x49, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x54, 0x6f, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x50, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x44, 0x6f,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x44, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x41,
+	0x75, 0x64, 0x69, 0x6f, 0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x75,
+	0x64, 0x69, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x20, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52,
-	0x6f, 0x6f, 0x6d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x22, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52, 0x6f, 0x6f, 0x6d,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
-	0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x15, 0x52, 0x65, 0x74, 0x72,
-	0x79, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x20, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x6f, 0x6f, 0x6d,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x52, 0x6f,
-	0x6f, 0x6d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79,
-	0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x12, 0x26, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52,
-	0x6f, 0x6f, 0x6d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x79, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4f, 0x77,
-	0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x22, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x4f, 0x77, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
-	0x11, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x12, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x46, 0x6f,
-	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x30, 0x01, 0x12, 0x3d, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x74, 0x79, 0x12, 0x2d, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x2d, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x15, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x53, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4c,
+	0x69, 0x73, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x79, 0x49, 0x44, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x0f, 0x52, 0x6f, 0x6f, 0x6d, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x6f, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x7a, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x2a, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2b, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65,
+	0x0a, 0x18, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x52, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x30, 0x01, 0x12, 0x4c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x39, 0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x77, 0x61, 0x72, 0x64, 0x12,
-	0x14, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a,
-	0x0c, 0x53, 0x65, 0x6e, 0x64, 0x44, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x44, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39,
-	0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x12, 0x14, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x62, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x20, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x0a, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x44, 0x0a, 0x16, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x46, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10, 0x2e, 0x76, 0x31,
+	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x30, 0x01, 0x12,
+	0x49, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x10, 0x52, 0x65,
+	0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x47, 0x65, 0x74,
+	0x4d, 0x69, 0x78, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x78, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x3b,
+	0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x35, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x13, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4c,
-	0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x0b,
-	0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x16, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4a, 0x0a,
-	0x0f, 0x52, 0x6f, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65,
-	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
-	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
-	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x1f, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68,
-	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x2a, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74,
-	0x68, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x46,
-	0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x72, 0x65, 0x65, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x54,
+	0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x47,
+	0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x54, 0x6f, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x1c, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x15, 0x55, 0x6e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x12, 0x20, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x6d, 0x65,
+	0x46, 0x65, 0x65, 0x64, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x6d,
+	0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x6d, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12,
+	0x15, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44,
+	0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x18, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x45, 0x64, 0x69, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x19, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x64, 0x69, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x45, 0x64, 0x69, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x4c, 0x69, 0x6b,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3e, 0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x16, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x44, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x72,
+	0x73, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69,
+	0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72,
+	0x6b, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x6d,
+	0x61, 0x72, 0x6b, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x55, 0x6e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x6e, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x41, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61,
+	0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d,
+	0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x22, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x74, 0x12, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x55,
+	0x6e, 0x64, 0x6f, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x6e, 0x64, 0x6f, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x44,
+	0x72, 0x61, 0x66, 0x74, 0x73, 0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1e,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3e, 0x0a, 0x0b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x16,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2f, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x50, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x48, 0x61,
+	0x73, 0x68, 0x74, 0x61, 0x67, 0x12, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x56, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x48, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x73, 0x68, 0x74, 0x61,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x73, 0x68, 0x74, 0x61,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x19, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x11, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x13, 0x47,
-	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61,
-	0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x78, 0x65,
-	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x4d, 0x69, 0x78, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x6f,
-	0x73, 0x74, 0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x47,
-	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x4c, 0x69, 0x6b, 0x65,
-	0x50, 0x6f, 0x73, 0x74, 0x12, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3e, 0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0xc4, 0x01, 0x5a, 0x0a, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x92, 0x41,
-	0xb4, 0x01, 0x12, 0x4e, 0x0a, 0x0b, 0x55, 0x6e, 0x70, 0x61, 0x70, 0x65, 0x72, 0x20, 0x41, 0x50,
-	0x49, 0x22, 0x3a, 0x0a, 0x07, 0x75, 0x6e, 0x70, 0x61, 0x70, 0x65, 0x72, 0x12, 0x22, 0x68, 0x74,
-	0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x44, 0x61, 0x67, 0x44, 0x69, 0x67, 0x67, 0x2f, 0x75, 0x6e, 0x70, 0x61, 0x70, 0x65, 0x72,
-	0x1a, 0x0b, 0x66, 0x6f, 0x6f, 0x40, 0x62, 0x61, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x32, 0x03, 0x31,
-	0x2e, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x52, 0x3b, 0x0a, 0x03, 0x34, 0x30, 0x34,
-	0x12, 0x34, 0x0a, 0x2a, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65,
-	0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x64,
-	0x6f, 0x65, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x2e, 0x12, 0x06,
-	0x0a, 0x04, 0x9a, 0x02, 0x01, 0x07, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3e, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f,
+	0x67, 0x12, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08,
+	0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f,
+	0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0xc4, 0x01, 0x5a, 0x0a, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x92, 0x41, 0xb4, 0x01, 0x12, 0x4e, 0x0a, 0x0b, 0x55, 0x6e, 0x70, 0x61, 0x70, 0x65,
+	0x72, 0x20, 0x41, 0x50, 0x49, 0x22, 0x3a, 0x0a, 0x07, 0x75, 0x6e, 0x70, 0x61, 0x70, 0x65, 0x72,
+	0x12, 0x22, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x61, 0x67, 0x44, 0x69, 0x67, 0x67, 0x2f, 0x75, 0x6e, 0x70,
+	0x61, 0x70, 0x65, 0x72, 0x1a, 0x0b, 0x66, 0x6f, 0x6f, 0x40, 0x62, 0x61, 0x72, 0x2e, 0x63, 0x6f,
+	0x6d, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x52, 0x3b, 0x0a,
+	0x03, 0x34, 0x30, 0x34, 0x12, 0x34, 0x0a, 0x2a, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64,
+	0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x65, 0x78, 0x69, 0x73,
+	0x74, 0x2e, 0x12, 0x06, 0x0a, 0x04, 0x9a, 0x02, 0x01, 0x07, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -451,243 +671,423 @@ var file_api_proto_v1_unpaper_service_proto_goTypes = []interface{}{
 	(*SendResetLinkRequest)(nil),                    // 9: v1.SendResetLinkRequest
 	(*ResetPasswordRequest)(nil),                    // 10: v1.ResetPasswordRequest
 	(*UpdateUsernameRequest)(nil),                   // 11: v1.UpdateUsernameRequest
-	(*FollowUserRequest)(nil),                       // 12: v1.FollowUserRequest
-	(*GetFollowersRequest)(nil),                     // 13: v1.GetFollowersRequest
-	(*GetFollowingRequest)(nil),                     // 14: v1.GetFollowingRequest
-	(*GetFollowingCountRequest)(nil),                // 15: v1.GetFollowingCountRequest
-	(*GetFollowersCountRequest)(nil),                // 16: v1.GetFollowersCountRequest
-	(*UserInfoRequest)(nil),                         // 17: v1.UserInfoRequest
-	(*ExtUserInfoRequest)(nil),                      // 18: v1.ExtUserInfoRequest
-	(*CustomerInfoRequest)(nil),                     // 19: v1.CustomerInfoRequest
-	(*StripeWebhookRequest)(nil),                    // 20: v1.StripeWebhookRequest
-	(*SubscribeToPlanRequest)(nil),                  // 21: v1.SubscribeToPlanRequest
-	(*RetryInvoiceRequest)(nil),                     // 22: v1.RetryInvoiceRequest
-	(*GetSubscriptionByIDRequest)(nil),              // 23: v1.GetSubscriptionByIDRequest
-	(*CreateSetupIntentRequest)(nil),                // 24: v1.CreateSetupIntentRequest
-	(*AttachPaymentMethodRequest)(nil),              // 25: v1.AttachPaymentMethodRequest
-	(*UpdateSubscriptionRequest)(nil),               // 26: v1.UpdateSubscriptionRequest
-	(*InvoicePreviewRequest)(nil),                   // 27: v1.InvoicePreviewRequest
-	(*CouponCheckRequest)(nil),                      // 28: v1.CouponCheckRequest
-	(*MakeDonationRequest)(nil),                     // 29: v1.MakeDonationRequest
-	(*PayRoomEntranceRequest)(nil),                  // 30: v1.PayRoomEntranceRequest
-	(*CheckRoomEntrancePIRequest)(nil),              // 31: v1.CheckRoomEntrancePIRequest
-	(*SubscribeToRoomRequest)(nil),                  // 32: v1.SubscribeToRoomRequest
-	(*ConfirmRoomSubscriptionRequest)(nil),          // 33: v1.ConfirmRoomSubscriptionRequest
-	(*RetryRoomSubscriptionRequest)(nil),            // 34: v1.RetryRoomSubscriptionRequest
-	(*GetRoomSubscriptionByRoomIDRequest)(nil),      // 35: v1.GetRoomSubscriptionByRoomIDRequest
-	(*GetMessagesRequest)(nil),                      // 36: v1.GetMessagesRequest
-	(*ListenForMessagesRequest)(nil),                // 37: v1.ListenForMessagesRequest
-	(*SendMessageRequest)(nil),                      // 38: v1.SendMessageRequest
-	(*SendAwardRequest)(nil),                        // 39: v1.SendAwardRequest
-	(*SendDonationRequest)(nil),                     // 40: v1.SendDonationRequest
-	(*SendAudioRequest)(nil),                        // 41: v1.SendAudioRequest
-	(*CreateListRequest)(nil),                       // 42: v1.CreateListRequest
-	(*UpdateListRequest)(nil),                       // 43: v1.UpdateListRequest
-	(*GetUserSuggestionsRequest)(nil),               // 44: v1.GetUserSuggestionsRequest
-	(*GetListByIDRequest)(nil),                      // 45: v1.GetListByIDRequest
-	(*RoomAccessCheckRequest)(nil),                  // 46: v1.RoomAccessCheckRequest
-	(*CreateConversationRequest)(nil),               // 47: v1.CreateConversationRequest
-	(*GetConversationRequest)(nil),                  // 48: v1.GetConversationRequest
-	(*GetConversationsRequest)(nil),                 // 49: v1.GetConversationsRequest
-	(*GetConversationWithParticipantsRequest)(nil),  // 50: v1.GetConversationWithParticipantsRequest
-	(*ReadNotificationRequest)(nil),                 // 51: v1.ReadNotificationRequest
-	(*CreatePostRequest)(nil),                       // 52: v1.CreatePostRequest
-	(*GetPostRequest)(nil),                          // 53: v1.GetPostRequest
-	(*GetPostsRequest)(nil),                         // 54: v1.GetPostsRequest
-	(*CreateCommentRequest)(nil),                    // 55: v1.CreateCommentRequest
-	(*LikePostRequest)(nil),                         // 56: v1.LikePostRequest
-	(*LikeCommentRequest)(nil),                      // 57: v1.LikeCommentRequest
-	(*User)(nil),                                    // 58: v1.User
-	(*GoogleLoginResponse)(nil),                     // 59: v1.GoogleLoginResponse
-	(*ExtUserInfoResponse)(nil),                     // 60: v1.ExtUserInfoResponse
-	(*GetFollowersResponse)(nil),                    // 61: v1.GetFollowersResponse
-	(*GetFollowingResponse)(nil),                    // 62: v1.GetFollowingResponse
-	(*GetFollowingCountResponse)(nil),               // 63: v1.GetFollowingCountResponse
-	(*GetFollowersCountResponse)(nil),               // 64: v1.GetFollowersCountResponse
-	(*Customer)(nil),                                // 65: v1.Customer
-	(*Invoice)(nil),                                 // 66: v1.Invoice
-	(*GetSubscriptionByIDResponse)(nil),             // 67: v1.GetSubscriptionByIDResponse
-	(*CreateSetupIntentResponse)(nil),               // 68: v1.CreateSetupIntentResponse
-	(*PaymentMethod)(nil),                           // 69: v1.PaymentMethod
-	(*CouponCheckResponse)(nil),                     // 70: v1.CouponCheckResponse
-	(*GetConnectAccountLinkResponse)(nil),           // 71: v1.GetConnectAccountLinkResponse
-	(*ConnectedPaymentIntentResponse)(nil),          // 72: v1.ConnectedPaymentIntentResponse
-	(*GetDashboardLinkResponse)(nil),                // 73: v1.GetDashboardLinkResponse
-	(*CheckRoomEntrancePIResponse)(nil),             // 74: v1.CheckRoomEntrancePIResponse
-	(*SubscribeToRoomResponse)(nil),                 // 75: v1.SubscribeToRoomResponse
-	(*GetRoomSubscriptionsResponse)(nil),            // 76: v1.GetRoomSubscriptionsResponse
-	(*ConfirmRoomSubscriptionResponse)(nil),         // 77: v1.ConfirmRoomSubscriptionResponse
-	(*GetRoomSubscriptionByRoomIDResponse)(nil),     // 78: v1.GetRoomSubscriptionByRoomIDResponse
-	(*GetOwnConnectedAccountResponse)(nil),          // 79: v1.GetOwnConnectedAccountResponse
-	(*GetMessagesResponse)(nil),                     // 80: v1.GetMessagesResponse
-	(*ChatMessage)(nil),                             // 81: v1.ChatMessage
-	(*List)(nil),                                    // 82: v1.List
-	(*GetUserSuggestionsResponse)(nil),              // 83: v1.GetUserSuggestionsResponse
-	(*GetAllListsResponse)(nil),                     // 84: v1.GetAllListsResponse
-	(*RoomAccessCheckResponse)(nil),                 // 85: v1.RoomAccessCheckResponse
-	(*CreateConversationResponse)(nil),              // 86: v1.CreateConversationResponse
-	(*GetConversationResponse)(nil),                 // 87: v1.GetConversationResponse
-	(*GetConversationsResponse)(nil),                // 88: v1.GetConversationsResponse
-	(*GetConversationWithParticipantsResponse)(nil), // 89: v1.GetConversationWithParticipantsResponse
-	(*Notification)(nil),                            // 90: v1.Notification
-	(*GetAllNotificationsRes)(nil),                  // 91: v1.GetAllNotificationsRes
-	(*ReadNotificationResponse)(nil),                // 92: v1.ReadNotificationResponse
-	(*GetMixesRes)(nil),                             // 93: v1.GetMixesRes
-	(*CreatePostResponse)(nil),                      // 94: v1.CreatePostResponse
-	(*GetPostResponse)(nil),                         // 95: v1.GetPostResponse
-	(*GetPostsResponse)(nil),                        // 96: v1.GetPostsResponse
-	(*CreateCommentResponse)(nil),                   // 97: v1.CreateCommentResponse
-	(*LikePostResponse)(nil),                        // 98: v1.LikePostResponse
-	(*LikeCommentResponse)(nil),                     // 99: v1.LikeCommentResponse
+	(*SetMessageRequestsPolicyRequest)(nil),         // 12: v1.SetMessageRequestsPolicyRequest
+	(*FollowUserRequest)(nil),                       // 13: v1.FollowUserRequest
+	(*GetFollowersRequest)(nil),                     // 14: v1.GetFollowersRequest
+	(*GetFollowingRequest)(nil),                     // 15: v1.GetFollowingRequest
+	(*GetFollowingCountRequest)(nil),                // 16: v1.GetFollowingCountRequest
+	(*GetFollowersCountRequest)(nil),                // 17: v1.GetFollowersCountRequest
+	(*BlockUserRequest)(nil),                        // 18: v1.BlockUserRequest
+	(*UnblockUserRequest)(nil),                      // 19: v1.UnblockUserRequest
+	(*UserInfoRequest)(nil),                         // 20: v1.UserInfoRequest
+	(*ExtUserInfoRequest)(nil),                      // 21: v1.ExtUserInfoRequest
+	(*CustomerInfoRequest)(nil),                     // 22: v1.CustomerInfoRequest
+	(*StripeWebhookRequest)(nil),                    // 23: v1.StripeWebhookRequest
+	(*SubscribeToPlanRequest)(nil),                  // 24: v1.SubscribeToPlanRequest
+	(*RetryInvoiceRequest)(nil),                     // 25: v1.RetryInvoiceRequest
+	(*GetSubscriptionByIDRequest)(nil),              // 26: v1.GetSubscriptionByIDRequest
+	(*CreateSetupIntentRequest)(nil),                // 27: v1.CreateSetupIntentRequest
+	(*AttachPaymentMethodRequest)(nil),              // 28: v1.AttachPaymentMethodRequest
+	(*UpdateSubscriptionRequest)(nil),               // 29: v1.UpdateSubscriptionRequest
+	(*InvoicePreviewRequest)(nil),                   // 30: v1.InvoicePreviewRequest
+	(*CouponCheckRequest)(nil),                      // 31: v1.CouponCheckRequest
+	(*MakeDonationRequest)(nil),                     // 32: v1.MakeDonationRequest
+	(*PayRoomEntranceRequest)(nil),                  // 33: v1.PayRoomEntranceRequest
+	(*CheckRoomEntrancePIRequest)(nil),              // 34: v1.CheckRoomEntrancePIRequest
+	(*SubscribeToRoomRequest)(nil),                  // 35: v1.SubscribeToRoomRequest
+	(*ConfirmRoomSubscriptionRequest)(nil),          // 36: v1.ConfirmRoomSubscriptionRequest
+	(*RetryRoomSubscriptionRequest)(nil),            // 37: v1.RetryRoomSubscriptionRequest
+	(*GetRoomSubscriptionByRoomIDRequest)(nil),      // 38: v1.GetRoomSubscriptionByRoomIDRequest
+	(*GetMessagesRequest)(nil),                      // 39: v1.GetMessagesRequest
+	(*ListenForMessagesRequest)(nil),                // 40: v1.ListenForMessagesRequest
+	(*SendMessageRequest)(nil),                      // 41: v1.SendMessageRequest
+	(*SendAwardRequest)(nil),                        // 42: v1.SendAwardRequest
+	(*SendDonationRequest)(nil),                     // 43: v1.SendDonationRequest
+	(*SendAudioRequest)(nil),                        // 44: v1.SendAudioRequest
+	(*CreateListRequest)(nil),                       // 45: v1.CreateListRequest
+	(*UpdateListRequest)(nil),                       // 46: v1.UpdateListRequest
+	(*GetUserSuggestionsRequest)(nil),               // 47: v1.GetUserSuggestionsRequest
+	(*GetListByIDRequest)(nil),                      // 48: v1.GetListByIDRequest
+	(*RoomAccessCheckRequest)(nil),                  // 49: v1.RoomAccessCheckRequest
+	(*CreateConversationRequest)(nil),               // 50: v1.CreateConversationRequest
+	(*GetConversationRequest)(nil),                  // 51: v1.GetConversationRequest
+	(*GetConversationsRequest)(nil),                 // 52: v1.GetConversationsRequest
+	(*GetConversationWithParticipantsRequest)(nil),  // 53: v1.GetConversationWithParticipantsRequest
+	(*SetConversationRetentionRequest)(nil),         // 54: v1.SetConversationRetentionRequest
+	(*SetConversationMutedRequest)(nil),             // 55: v1.SetConversationMutedRequest
+	(*ExportConversationRequest)(nil),               // 56: v1.ExportConversationRequest
+	(*RespondToMessageRequestRequest)(nil),          // 57: v1.RespondToMessageRequestRequest
+	(*ListScheduledMessagesRequest)(nil),            // 58: v1.ListScheduledMessagesRequest
+	(*CancelScheduledMessageRequest)(nil),           // 59: v1.CancelScheduledMessageRequest
+	(*ReadNotificationRequest)(nil),                 // 60: v1.ReadNotificationRequest
+	(*CreatePostRequest)(nil),                       // 61: v1.CreatePostRequest
+	(*GetPostRequest)(nil),                          // 62: v1.GetPostRequest
+	(*GetPostsRequest)(nil),                         // 63: v1.GetPostsRequest
+	(*GetCommentsRequest)(nil),                      // 64: v1.GetCommentsRequest
+	(*GetCommentTreeRequest)(nil),                   // 65: v1.GetCommentTreeRequest
+	(*GetThreadRequest)(nil),                        // 66: v1.GetThreadRequest
+	(*SubscribeToThreadRequest)(nil),                // 67: v1.SubscribeToThreadRequest
+	(*UnsubscribeFromThreadRequest)(nil),            // 68: v1.UnsubscribeFromThreadRequest
+	(*GetHomeFeedRequest)(nil),                      // 69: v1.GetHomeFeedRequest
+	(*GetTrendingPostsRequest)(nil),                 // 70: v1.GetTrendingPostsRequest
+	(*UpdatePostRequest)(nil),                       // 71: v1.UpdatePostRequest
+	(*DeletePostRequest)(nil),                       // 72: v1.DeletePostRequest
+	(*UpdateCommentRequest)(nil),                    // 73: v1.UpdateCommentRequest
+	(*DeleteCommentRequest)(nil),                    // 74: v1.DeleteCommentRequest
+	(*GetEditHistoryRequest)(nil),                   // 75: v1.GetEditHistoryRequest
+	(*CreateCommentRequest)(nil),                    // 76: v1.CreateCommentRequest
+	(*LikePostRequest)(nil),                         // 77: v1.LikePostRequest
+	(*LikeCommentRequest)(nil),                      // 78: v1.LikeCommentRequest
+	(*GetPostLikersRequest)(nil),                    // 79: v1.GetPostLikersRequest
+	(*GetCommentLikersRequest)(nil),                 // 80: v1.GetCommentLikersRequest
+	(*BookmarkPostRequest)(nil),                     // 81: v1.BookmarkPostRequest
+	(*UnbookmarkPostRequest)(nil),                   // 82: v1.UnbookmarkPostRequest
+	(*GetBookmarksRequest)(nil),                     // 83: v1.GetBookmarksRequest
+	(*RepostRequest)(nil),                           // 84: v1.RepostRequest
+	(*UndoRepostRequest)(nil),                       // 85: v1.UndoRepostRequest
+	(*GetDraftsRequest)(nil),                        // 86: v1.GetDraftsRequest
+	(*GetScheduledPostsRequest)(nil),                // 87: v1.GetScheduledPostsRequest
+	(*SchedulePostRequest)(nil),                     // 88: v1.SchedulePostRequest
+	(*CancelScheduledPostRequest)(nil),              // 89: v1.CancelScheduledPostRequest
+	(*PublishPostRequest)(nil),                      // 90: v1.PublishPostRequest
+	(*SearchRequest)(nil),                           // 91: v1.SearchRequest
+	(*GetPostsByHashtagRequest)(nil),                // 92: v1.GetPostsByHashtagRequest
+	(*GetTrendingHashtagsRequest)(nil),              // 93: v1.GetTrendingHashtagsRequest
+	(*RecordPlaybackRequest)(nil),                   // 94: v1.RecordPlaybackRequest
+	(*GetPostAnalyticsRequest)(nil),                 // 95: v1.GetPostAnalyticsRequest
+	(*ReportRequest)(nil),                           // 96: v1.ReportRequest
+	(*ListReportsRequest)(nil),                      // 97: v1.ListReportsRequest
+	(*ClaimReportRequest)(nil),                      // 98: v1.ClaimReportRequest
+	(*ResolveReportRequest)(nil),                    // 99: v1.ResolveReportRequest
+	(*GetModerationLogRequest)(nil),                 // 100: v1.GetModerationLogRequest
+	(*VotePollRequest)(nil),                         // 101: v1.VotePollRequest
+	(*User)(nil),                                    // 102: v1.User
+	(*GoogleLoginResponse)(nil),                     // 103: v1.GoogleLoginResponse
+	(*ExtUserInfoResponse)(nil),                     // 104: v1.ExtUserInfoResponse
+	(*GetFollowersResponse)(nil),                    // 105: v1.GetFollowersResponse
+	(*GetFollowingResponse)(nil),                    // 106: v1.GetFollowingResponse
+	(*GetFollowingCountResponse)(nil),               // 107: v1.GetFollowingCountResponse
+	(*GetFollowersCountResponse)(nil),               // 108: v1.GetFollowersCountResponse
+	(*Customer)(nil),                                // 109: v1.Customer
+	(*Invoice)(nil),                                 // 110: v1.Invoice
+	(*GetSubscriptionByIDResponse)(nil),             // 111: v1.GetSubscriptionByIDResponse
+	(*CreateSetupIntentResponse)(nil),               // 112: v1.CreateSetupIntentResponse
+	(*PaymentMethod)(nil),                           // 113: v1.PaymentMethod
+	(*CouponCheckResponse)(nil),                     // 114: v1.CouponCheckResponse
+	(*GetConnectAccountLinkResponse)(nil),           // 115: v1.GetConnectAccountLinkResponse
+	(*ConnectedPaymentIntentResponse)(nil),          // 116: v1.ConnectedPaymentIntentResponse
+	(*GetDashboardLinkResponse)(nil),                // 117: v1.GetDashboardLinkResponse
+	(*CheckRoomEntrancePIResponse)(nil),             // 118: v1.CheckRoomEntrancePIResponse
+	(*SubscribeToRoomResponse)(nil),                 // 119: v1.SubscribeToRoomResponse
+	(*GetRoomSubscriptionsResponse)(nil),            // 120: v1.GetRoomSubscriptionsResponse
+	(*ConfirmRoomSubscriptionResponse)(nil),         // 121: v1.ConfirmRoomSubscriptionResponse
+	(*GetRoomSubscriptionByRoomIDResponse)(nil),     // 122: v1.GetRoomSubscriptionByRoomIDResponse
+	(*GetOwnConnectedAccountResponse)(nil),          // 123: v1.GetOwnConnectedAccountResponse
+	(*GetMessagesResponse)(nil),                     // 124: v1.GetMessagesResponse
+	(*ChatMessage)(nil),                             // 125: v1.ChatMessage
+	(*List)(nil),                                    // 126: v1.List
+	(*GetUserSuggestionsResponse)(nil),              // 127: v1.GetUserSuggestionsResponse
+	(*GetAllListsResponse)(nil),                     // 128: v1.GetAllListsResponse
+	(*RoomAccessCheckResponse)(nil),                 // 129: v1.RoomAccessCheckResponse
+	(*CreateConversationResponse)(nil),              // 130: v1.CreateConversationResponse
+	(*GetConversationResponse)(nil),                 // 131: v1.GetConversationResponse
+	(*GetConversationsResponse)(nil),                // 132: v1.GetConversationsResponse
+	(*GetConversationWithParticipantsResponse)(nil), // 133: v1.GetConversationWithParticipantsResponse
+	(*SetConversationRetentionResponse)(nil),        // 134: v1.SetConversationRetentionResponse
+	(*SetConversationMutedResponse)(nil),            // 135: v1.SetConversationMutedResponse
+	(*ExportConversationChunk)(nil),                 // 136: v1.ExportConversationChunk
+	(*GetMessageRequestsResponse)(nil),              // 137: v1.GetMessageRequestsResponse
+	(*RespondToMessageRequestResponse)(nil),         // 138: v1.RespondToMessageRequestResponse
+	(*ListScheduledMessagesResponse)(nil),           // 139: v1.ListScheduledMessagesResponse
+	(*Notification)(nil),                            // 140: v1.Notification
+	(*GetAllNotificationsRes)(nil),                  // 141: v1.GetAllNotificationsRes
+	(*ReadNotificationResponse)(nil),                // 142: v1.ReadNotificationResponse
+	(*GetMixesRes)(nil),                             // 143: v1.GetMixesRes
+	(*CreatePostResponse)(nil),                      // 144: v1.CreatePostResponse
+	(*GetPostResponse)(nil),                         // 145: v1.GetPostResponse
+	(*GetPostsResponse)(nil),                        // 146: v1.GetPostsResponse
+	(*GetPostCategoriesResponse)(nil),               // 147: v1.GetPostCategoriesResponse
+	(*GetCommentsResponse)(nil),                     // 148: v1.GetCommentsResponse
+	(*GetCommentTreeResponse)(nil),                  // 149: v1.GetCommentTreeResponse
+	(*GetThreadResponse)(nil),                       // 150: v1.GetThreadResponse
+	(*SubscribeToThreadResponse)(nil),               // 151: v1.SubscribeToThreadResponse
+	(*UnsubscribeFromThreadResponse)(nil),           // 152: v1.UnsubscribeFromThreadResponse
+	(*GetHomeFeedResponse)(nil),                     // 153: v1.GetHomeFeedResponse
+	(*GetTrendingPostsResponse)(nil),                // 154: v1.GetTrendingPostsResponse
+	(*UpdatePostResponse)(nil),                      // 155: v1.UpdatePostResponse
+	(*DeletePostResponse)(nil),                      // 156: v1.DeletePostResponse
+	(*UpdateCommentResponse)(nil),                   // 157: v1.UpdateCommentResponse
+	(*DeleteCommentResponse)(nil),                   // 158: v1.DeleteCommentResponse
+	(*GetEditHistoryResponse)(nil),                  // 159: v1.GetEditHistoryResponse
+	(*CreateCommentResponse)(nil),                   // 160: v1.CreateCommentResponse
+	(*LikePostResponse)(nil),                        // 161: v1.LikePostResponse
+	(*LikeCommentResponse)(nil),                     // 162: v1.LikeCommentResponse
+	(*GetPostLikersResponse)(nil),                   // 163: v1.GetPostLikersResponse
+	(*GetCommentLikersResponse)(nil),                // 164: v1.GetCommentLikersResponse
+	(*BookmarkPostResponse)(nil),                    // 165: v1.BookmarkPostResponse
+	(*UnbookmarkPostResponse)(nil),                  // 166: v1.UnbookmarkPostResponse
+	(*GetBookmarksResponse)(nil),                    // 167: v1.GetBookmarksResponse
+	(*GetBookmarkCollectionsResponse)(nil),          // 168: v1.GetBookmarkCollectionsResponse
+	(*RepostResponse)(nil),                          // 169: v1.RepostResponse
+	(*UndoRepostResponse)(nil),                      // 170: v1.UndoRepostResponse
+	(*GetDraftsResponse)(nil),                       // 171: v1.GetDraftsResponse
+	(*GetScheduledPostsResponse)(nil),               // 172: v1.GetScheduledPostsResponse
+	(*SchedulePostResponse)(nil),                    // 173: v1.SchedulePostResponse
+	(*CancelScheduledPostResponse)(nil),             // 174: v1.CancelScheduledPostResponse
+	(*PublishPostResponse)(nil),                     // 175: v1.PublishPostResponse
+	(*SearchResponse)(nil),                          // 176: v1.SearchResponse
+	(*GetPostsByHashtagResponse)(nil),               // 177: v1.GetPostsByHashtagResponse
+	(*GetTrendingHashtagsResponse)(nil),             // 178: v1.GetTrendingHashtagsResponse
+	(*RecordPlaybackResponse)(nil),                  // 179: v1.RecordPlaybackResponse
+	(*GetPostAnalyticsResponse)(nil),                // 180: v1.GetPostAnalyticsResponse
+	(*ListReportsResponse)(nil),                     // 181: v1.ListReportsResponse
+	(*ClaimReportResponse)(nil),                     // 182: v1.ClaimReportResponse
+	(*ResolveReportResponse)(nil),                   // 183: v1.ResolveReportResponse
+	(*GetModerationLogResponse)(nil),                // 184: v1.GetModerationLogResponse
+	(*VotePollResponse)(nil),                        // 185: v1.VotePollResponse
 }
 var file_api_proto_v1_unpaper_service_proto_depIdxs = []int32{
-	0,  // 0: v1.UnpaperService.Ping:input_type -> v1.PingRequest
-	1,  // 1: v1.UnpaperService.GoogleLogin:input_type -> v1.GoogleLoginRequest
-	2,  // 2: v1.UnpaperService.GoogleCallback:input_type -> v1.GoogleCallbackRequest
-	3,  // 3: v1.UnpaperService.GoogleOneTap:input_type -> google.protobuf.Empty
-	4,  // 4: v1.UnpaperService.EmailSignup:input_type -> v1.EmailSignupRequest
-	5,  // 5: v1.UnpaperService.EmailSignin:input_type -> v1.EmailSigninRequest
-	6,  // 6: v1.UnpaperService.EmailVerify:input_type -> v1.EmailVerifyRequest
-	7,  // 7: v1.UnpaperService.EmailCheck:input_type -> v1.EmailCheckRequest
-	8,  // 8: v1.UnpaperService.ChangePassword:input_type -> v1.ChangePasswordRequest
-	9,  // 9: v1.UnpaperService.SendResetLink:input_type -> v1.SendResetLinkRequest
-	10, // 10: v1.UnpaperService.ResetPassword:input_type -> v1.ResetPasswordRequest
-	11, // 11: v1.UnpaperService.UpdateUsername:input_type -> v1.UpdateUsernameRequest
-	3,  // 12: v1.UnpaperService.SignOut:input_type -> google.protobuf.Empty
-	3,  // 13: v1.UnpaperService.SetUserOnline:input_type -> google.protobuf.Empty
-	3,  // 14: v1.UnpaperService.SetUserOffline:input_type -> google.protobuf.Empty
-	12, // 15: v1.UnpaperService.FollowUser:input_type -> v1.FollowUserRequest
-	13, // 16: v1.UnpaperService.GetFollowers:input_type -> v1.GetFollowersRequest
-	14, // 17: v1.UnpaperService.GetFollowing:input_type -> v1.GetFollowingRequest
-	15, // 18: v1.UnpaperService.GetFollowingCount:input_type -> v1.GetFollowingCountRequest
-	16, // 19: v1.UnpaperService.GetFollowersCount:input_type -> v1.GetFollowersCountRequest
-	17, // 20: v1.UnpaperService.UserInfo:input_type -> v1.UserInfoRequest
-	18, // 21: v1.UnpaperService.ExtUserInfo:input_type -> v1.ExtUserInfoRequest
-	19, // 22: v1.UnpaperService.CustomerInfo:input_type -> v1.CustomerInfoRequest
-	20, // 23: v1.UnpaperService.StripeWebhook:input_type -> v1.StripeWebhookRequest
-	20, // 24: v1.UnpaperService.StripeConnectWebhook:input_type -> v1.StripeWebhookRequest
-	21, // 25: v1.UnpaperService.SubscribeToPlan:input_type -> v1.SubscribeToPlanRequest
-	22, // 26: v1.UnpaperService.RetryInvoice:input_type -> v1.RetryInvoiceRequest
-	23, // 27: v1.UnpaperService.GetSubscriptionByID:input_type -> v1.GetSubscriptionByIDRequest
-	24, // 28: v1.UnpaperService.CreateSetupIntent:input_type -> v1.CreateSetupIntentRequest
-	25, // 29: v1.UnpaperService.AttachPaymentMethod:input_type -> v1.AttachPaymentMethodRequest
-	26, // 30: v1.UnpaperService.UpdateSubscription:input_type -> v1.UpdateSubscriptionRequest
-	27, // 31: v1.UnpaperService.InvoicePreview:input_type -> v1.InvoicePreviewRequest
-	28, // 32: v1.UnpaperService.CouponCheck:input_type -> v1.CouponCheckRequest
-	3,  // 33: v1.UnpaperService.GetConnectAccountLink:input_type -> google.protobuf.Empty
-	29, // 34: v1.UnpaperService.MakeDonation:input_type -> v1.MakeDonationRequest
-	30, // 35: v1.UnpaperService.PayRoomEntrance:input_type -> v1.PayRoomEntranceRequest
-	3,  // 36: v1.UnpaperService.CreateStripeAccount:input_type -> google.protobuf.Empty
-	3,  // 37: v1.UnpaperService.GetDashboardLink:input_type -> google.protobuf.Empty
-	31, // 38: v1.UnpaperService.CheckRoomEntrancePI:input_type -> v1.CheckRoomEntrancePIRequest
-	32, // 39: v1.UnpaperService.SubscribeToRoom:input_type -> v1.SubscribeToRoomRequest
-	3,  // 40: v1.UnpaperService.GetRoomSubscriptions:input_type -> google.protobuf.Empty
-	33, // 41: v1.UnpaperService.ConfirmRoomSubscription:input_type -> v1.ConfirmRoomSubscriptionRequest
-	34, // 42: v1.UnpaperService.RetryRoomSubscription:input_type -> v1.RetryRoomSubscriptionRequest
-	35, // 43: v1.UnpaperService.GetRoomSubscriptionByRoomID:input_type -> v1.GetRoomSubscriptionByRoomIDRequest
-	3,  // 44: v1.UnpaperService.GetOwnConnectedAccount:input_type -> google.protobuf.Empty
-	36, // 45: v1.UnpaperService.GetMessages:input_type -> v1.GetMessagesRequest
-	37, // 46: v1.UnpaperService.ListenForMessages:input_type -> v1.ListenForMessagesRequest
-	38, // 47: v1.UnpaperService.SendMessage:input_type -> v1.SendMessageRequest
-	39, // 48: v1.UnpaperService.SendAward:input_type -> v1.SendAwardRequest
-	40, // 49: v1.UnpaperService.SendDonation:input_type -> v1.SendDonationRequest
-	41, // 50: v1.UnpaperService.SendAudio:input_type -> v1.SendAudioRequest
-	42, // 51: v1.UnpaperService.CreateList:input_type -> v1.CreateListRequest
-	43, // 52: v1.UnpaperService.UpdateList:input_type -> v1.UpdateListRequest
-	44, // 53: v1.UnpaperService.GetUserSuggestions:input_type -> v1.GetUserSuggestionsRequest
-	3,  // 54: v1.UnpaperService.GetAllLists:input_type -> google.protobuf.Empty
-	45, // 55: v1.UnpaperService.GetListByID:input_type -> v1.GetListByIDRequest
-	46, // 56: v1.UnpaperService.RoomAccessCheck:input_type -> v1.RoomAccessCheckRequest
-	47, // 57: v1.UnpaperService.CreateConversation:input_type -> v1.CreateConversationRequest
-	48, // 58: v1.UnpaperService.GetConversation:input_type -> v1.GetConversationRequest
-	49, // 59: v1.UnpaperService.GetConversations:input_type -> v1.GetConversationsRequest
-	50, // 60: v1.UnpaperService.GetConversationWithParticipants:input_type -> v1.GetConversationWithParticipantsRequest
-	3,  // 61: v1.UnpaperService.ListenForNotifications:input_type -> google.protobuf.Empty
-	3,  // 62: v1.UnpaperService.GetAllNotifications:input_type -> google.protobuf.Empty
-	51, // 63: v1.UnpaperService.ReadNotification:input_type -> v1.ReadNotificationRequest
-	3,  // 64: v1.UnpaperService.GetMixes:input_type -> google.protobuf.Empty
-	52, // 65: v1.UnpaperService.CreatePost:input_type -> v1.CreatePostRequest
-	53, // 66: v1.UnpaperService.GetPost:input_type -> v1.GetPostRequest
-	54, // 67: v1.UnpaperService.GetPosts:input_type -> v1.GetPostsRequest
-	55, // 68: v1.UnpaperService.CreateComment:input_type -> v1.CreateCommentRequest
-	56, // 69: v1.UnpaperService.LikePost:input_type -> v1.LikePostRequest
-	57, // 70: v1.UnpaperService.LikeComment:input_type -> v1.LikeCommentRequest
-	58, // 71: v1.UnpaperService.Ping:output_type -> v1.User
-	59, // 72: v1.UnpaperService.GoogleLogin:output_type -> v1.GoogleLoginResponse
-	58, // 73: v1.UnpaperService.GoogleCallback:output_type -> v1.User
-	58, // 74: v1.UnpaperService.GoogleOneTap:output_type -> v1.User
-	58, // 75: v1.UnpaperService.EmailSignup:output_type -> v1.User
-	58, // 76: v1.UnpaperService.EmailSignin:output_type -> v1.User
-	3,  // 77: v1.UnpaperService.EmailVerify:output_type -> google.protobuf.Empty
-	3,  // 78: v1.UnpaperService.EmailCheck:output_type -> google.protobuf.Empty
-	3,  // 79: v1.UnpaperService.ChangePassword:output_type -> google.protobuf.Empty
-	3,  // 80: v1.UnpaperService.SendResetLink:output_type -> google.protobuf.Empty
-	3,  // 81: v1.UnpaperService.ResetPassword:output_type -> google.protobuf.Empty
-	58, // 82: v1.UnpaperService.UpdateUsername:output_type -> v1.User
-	3,  // 83: v1.UnpaperService.SignOut:output_type -> google.protobuf.Empty
-	3,  // 84: v1.UnpaperService.SetUserOnline:output_type -> google.protobuf.Empty
-	3,  // 85: v1.UnpaperService.SetUserOffline:output_type -> google.protobuf.Empty
-	60, // 86: v1.UnpaperService.FollowUser:output_type -> v1.ExtUserInfoResponse
-	61, // 87: v1.UnpaperService.GetFollowers:output_type -> v1.GetFollowersResponse
-	62, // 88: v1.UnpaperService.GetFollowing:output_type -> v1.GetFollowingResponse
-	63, // 89: v1.UnpaperService.GetFollowingCount:output_type -> v1.GetFollowingCountResponse
-	64, // 90: v1.UnpaperService.GetFollowersCount:output_type -> v1.GetFollowersCountResponse
-	58, // 91: v1.UnpaperService.UserInfo:output_type -> v1.User
-	60, // 92: v1.UnpaperService.ExtUserInfo:output_type -> v1.ExtUserInfoResponse
-	65, // 93: v1.UnpaperService.CustomerInfo:output_type -> v1.Customer
-	3,  // 94: v1.UnpaperService.StripeWebhook:output_type -> google.protobuf.Empty
-	3,  // 95: v1.UnpaperService.StripeConnectWebhook:output_type -> google.protobuf.Empty
-	65, // 96: v1.UnpaperService.SubscribeToPlan:output_type -> v1.Customer
-	66, // 97: v1.UnpaperService.RetryInvoice:output_type -> v1.Invoice
-	67, // 98: v1.UnpaperService.GetSubscriptionByID:output_type -> v1.GetSubscriptionByIDResponse
-	68, // 99: v1.UnpaperService.CreateSetupIntent:output_type -> v1.CreateSetupIntentResponse
-	69, // 100: v1.UnpaperService.AttachPaymentMethod:output_type -> v1.PaymentMethod
-	65, // 101: v1.UnpaperService.UpdateSubscription:output_type -> v1.Customer
-	66, // 102: v1.UnpaperService.InvoicePreview:output_type -> v1.Invoice
-	70, // 103: v1.UnpaperService.CouponCheck:output_type -> v1.CouponCheckResponse
-	71, // 104: v1.UnpaperService.GetConnectAccountLink:output_type -> v1.GetConnectAccountLinkResponse
-	72, // 105: v1.UnpaperService.MakeDonation:output_type -> v1.ConnectedPaymentIntentResponse
-	72, // 106: v1.UnpaperService.PayRoomEntrance:output_type -> v1.ConnectedPaymentIntentResponse
-	65, // 107: v1.UnpaperService.CreateStripeAccount:output_type -> v1.Customer
-	73, // 108: v1.UnpaperService.GetDashboardLink:output_type -> v1.GetDashboardLinkResponse
-	74, // 109: v1.UnpaperService.CheckRoomEntrancePI:output_type -> v1.CheckRoomEntrancePIResponse
-	75, // 110: v1.UnpaperService.SubscribeToRoom:output_type -> v1.SubscribeToRoomResponse
-	76, // 111: v1.UnpaperService.GetRoomSubscriptions:output_type -> v1.GetRoomSubscriptionsResponse
-	77, // 112: v1.UnpaperService.ConfirmRoomSubscription:output_type -> v1.ConfirmRoomSubscriptionResponse
-	72, // 113: v1.UnpaperService.RetryRoomSubscription:output_type -> v1.ConnectedPaymentIntentResponse
-	78, // 114: v1.UnpaperService.GetRoomSubscriptionByRoomID:output_type -> v1.GetRoomSubscriptionByRoomIDResponse
-	79, // 115: v1.UnpaperService.GetOwnConnectedAccount:output_type -> v1.GetOwnConnectedAccountResponse
-	80, // 116: v1.UnpaperService.GetMessages:output_type -> v1.GetMessagesResponse
-	81, // 117: v1.UnpaperService.ListenForMessages:output_type -> v1.ChatMessage
-	3,  // 118: v1.UnpaperService.SendMessage:output_type -> google.protobuf.Empty
-	3,  // 119: v1.UnpaperService.SendAward:output_type -> google.protobuf.Empty
-	3,  // 120: v1.UnpaperService.SendDonation:output_type -> google.protobuf.Empty
-	3,  // 121: v1.UnpaperService.SendAudio:output_type -> google.protobuf.Empty
-	82, // 122: v1.UnpaperService.CreateList:output_type -> v1.List
-	82, // 123: v1.UnpaperService.UpdateList:output_type -> v1.List
-	83, // 124: v1.UnpaperService.GetUserSuggestions:output_type -> v1.GetUserSuggestionsResponse
-	84, // 125: v1.UnpaperService.GetAllLists:output_type -> v1.GetAllListsResponse
-	82, // 126: v1.UnpaperService.GetListByID:output_type -> v1.List
-	85, // 127: v1.UnpaperService.RoomAccessCheck:output_type -> v1.RoomAccessCheckResponse
-	86, // 128: v1.UnpaperService.CreateConversation:output_type -> v1.CreateConversationResponse
-	87, // 129: v1.UnpaperService.GetConversation:output_type -> v1.GetConversationResponse
-	88, // 130: v1.UnpaperService.GetConversations:output_type -> v1.GetConversationsResponse
-	89, // 131: v1.UnpaperService.GetConversationWithParticipants:output_type -> v1.GetConversationWithParticipantsResponse
-	90, // 132: v1.UnpaperService.ListenForNotifications:output_type -> v1.Notification
-	91, // 133: v1.UnpaperService.GetAllNotifications:output_type -> v1.GetAllNotificationsRes
-	92, // 134: v1.UnpaperService.ReadNotification:output_type -> v1.ReadNotificationResponse
-	93, // 135: v1.UnpaperService.GetMixes:output_type -> v1.GetMixesRes
-	94, // 136: v1.UnpaperService.CreatePost:output_type -> v1.CreatePostResponse
-	95, // 137: v1.UnpaperService.GetPost:output_type -> v1.GetPostResponse
-	96, // 138: v1.UnpaperService.GetPosts:output_type -> v1.GetPostsResponse
-	97, // 139: v1.UnpaperService.CreateComment:output_type -> v1.CreateCommentResponse
-	98, // 140: v1.UnpaperService.LikePost:output_type -> v1.LikePostResponse
-	99, // 141: v1.UnpaperService.LikeComment:output_type -> v1.LikeCommentResponse
-	71, // [71:142] is the sub-list for method output_type
-	0,  // [0:71] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: v1.UnpaperService.Ping:input_type -> v1.PingRequest
+	1,   // 1: v1.UnpaperService.GoogleLogin:input_type -> v1.GoogleLoginRequest
+	2,   // 2: v1.UnpaperService.GoogleCallback:input_type -> v1.GoogleCallbackRequest
+	3,   // 3: v1.UnpaperService.GoogleOneTap:input_type -> google.protobuf.Empty
+	4,   // 4: v1.UnpaperService.EmailSignup:input_type -> v1.EmailSignupRequest
+	5,   // 5: v1.UnpaperService.EmailSignin:input_type -> v1.EmailSigninRequest
+	6,   // 6: v1.UnpaperService.EmailVerify:input_type -> v1.EmailVerifyRequest
+	7,   // 7: v1.UnpaperService.EmailCheck:input_type -> v1.EmailCheckRequest
+	8,   // 8: v1.UnpaperService.ChangePassword:input_type -> v1.ChangePasswordRequest
+	9,   // 9: v1.UnpaperService.SendResetLink:input_type -> v1.SendResetLinkRequest
+	10,  // 10: v1.UnpaperService.ResetPassword:input_type -> v1.ResetPasswordRequest
+	11,  // 11: v1.UnpaperService.UpdateUsername:input_type -> v1.UpdateUsernameRequest
+	12,  // 12: v1.UnpaperService.SetMessageRequestsPolicy:input_type -> v1.SetMessageRequestsPolicyRequest
+	3,   // 13: v1.UnpaperService.SignOut:input_type -> google.protobuf.Empty
+	3,   // 14: v1.UnpaperService.SetUserOnline:input_type -> google.protobuf.Empty
+	3,   // 15: v1.UnpaperService.SetUserOffline:input_type -> google.protobuf.Empty
+	13,  // 16: v1.UnpaperService.FollowUser:input_type -> v1.FollowUserRequest
+	14,  // 17: v1.UnpaperService.GetFollowers:input_type -> v1.GetFollowersRequest
+	15,  // 18: v1.UnpaperService.GetFollowing:input_type -> v1.GetFollowingRequest
+	16,  // 19: v1.UnpaperService.GetFollowingCount:input_type -> v1.GetFollowingCountRequest
+	17,  // 20: v1.UnpaperService.GetFollowersCount:input_type -> v1.GetFollowersCountRequest
+	18,  // 21: v1.UnpaperService.BlockUser:input_type -> v1.BlockUserRequest
+	19,  // 22: v1.UnpaperService.UnblockUser:input_type -> v1.UnblockUserRequest
+	20,  // 23: v1.UnpaperService.UserInfo:input_type -> v1.UserInfoRequest
+	21,  // 24: v1.UnpaperService.ExtUserInfo:input_type -> v1.ExtUserInfoRequest
+	22,  // 25: v1.UnpaperService.CustomerInfo:input_type -> v1.CustomerInfoRequest
+	23,  // 26: v1.UnpaperService.StripeWebhook:input_type -> v1.StripeWebhookRequest
+	23,  // 27: v1.UnpaperService.StripeConnectWebhook:input_type -> v1.StripeWebhookRequest
+	24,  // 28: v1.UnpaperService.SubscribeToPlan:input_type -> v1.SubscribeToPlanRequest
+	25,  // 29: v1.UnpaperService.RetryInvoice:input_type -> v1.RetryInvoiceRequest
+	26,  // 30: v1.UnpaperService.GetSubscriptionByID:input_type -> v1.GetSubscriptionByIDRequest
+	27,  // 31: v1.UnpaperService.CreateSetupIntent:input_type -> v1.CreateSetupIntentRequest
+	28,  // 32: v1.UnpaperService.AttachPaymentMethod:input_type -> v1.AttachPaymentMethodRequest
+	29,  // 33: v1.UnpaperService.UpdateSubscription:input_type -> v1.UpdateSubscriptionRequest
+	30,  // 34: v1.UnpaperService.InvoicePreview:input_type -> v1.InvoicePreviewRequest
+	31,  // 35: v1.UnpaperService.CouponCheck:input_type -> v1.CouponCheckRequest
+	3,   // 36: v1.UnpaperService.GetConnectAccountLink:input_type -> google.protobuf.Empty
+	32,  // 37: v1.UnpaperService.MakeDonation:input_type -> v1.MakeDonationRequest
+	33,  // 38: v1.UnpaperService.PayRoomEntrance:input_type -> v1.PayRoomEntranceRequest
+	3,   // 39: v1.UnpaperService.CreateStripeAccount:input_type -> google.protobuf.Empty
+	3,   // 40: v1.UnpaperService.GetDashboardLink:input_type -> google.protobuf.Empty
+	34,  // 41: v1.UnpaperService.CheckRoomEntrancePI:input_type -> v1.CheckRoomEntrancePIRequest
+	35,  // 42: v1.UnpaperService.SubscribeToRoom:input_type -> v1.SubscribeToRoomRequest
+	3,   // 43: v1.UnpaperService.GetRoomSubscriptions:input_type -> google.protobuf.Empty
+	36,  // 44: v1.UnpaperService.ConfirmRoomSubscription:input_type -> v1.ConfirmRoomSubscriptionRequest
+	37,  // 45: v1.UnpaperService.RetryRoomSubscription:input_type -> v1.RetryRoomSubscriptionRequest
+	38,  // 46: v1.UnpaperService.GetRoomSubscriptionByRoomID:input_type -> v1.GetRoomSubscriptionByRoomIDRequest
+	3,   // 47: v1.UnpaperService.GetOwnConnectedAccount:input_type -> google.protobuf.Empty
+	39,  // 48: v1.UnpaperService.GetMessages:input_type -> v1.GetMessagesRequest
+	40,  // 49: v1.UnpaperService.ListenForMessages:input_type -> v1.ListenForMessagesRequest
+	41,  // 50: v1.UnpaperService.SendMessage:input_type -> v1.SendMessageRequest
+	42,  // 51: v1.UnpaperService.SendAward:input_type -> v1.SendAwardRequest
+	43,  // 52: v1.UnpaperService.SendDonation:input_type -> v1.SendDonationRequest
+	44,  // 53: v1.UnpaperService.SendAudio:input_type -> v1.SendAudioRequest
+	45,  // 54: v1.UnpaperService.CreateList:input_type -> v1.CreateListRequest
+	46,  // 55: v1.UnpaperService.UpdateList:input_type -> v1.UpdateListRequest
+	47,  // 56: v1.UnpaperService.GetUserSuggestions:input_type -> v1.GetUserSuggestionsRequest
+	3,   // 57: v1.UnpaperService.GetAllLists:input_type -> google.protobuf.Empty
+	48,  // 58: v1.UnpaperService.GetListByID:input_type -> v1.GetListByIDRequest
+	49,  // 59: v1.UnpaperService.RoomAccessCheck:input_type -> v1.RoomAccessCheckRequest
+	50,  // 60: v1.UnpaperService.CreateConversation:input_type -> v1.CreateConversationRequest
+	51,  // 61: v1.UnpaperService.GetConversation:input_type -> v1.GetConversationRequest
+	52,  // 62: v1.UnpaperService.GetConversations:input_type -> v1.GetConversationsRequest
+	53,  // 63: v1.UnpaperService.GetConversationWithParticipants:input_type -> v1.GetConversationWithParticipantsRequest
+	54,  // 64: v1.UnpaperService.SetConversationRetention:input_type -> v1.SetConversationRetentionRequest
+	55,  // 65: v1.UnpaperService.SetConversationMuted:input_type -> v1.SetConversationMutedRequest
+	56,  // 66: v1.UnpaperService.ExportConversation:input_type -> v1.ExportConversationRequest
+	3,   // 67: v1.UnpaperService.GetMessageRequests:input_type -> google.protobuf.Empty
+	57,  // 68: v1.UnpaperService.RespondToMessageRequest:input_type -> v1.RespondToMessageRequestRequest
+	58,  // 69: v1.UnpaperService.ListScheduledMessages:input_type -> v1.ListScheduledMessagesRequest
+	59,  // 70: v1.UnpaperService.CancelScheduledMessage:input_type -> v1.CancelScheduledMessageRequest
+	3,   // 71: v1.UnpaperService.ListenForNotifications:input_type -> google.protobuf.Empty
+	3,   // 72: v1.UnpaperService.GetAllNotifications:input_type -> google.protobuf.Empty
+	60,  // 73: v1.UnpaperService.ReadNotification:input_type -> v1.ReadNotificationRequest
+	3,   // 74: v1.UnpaperService.GetMixes:input_type -> google.protobuf.Empty
+	61,  // 75: v1.UnpaperService.CreatePost:input_type -> v1.CreatePostRequest
+	62,  // 76: v1.UnpaperService.GetPost:input_type -> v1.GetPostRequest
+	63,  // 77: v1.UnpaperService.GetPosts:input_type -> v1.GetPostsRequest
+	3,   // 78: v1.UnpaperService.GetPostCategories:input_type -> google.protobuf.Empty
+	64,  // 79: v1.UnpaperService.GetComments:input_type -> v1.GetCommentsRequest
+	65,  // 80: v1.UnpaperService.GetCommentTree:input_type -> v1.GetCommentTreeRequest
+	66,  // 81: v1.UnpaperService.GetThread:input_type -> v1.GetThreadRequest
+	67,  // 82: v1.UnpaperService.SubscribeToThread:input_type -> v1.SubscribeToThreadRequest
+	68,  // 83: v1.UnpaperService.UnsubscribeFromThread:input_type -> v1.UnsubscribeFromThreadRequest
+	69,  // 84: v1.UnpaperService.GetHomeFeed:input_type -> v1.GetHomeFeedRequest
+	70,  // 85: v1.UnpaperService.GetTrendingPosts:input_type -> v1.GetTrendingPostsRequest
+	71,  // 86: v1.UnpaperService.UpdatePost:input_type -> v1.UpdatePostRequest
+	72,  // 87: v1.UnpaperService.DeletePost:input_type -> v1.DeletePostRequest
+	73,  // 88: v1.UnpaperService.UpdateComment:input_type -> v1.UpdateCommentRequest
+	74,  // 89: v1.UnpaperService.DeleteComment:input_type -> v1.DeleteCommentRequest
+	75,  // 90: v1.UnpaperService.GetEditHistory:input_type -> v1.GetEditHistoryRequest
+	76,  // 91: v1.UnpaperService.CreateComment:input_type -> v1.CreateCommentRequest
+	77,  // 92: v1.UnpaperService.LikePost:input_type -> v1.LikePostRequest
+	78,  // 93: v1.UnpaperService.LikeComment:input_type -> v1.LikeCommentRequest
+	79,  // 94: v1.UnpaperService.GetPostLikers:input_type -> v1.GetPostLikersRequest
+	80,  // 95: v1.UnpaperService.GetCommentLikers:input_type -> v1.GetCommentLikersRequest
+	81,  // 96: v1.UnpaperService.BookmarkPost:input_type -> v1.BookmarkPostRequest
+	82,  // 97: v1.UnpaperService.UnbookmarkPost:input_type -> v1.UnbookmarkPostRequest
+	83,  // 98: v1.UnpaperService.GetBookmarks:input_type -> v1.GetBookmarksRequest
+	3,   // 99: v1.UnpaperService.GetBookmarkCollections:input_type -> google.protobuf.Empty
+	84,  // 100: v1.UnpaperService.Repost:input_type -> v1.RepostRequest
+	85,  // 101: v1.UnpaperService.UndoRepost:input_type -> v1.UndoRepostRequest
+	86,  // 102: v1.UnpaperService.GetDrafts:input_type -> v1.GetDraftsRequest
+	87,  // 103: v1.UnpaperService.GetScheduledPosts:input_type -> v1.GetScheduledPostsRequest
+	88,  // 104: v1.UnpaperService.SchedulePost:input_type -> v1.SchedulePostRequest
+	89,  // 105: v1.UnpaperService.CancelScheduledPost:input_type -> v1.CancelScheduledPostRequest
+	90,  // 106: v1.UnpaperService.PublishPost:input_type -> v1.PublishPostRequest
+	91,  // 107: v1.UnpaperService.Search:input_type -> v1.SearchRequest
+	92,  // 108: v1.UnpaperService.GetPostsByHashtag:input_type -> v1.GetPostsByHashtagRequest
+	93,  // 109: v1.UnpaperService.GetTrendingHashtags:input_type -> v1.GetTrendingHashtagsRequest
+	94,  // 110: v1.UnpaperService.RecordPlayback:input_type -> v1.RecordPlaybackRequest
+	95,  // 111: v1.UnpaperService.GetPostAnalytics:input_type -> v1.GetPostAnalyticsRequest
+	96,  // 112: v1.UnpaperService.Report:input_type -> v1.ReportRequest
+	97,  // 113: v1.UnpaperService.ListReports:input_type -> v1.ListReportsRequest
+	98,  // 114: v1.UnpaperService.ClaimReport:input_type -> v1.ClaimReportRequest
+	99,  // 115: v1.UnpaperService.ResolveReport:input_type -> v1.ResolveReportRequest
+	100, // 116: v1.UnpaperService.GetModerationLog:input_type -> v1.GetModerationLogRequest
+	101, // 117: v1.UnpaperService.VotePoll:input_type -> v1.VotePollRequest
+	102, // 118: v1.UnpaperService.Ping:output_type -> v1.User
+	103, // 119: v1.UnpaperService.GoogleLogin:output_type -> v1.GoogleLoginResponse
+	102, // 120: v1.UnpaperService.GoogleCallback:output_type -> v1.User
+	102, // 121: v1.UnpaperService.GoogleOneTap:output_type -> v1.User
+	102, // 122: v1.UnpaperService.EmailSignup:output_type -> v1.User
+	102, // 123: v1.UnpaperService.EmailSignin:output_type -> v1.User
+	3,   // 124: v1.UnpaperService.EmailVerify:output_type -> google.protobuf.Empty
+	3,   // 125: v1.UnpaperService.EmailCheck:output_type -> google.protobuf.Empty
+	3,   // 126: v1.UnpaperService.ChangePassword:output_type -> google.protobuf.Empty
+	3,   // 127: v1.UnpaperService.SendResetLink:output_type -> google.protobuf.Empty
+	3,   // 128: v1.UnpaperService.ResetPassword:output_type -> google.protobuf.Empty
+	102, // 129: v1.UnpaperService.UpdateUsername:output_type -> v1.User
+	102, // 130: v1.UnpaperService.SetMessageRequestsPolicy:output_type -> v1.User
+	3,   // 131: v1.UnpaperService.SignOut:output_type -> google.protobuf.Empty
+	3,   // 132: v1.UnpaperService.SetUserOnline:output_type -> google.protobuf.Empty
+	3,   // 133: v1.UnpaperService.SetUserOffline:output_type -> google.protobuf.Empty
+	104, // 134: v1.UnpaperService.FollowUser:output_type -> v1.ExtUserInfoResponse
+	105, // 135: v1.UnpaperService.GetFollowers:output_type -> v1.GetFollowersResponse
+	106, // 136: v1.UnpaperService.GetFollowing:output_type -> v1.GetFollowingResponse
+	107, // 137: v1.UnpaperService.GetFollowingCount:output_type -> v1.GetFollowingCountResponse
+	108, // 138: v1.UnpaperService.GetFollowersCount:output_type -> v1.GetFollowersCountResponse
+	3,   // 139: v1.UnpaperService.BlockUser:output_type -> google.protobuf.Empty
+	3,   // 140: v1.UnpaperService.UnblockUser:output_type -> google.protobuf.Empty
+	102, // 141: v1.UnpaperService.UserInfo:output_type -> v1.User
+	104, // 142: v1.UnpaperService.ExtUserInfo:output_type -> v1.ExtUserInfoResponse
+	109, // 143: v1.UnpaperService.CustomerInfo:output_type -> v1.Customer
+	3,   // 144: v1.UnpaperService.StripeWebhook:output_type -> google.protobuf.Empty
+	3,   // 145: v1.UnpaperService.StripeConnectWebhook:output_type -> google.protobuf.Empty
+	109, // 146: v1.UnpaperService.SubscribeToPlan:output_type -> v1.Customer
+	110, // 147: v1.UnpaperService.RetryInvoice:output_type -> v1.Invoice
+	111, // 148: v1.UnpaperService.GetSubscriptionByID:output_type -> v1.GetSubscriptionByIDResponse
+	112, // 149: v1.UnpaperService.CreateSetupIntent:output_type -> v1.CreateSetupIntentResponse
+	113, // 150: v1.UnpaperService.AttachPaymentMethod:output_type -> v1.PaymentMethod
+	109, // 151: v1.UnpaperService.UpdateSubscription:output_type -> v1.Customer
+	110, // 152: v1.UnpaperService.InvoicePreview:output_type -> v1.Invoice
+	114, // 153: v1.UnpaperService.CouponCheck:output_type -> v1.CouponCheckResponse
+	115, // 154: v1.UnpaperService.GetConnectAccountLink:output_type -> v1.GetConnectAccountLinkResponse
+	116, // 155: v1.UnpaperService.MakeDonation:output_type -> v1.ConnectedPaymentIntentResponse
+	116, // 156: v1.UnpaperService.PayRoomEntrance:output_type -> v1.ConnectedPaymentIntentResponse
+	109, // 157: v1.UnpaperService.CreateStripeAccount:output_type -> v1.Customer
+	117, // 158: v1.UnpaperService.GetDashboardLink:output_type -> v1.GetDashboardLinkResponse
+	118, // 159: v1.UnpaperService.CheckRoomEntrancePI:output_type -> v1.CheckRoomEntrancePIResponse
+	119, // 160: v1.UnpaperService.SubscribeToRoom:output_type -> v1.SubscribeToRoomResponse
+	120, // 161: v1.UnpaperService.GetRoomSubscriptions:output_type -> v1.GetRoomSubscriptionsResponse
+	121, // 162: v1.UnpaperService.ConfirmRoomSubscription:output_type -> v1.ConfirmRoomSubscriptionResponse
+	116, // 163: v1.UnpaperService.RetryRoomSubscription:output_type -> v1.ConnectedPaymentIntentResponse
+	122, // 164: v1.UnpaperService.GetRoomSubscriptionByRoomID:output_type -> v1.GetRoomSubscriptionByRoomIDResponse
+	123, // 165: v1.UnpaperService.GetOwnConnectedAccount:output_type -> v1.GetOwnConnectedAccountResponse
+	124, // 166: v1.UnpaperService.GetMessages:output_type -> v1.GetMessagesResponse
+	125, // 167: v1.UnpaperService.ListenForMessages:output_type -> v1.ChatMessage
+	3,   // 168: v1.UnpaperService.SendMessage:output_type -> google.protobuf.Empty
+	3,   // 169: v1.UnpaperService.SendAward:output_type -> google.protobuf.Empty
+	3,   // 170: v1.UnpaperService.SendDonation:output_type -> google.protobuf.Empty
+	3,   // 171: v1.UnpaperService.SendAudio:output_type -> google.protobuf.Empty
+	126, // 172: v1.UnpaperService.CreateList:output_type -> v1.List
+	126, // 173: v1.UnpaperService.UpdateList:output_type -> v1.List
+	127, // 174: v1.UnpaperService.GetUserSuggestions:output_type -> v1.GetUserSuggestionsResponse
+	128, // 175: v1.UnpaperService.GetAllLists:output_type -> v1.GetAllListsResponse
+	126, // 176: v1.UnpaperService.GetListByID:output_type -> v1.List
+	129, // 177: v1.UnpaperService.RoomAccessCheck:output_type -> v1.RoomAccessCheckResponse
+	130, // 178: v1.UnpaperService.CreateConversation:output_type -> v1.CreateConversationResponse
+	131, // 179: v1.UnpaperService.GetConversation:output_type -> v1.GetConversationResponse
+	132, // 180: v1.UnpaperService.GetConversations:output_type -> v1.GetConversationsResponse
+	133, // 181: v1.UnpaperService.GetConversationWithParticipants:output_type -> v1.GetConversationWithParticipantsResponse
+	134, // 182: v1.UnpaperService.SetConversationRetention:output_type -> v1.SetConversationRetentionResponse
+	135, // 183: v1.UnpaperService.SetConversationMuted:output_type -> v1.SetConversationMutedResponse
+	136, // 184: v1.UnpaperService.ExportConversation:output_type -> v1.ExportConversationChunk
+	137, // 185: v1.UnpaperService.GetMessageRequests:output_type -> v1.GetMessageRequestsResponse
+	138, // 186: v1.UnpaperService.RespondToMessageRequest:output_type -> v1.RespondToMessageRequestResponse
+	139, // 187: v1.UnpaperService.ListScheduledMessages:output_type -> v1.ListScheduledMessagesResponse
+	3,   // 188: v1.UnpaperService.CancelScheduledMessage:output_type -> google.protobuf.Empty
+	140, // 189: v1.UnpaperService.ListenForNotifications:output_type -> v1.Notification
+	141, // 190: v1.UnpaperService.GetAllNotifications:output_type -> v1.GetAllNotificationsRes
+	142, // 191: v1.UnpaperService.ReadNotification:output_type -> v1.ReadNotificationResponse
+	143, // 192: v1.UnpaperService.GetMixes:output_type -> v1.GetMixesRes
+	144, // 193: v1.UnpaperService.CreatePost:output_type -> v1.CreatePostResponse
+	145, // 194: v1.UnpaperService.GetPost:output_type -> v1.GetPostResponse
+	146, // 195: v1.UnpaperService.GetPosts:output_type -> v1.GetPostsResponse
+	147, // 196: v1.UnpaperService.GetPostCategories:output_type -> v1.GetPostCategoriesResponse
+	148, // 197: v1.UnpaperService.GetComments:output_type -> v1.GetCommentsResponse
+	149, // 198: v1.UnpaperService.GetCommentTree:output_type -> v1.GetCommentTreeResponse
+	150, // 199: v1.UnpaperService.GetThread:output_type -> v1.GetThreadResponse
+	151, // 200: v1.UnpaperService.SubscribeToThread:output_type -> v1.SubscribeToThreadResponse
+	152, // 201: v1.UnpaperService.UnsubscribeFromThread:output_type -> v1.UnsubscribeFromThreadResponse
+	153, // 202: v1.UnpaperService.GetHomeFeed:output_type -> v1.GetHomeFeedResponse
+	154, // 203: v1.UnpaperService.GetTrendingPosts:output_type -> v1.GetTrendingPostsResponse
+	155, // 204: v1.UnpaperService.UpdatePost:output_type -> v1.UpdatePostResponse
+	156, // 205: v1.UnpaperService.DeletePost:output_type -> v1.DeletePostResponse
+	157, // 206: v1.UnpaperService.UpdateComment:output_type -> v1.UpdateCommentResponse
+	158, // 207: v1.UnpaperService.DeleteComment:output_type -> v1.DeleteCommentResponse
+	159, // 208: v1.UnpaperService.GetEditHistory:output_type -> v1.GetEditHistoryResponse
+	160, // 209: v1.UnpaperService.CreateComment:output_type -> v1.CreateCommentResponse
+	161, // 210: v1.UnpaperService.LikePost:output_type -> v1.LikePostResponse
+	162, // 211: v1.UnpaperService.LikeComment:output_type -> v1.LikeCommentResponse
+	163, // 212: v1.UnpaperService.GetPostLikers:output_type -> v1.GetPostLikersResponse
+	164, // 213: v1.UnpaperService.GetCommentLikers:output_type -> v1.GetCommentLikersResponse
+	165, // 214: v1.UnpaperService.BookmarkPost:output_type -> v1.BookmarkPostResponse
+	166, // 215: v1.UnpaperService.UnbookmarkPost:output_type -> v1.UnbookmarkPostResponse
+	167, // 216: v1.UnpaperService.GetBookmarks:output_type -> v1.GetBookmarksResponse
+	168, // 217: v1.UnpaperService.GetBookmarkCollections:output_type -> v1.GetBookmarkCollectionsResponse
+	169, // 218: v1.UnpaperService.Repost:output_type -> v1.RepostResponse
+	170, // 219: v1.UnpaperService.UndoRepost:output_type -> v1.UndoRepostResponse
+	171, // 220: v1.UnpaperService.GetDrafts:output_type -> v1.GetDraftsResponse
+	172, // 221: v1.UnpaperService.GetScheduledPosts:output_type -> v1.GetScheduledPostsResponse
+	173, // 222: v1.UnpaperService.SchedulePost:output_type -> v1.SchedulePostResponse
+	174, // 223: v1.UnpaperService.CancelScheduledPost:output_type -> v1.CancelScheduledPostResponse
+	175, // 224: v1.UnpaperService.PublishPost:output_type -> v1.PublishPostResponse
+	176, // 225: v1.UnpaperService.Search:output_type -> v1.SearchResponse
+	177, // 226: v1.UnpaperService.GetPostsByHashtag:output_type -> v1.GetPostsByHashtagResponse
+	178, // 227: v1.UnpaperService.GetTrendingHashtags:output_type -> v1.GetTrendingHashtagsResponse
+	179, // 228: v1.UnpaperService.RecordPlayback:output_type -> v1.RecordPlaybackResponse
+	180, // 229: v1.UnpaperService.GetPostAnalytics:output_type -> v1.GetPostAnalyticsResponse
+	3,   // 230: v1.UnpaperService.Report:output_type -> google.protobuf.Empty
+	181, // 231: v1.UnpaperService.ListReports:output_type -> v1.ListReportsResponse
+	182, // 232: v1.UnpaperService.ClaimReport:output_type -> v1.ClaimReportResponse
+	183, // 233: v1.UnpaperService.ResolveReport:output_type -> v1.ResolveReportResponse
+	184, // 234: v1.UnpaperService.GetModerationLog:output_type -> v1.GetModerationLogResponse
+	185, // 235: v1.UnpaperService.VotePoll:output_type -> v1.VotePollResponse
+	118, // [118:236] is the sub-list for method output_type
+	0,   // [0:118] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_v1_unpaper_service_proto_init() }
@@ -701,6 +1101,9 @@ func file_api_proto_v1_unpaper_service_proto_init() {
 	file_api_proto_v1_posts_proto_init()
 	file_api_proto_v1_notifications_proto_init()
 	file_api_proto_v1_mixes_proto_init()
+	file_api_proto_v1_search_proto_init()
+	file_api_proto_v1_moderation_proto_init()
+	file_api_proto_v1_polls_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_api_proto_v1_unpaper_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PingRequest); i {
@@ -761,6 +1164,7 @@ type UnpaperServiceClient interface {
 	SendResetLink(ctx context.Context, in *SendResetLinkRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	UpdateUsername(ctx context.Context, in *UpdateUsernameRequest, opts ...grpc.CallOption) (*User, error)
+	SetMessageRequestsPolicy(ctx context.Context, in *SetMessageRequestsPolicyRequest, opts ...grpc.CallOption) (*User, error)
 	SignOut(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
 	SetUserOnline(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
 	SetUserOffline(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
@@ -769,6 +1173,8 @@ type UnpaperServiceClient interface {
 	GetFollowing(ctx context.Context, in *GetFollowingRequest, opts ...grpc.CallOption) (*GetFollowingResponse, error)
 	GetFollowingCount(ctx context.Context, in *GetFollowingCountRequest, opts ...grpc.CallOption) (*GetFollowingCountResponse, error)
 	GetFollowersCount(ctx context.Context, in *GetFollowersCountRequest, opts ...grpc.CallOption) (*GetFollowersCountResponse, error)
+	BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Info
 	UserInfo(ctx context.Context, in *UserInfoRequest, opts ...grpc.CallOption) (*User, error)
 	ExtUserInfo(ctx context.Context, in *ExtUserInfoRequest, opts ...grpc.CallOption) (*ExtUserInfoResponse, error)
@@ -813,6 +1219,13 @@ type UnpaperServiceClient interface {
 	GetConversation(ctx context.Context, in *GetConversationRequest, opts ...grpc.CallOption) (*GetConversationResponse, error)
 	GetConversations(ctx context.Context, in *GetConversationsRequest, opts ...grpc.CallOption) (*GetConversationsResponse, error)
 	GetConversationWithParticipants(ctx context.Context, in *GetConversationWithParticipantsRequest, opts ...grpc.CallOption) (*GetConversationWithParticipantsResponse, error)
+	SetConversationRetention(ctx context.Context, in *SetConversationRetentionRequest, opts ...grpc.CallOption) (*SetConversationRetentionResponse, error)
+	SetConversationMuted(ctx context.Context, in *SetConversationMutedRequest, opts ...grpc.CallOption) (*SetConversationMutedResponse, error)
+	ExportConversation(ctx context.Context, in *ExportConversationRequest, opts ...grpc.CallOption) (UnpaperService_ExportConversationClient, error)
+	GetMessageRequests(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetMessageRequestsResponse, error)
+	RespondToMessageRequest(ctx context.Context, in *RespondToMessageRequestRequest, opts ...grpc.CallOption) (*RespondToMessageRequestResponse, error)
+	ListScheduledMessages(ctx context.Context, in *ListScheduledMessagesRequest, opts ...grpc.CallOption) (*ListScheduledMessagesResponse, error)
+	CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Notifications
 	ListenForNotifications(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (UnpaperService_ListenForNotificationsClient, error)
 	GetAllNotifications(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetAllNotificationsRes, error)
@@ -823,9 +1236,46 @@ type UnpaperServiceClient interface {
 	CreatePost(ctx context.Context, in *CreatePostRequest, opts ...grpc.CallOption) (*CreatePostResponse, error)
 	GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*GetPostResponse, error)
 	GetPosts(ctx context.Context, in *GetPostsRequest, opts ...grpc.CallOption) (*GetPostsResponse, error)
+	GetPostCategories(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetPostCategoriesResponse, error)
+	GetComments(ctx context.Context, in *GetCommentsRequest, opts ...grpc.CallOption) (*GetCommentsResponse, error)
+	GetCommentTree(ctx context.Context, in *GetCommentTreeRequest, opts ...grpc.CallOption) (*GetCommentTreeResponse, error)
+	GetThread(ctx context.Context, in *GetThreadRequest, opts ...grpc.CallOption) (*GetThreadResponse, error)
+	SubscribeToThread(ctx context.Context, in *SubscribeToThreadRequest, opts ...grpc.CallOption) (*SubscribeToThreadResponse, error)
+	UnsubscribeFromThread(ctx context.Context, in *UnsubscribeFromThreadRequest, opts ...grpc.CallOption) (*UnsubscribeFromThreadResponse, error)
+	GetHomeFeed(ctx context.Context, in *GetHomeFeedRequest, opts ...grpc.CallOption) (*GetHomeFeedResponse, error)
+	GetTrendingPosts(ctx context.Context, in *GetTrendingPostsRequest, opts ...grpc.CallOption) (*GetTrendingPostsResponse, error)
+	UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*UpdatePostResponse, error)
+	DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error)
+	UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentResponse, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
+	GetEditHistory(ctx context.Context, in *GetEditHistoryRequest, opts ...grpc.CallOption) (*GetEditHistoryResponse, error)
 	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error)
 	LikePost(ctx context.Context, in *LikePostRequest, opts ...grpc.CallOption) (*LikePostResponse, error)
 	LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeCommentResponse, error)
+	GetPostLikers(ctx context.Context, in *GetPostLikersRequest, opts ...grpc.CallOption) (*GetPostLikersResponse, error)
+	GetCommentLikers(ctx context.Context, in *GetCommentLikersRequest, opts ...grpc.CallOption) (*GetCommentLikersResponse, error)
+	BookmarkPost(ctx context.Context, in *BookmarkPostRequest, opts ...grpc.CallOption) (*BookmarkPostResponse, error)
+	UnbookmarkPost(ctx context.Context, in *UnbookmarkPostRequest, opts ...grpc.CallOption) (*UnbookmarkPostResponse, error)
+	GetBookmarks(ctx context.Context, in *GetBookmarksRequest, opts ...grpc.CallOption) (*GetBookmarksResponse, error)
+	GetBookmarkCollections(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetBookmarkCollectionsResponse, error)
+	Repost(ctx context.Context, in *RepostRequest, opts ...grpc.CallOption) (*RepostResponse, error)
+	UndoRepost(ctx context.Context, in *UndoRepostRequest, opts ...grpc.CallOption) (*UndoRepostResponse, error)
+	GetDrafts(ctx context.Context, in *GetDraftsRequest, opts ...grpc.CallOption) (*GetDraftsResponse, error)
+	GetScheduledPosts(ctx context.Context, in *GetScheduledPostsRequest, opts ...grpc.CallOption) (*GetScheduledPostsResponse, error)
+	SchedulePost(ctx context.Context, in *SchedulePostRequest, opts ...grpc.CallOption) (*SchedulePostResponse, error)
+	CancelScheduledPost(ctx context.Context, in *CancelScheduledPostRequest, opts ...grpc.CallOption) (*CancelScheduledPostResponse, error)
+	PublishPost(ctx context.Context, in *PublishPostRequest, opts ...grpc.CallOption) (*PublishPostResponse, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	GetPostsByHashtag(ctx context.Context, in *GetPostsByHashtagRequest, opts ...grpc.CallOption) (*GetPostsByHashtagResponse, error)
+	GetTrendingHashtags(ctx context.Context, in *GetTrendingHashtagsRequest, opts ...grpc.CallOption) (*GetTrendingHashtagsResponse, error)
+	RecordPlayback(ctx context.Context, in *RecordPlaybackRequest, opts ...grpc.CallOption) (*RecordPlaybackResponse, error)
+	GetPostAnalytics(ctx context.Context, in *GetPostAnalyticsRequest, opts ...grpc.CallOption) (*GetPostAnalyticsResponse, error)
+	Report(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error)
+	ClaimReport(ctx context.Context, in *ClaimReportRequest, opts ...grpc.CallOption) (*ClaimReportResponse, error)
+	ResolveReport(ctx context.Context, in *ResolveReportRequest, opts ...grpc.CallOption) (*ResolveReportResponse, error)
+	GetModerationLog(ctx context.Context, in *GetModerationLogRequest, opts ...grpc.CallOption) (*GetModerationLogResponse, error)
+	VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*VotePollResponse, error)
 }
 
 type unpaperServiceClient struct {
@@ -944,6 +1394,15 @@ func (c *unpaperServiceClient) UpdateUsername(ctx context.Context, in *UpdateUse
 	return out, nil
 }
 
+func (c *unpaperServiceClient) SetMessageRequestsPolicy(ctx context.Context, in *SetMessageRequestsPolicyRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/SetMessageRequestsPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *unpaperServiceClient) SignOut(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/v1.UnpaperService/SignOut", in, out, opts...)
@@ -1016,6 +1475,24 @@ func (c *unpaperServiceClient) GetFollowersCount(ctx context.Context, in *GetFol
 	return out, nil
 }
 
+func (c *unpaperServiceClient) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/BlockUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unpaperServiceClient) UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/UnblockUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *unpaperServiceClient) UserInfo(ctx context.Context, in *UserInfoRequest, opts ...grpc.CallOption) (*User, error) {
 	out := new(User)
 	err := c.cc.Invoke(ctx, "/v1.UnpaperService/UserInfo", in, out, opts...)
@@ -1408,8 +1885,94 @@ func (c *unpaperServiceClient) GetConversationWithParticipants(ctx context.Conte
 	return out, nil
 }
 
+func (c *unpaperServiceClient) SetConversationRetention(ctx context.Context, in *SetConversationRetentionRequest, opts ...grpc.CallOption) (*SetConversationRetentionResponse, error) {
+	out := new(SetConversationRetentionResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/SetConversationRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unpaperServiceClient) SetConversationMuted(ctx context.Context, in *SetConversationMutedRequest, opts ...grpc.CallOption) (*SetConversationMutedResponse, error) {
+	out := new(SetConversationMutedResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/SetConversationMuted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unpaperServiceClient) ExportConversation(ctx context.Context, in *ExportConversationRequest, opts ...grpc.CallOption) (UnpaperService_ExportConversationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_UnpaperService_serviceDesc.Streams[1], "/v1.UnpaperService/ExportConversation", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &unpaperServiceExportConversationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UnpaperService_ExportConversationClient interface {
+	Recv() (*ExportConversationChunk, error)
+	grpc.ClientStream
+}
+
+type unpaperServiceExportConversationClient struct {
+	grpc.ClientStream
+}
+
+func (x *unpaperServiceExportConversationClient) Recv() (*ExportConversationChunk, error) {
+	m := new(ExportConversationChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *unpaperServiceClient) GetMessageRequests(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetMessageRequestsResponse, error) {
+	out := new(GetMessageRequestsResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/GetMessageRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unpaperServiceClient) RespondToMessageRequest(ctx context.Context, in *RespondToMessageRequestRequest, opts ...grpc.CallOption) (*RespondToMessageRequestResponse, error) {
+	out := new(RespondToMessageRequestResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/RespondToMessageRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unpaperServiceClient) ListScheduledMessages(ctx context.Context, in *ListScheduledMessagesRequest, opts ...grpc.CallOption) (*ListScheduledMessagesResponse, error) {
+	out := new(ListScheduledMessagesResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/ListScheduledMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unpaperServiceClient) CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/CancelScheduledMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *unpaperServiceClient) ListenForNotifications(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (UnpaperService_ListenForNotificationsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_UnpaperService_serviceDesc.Streams[1], "/v1.UnpaperService/ListenForNotifications", opts...)
+	stream, err := c.cc.NewStream(ctx, &_UnpaperService_serviceDesc.Streams[2], "/v1.UnpaperService/ListenForNotifications", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1494,277 +2057,666 @@ func (c *unpaperServiceClient) GetPosts(ctx context.Context, in *GetPostsRequest
 	return out, nil
 }
 
-func (c *unpaperServiceClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error) {
-	out := new(CreateCommentResponse)
-	err := c.cc.Invoke(ctx, "/v1.UnpaperService/CreateComment", in, out, opts...)
+func (c *unpaperServiceClient) GetPostCategories(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetPostCategoriesResponse, error) {
+	out := new(GetPostCategoriesResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/GetPostCategories", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *unpaperServiceClient) LikePost(ctx context.Context, in *LikePostRequest, opts ...grpc.CallOption) (*LikePostResponse, error) {
-	out := new(LikePostResponse)
-	err := c.cc.Invoke(ctx, "/v1.UnpaperService/LikePost", in, out, opts...)
+func (c *unpaperServiceClient) GetComments(ctx context.Context, in *GetCommentsRequest, opts ...grpc.CallOption) (*GetCommentsResponse, error) {
+	out := new(GetCommentsResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/GetComments", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *unpaperServiceClient) LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeCommentResponse, error) {
-	out := new(LikeCommentResponse)
-	err := c.cc.Invoke(ctx, "/v1.UnpaperService/LikeComment", in, out, opts...)
+func (c *unpaperServiceClient) GetCommentTree(ctx context.Context, in *GetCommentTreeRequest, opts ...grpc.CallOption) (*GetCommentTreeResponse, error) {
+	out := new(GetCommentTreeResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/GetCommentTree", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// UnpaperServiceServer is the server API for UnpaperService service.
-type UnpaperServiceServer interface {
-	// Ping
-	Ping(context.Context, *PingRequest) (*User, error)
-	// Auth
-	GoogleLogin(context.Context, *GoogleLoginRequest) (*GoogleLoginResponse, error)
-	GoogleCallback(context.Context, *GoogleCallbackRequest) (*User, error)
-	GoogleOneTap(context.Context, *empty.Empty) (*User, error)
-	EmailSignup(context.Context, *EmailSignupRequest) (*User, error)
-	EmailSignin(context.Context, *EmailSigninRequest) (*User, error)
-	EmailVerify(context.Context, *EmailVerifyRequest) (*empty.Empty, error)
-	EmailCheck(context.Context, *EmailCheckRequest) (*empty.Empty, error)
-	ChangePassword(context.Context, *ChangePasswordRequest) (*empty.Empty, error)
-	SendResetLink(context.Context, *SendResetLinkRequest) (*empty.Empty, error)
-	ResetPassword(context.Context, *ResetPasswordRequest) (*empty.Empty, error)
-	UpdateUsername(context.Context, *UpdateUsernameRequest) (*User, error)
-	SignOut(context.Context, *empty.Empty) (*empty.Empty, error)
-	SetUserOnline(context.Context, *empty.Empty) (*empty.Empty, error)
-	SetUserOffline(context.Context, *empty.Empty) (*empty.Empty, error)
-	FollowUser(context.Context, *FollowUserRequest) (*ExtUserInfoResponse, error)
-	GetFollowers(context.Context, *GetFollowersRequest) (*GetFollowersResponse, error)
-	GetFollowing(context.Context, *GetFollowingRequest) (*GetFollowingResponse, error)
-	GetFollowingCount(context.Context, *GetFollowingCountRequest) (*GetFollowingCountResponse, error)
-	GetFollowersCount(context.Context, *GetFollowersCountRequest) (*GetFollowersCountResponse, error)
-	// Info
-	UserInfo(context.Context, *UserInfoRequest) (*User, error)
-	ExtUserInfo(context.Context, *ExtUserInfoRequest) (*ExtUserInfoResponse, error)
-	CustomerInfo(context.Context, *CustomerInfoRequest) (*Customer, error)
-	// Stripe
-	StripeWebhook(context.Context, *StripeWebhookRequest) (*empty.Empty, error)
-	StripeConnectWebhook(context.Context, *StripeWebhookRequest) (*empty.Empty, error)
-	SubscribeToPlan(context.Context, *SubscribeToPlanRequest) (*Customer, error)
-	RetryInvoice(context.Context, *RetryInvoiceRequest) (*Invoice, error)
-	GetSubscriptionByID(context.Context, *GetSubscriptionByIDRequest) (*GetSubscriptionByIDResponse, error)
-	CreateSetupIntent(context.Context, *CreateSetupIntentRequest) (*CreateSetupIntentResponse, error)
-	AttachPaymentMethod(context.Context, *AttachPaymentMethodRequest) (*PaymentMethod, error)
-	UpdateSubscription(context.Context, *UpdateSubscriptionRequest) (*Customer, error)
-	InvoicePreview(context.Context, *InvoicePreviewRequest) (*Invoice, error)
-	CouponCheck(context.Context, *CouponCheckRequest) (*CouponCheckResponse, error)
-	GetConnectAccountLink(context.Context, *empty.Empty) (*GetConnectAccountLinkResponse, error)
-	MakeDonation(context.Context, *MakeDonationRequest) (*ConnectedPaymentIntentResponse, error)
-	PayRoomEntrance(context.Context, *PayRoomEntranceRequest) (*ConnectedPaymentIntentResponse, error)
-	CreateStripeAccount(context.Context, *empty.Empty) (*Customer, error)
-	GetDashboardLink(context.Context, *empty.Empty) (*GetDashboardLinkResponse, error)
-	CheckRoomEntrancePI(context.Context, *CheckRoomEntrancePIRequest) (*CheckRoomEntrancePIResponse, error)
-	SubscribeToRoom(context.Context, *SubscribeToRoomRequest) (*SubscribeToRoomResponse, error)
-	GetRoomSubscriptions(context.Context, *empty.Empty) (*GetRoomSubscriptionsResponse, error)
-	ConfirmRoomSubscription(context.Context, *ConfirmRoomSubscriptionRequest) (*ConfirmRoomSubscriptionResponse, error)
-	RetryRoomSubscription(context.Context, *RetryRoomSubscriptionRequest) (*ConnectedPaymentIntentResponse, error)
-	GetRoomSubscriptionByRoomID(context.Context, *GetRoomSubscriptionByRoomIDRequest) (*GetRoomSubscriptionByRoomIDResponse, error)
-	GetOwnConnectedAccount(context.Context, *empty.Empty) (*GetOwnConnectedAccountResponse, error)
-	// Chat
-	GetMessages(context.Context, *GetMessagesRequest) (*GetMessagesResponse, error)
-	ListenForMessages(*ListenForMessagesRequest, UnpaperService_ListenForMessagesServer) error
-	SendMessage(context.Context, *SendMessageRequest) (*empty.Empty, error)
-	SendAward(context.Context, *SendAwardRequest) (*empty.Empty, error)
-	SendDonation(context.Context, *SendDonationRequest) (*empty.Empty, error)
-	SendAudio(context.Context, *SendAudioRequest) (*empty.Empty, error)
-	CreateList(context.Context, *CreateListRequest) (*List, error)
-	UpdateList(context.Context, *UpdateListRequest) (*List, error)
-	GetUserSuggestions(context.Context, *GetUserSuggestionsRequest) (*GetUserSuggestionsResponse, error)
-	GetAllLists(context.Context, *empty.Empty) (*GetAllListsResponse, error)
-	GetListByID(context.Context, *GetListByIDRequest) (*List, error)
-	RoomAccessCheck(context.Context, *RoomAccessCheckRequest) (*RoomAccessCheckResponse, error)
-	CreateConversation(context.Context, *CreateConversationRequest) (*CreateConversationResponse, error)
-	GetConversation(context.Context, *GetConversationRequest) (*GetConversationResponse, error)
-	GetConversations(context.Context, *GetConversationsRequest) (*GetConversationsResponse, error)
-	GetConversationWithParticipants(context.Context, *GetConversationWithParticipantsRequest) (*GetConversationWithParticipantsResponse, error)
-	// Notifications
-	ListenForNotifications(*empty.Empty, UnpaperService_ListenForNotificationsServer) error
-	GetAllNotifications(context.Context, *empty.Empty) (*GetAllNotificationsRes, error)
-	ReadNotification(context.Context, *ReadNotificationRequest) (*ReadNotificationResponse, error)
-	// Mixes
-	GetMixes(context.Context, *empty.Empty) (*GetMixesRes, error)
-	// Posts
-	CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error)
-	GetPost(context.Context, *GetPostRequest) (*GetPostResponse, error)
-	GetPosts(context.Context, *GetPostsRequest) (*GetPostsResponse, error)
-	CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error)
-	LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error)
-	LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentResponse, error)
+func (c *unpaperServiceClient) GetThread(ctx context.Context, in *GetThreadRequest, opts ...grpc.CallOption) (*GetThreadResponse, error) {
+	out := new(GetThreadResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/GetThread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedUnpaperServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedUnpaperServiceServer struct {
+func (c *unpaperServiceClient) SubscribeToThread(ctx context.Context, in *SubscribeToThreadRequest, opts ...grpc.CallOption) (*SubscribeToThreadResponse, error) {
+	out := new(SubscribeToThreadResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/SubscribeToThread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (*UnimplementedUnpaperServiceServer) Ping(context.Context, *PingRequest) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+func (c *unpaperServiceClient) UnsubscribeFromThread(ctx context.Context, in *UnsubscribeFromThreadRequest, opts ...grpc.CallOption) (*UnsubscribeFromThreadResponse, error) {
+	out := new(UnsubscribeFromThreadResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/UnsubscribeFromThread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) GoogleLogin(context.Context, *GoogleLoginRequest) (*GoogleLoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GoogleLogin not implemented")
+
+func (c *unpaperServiceClient) GetHomeFeed(ctx context.Context, in *GetHomeFeedRequest, opts ...grpc.CallOption) (*GetHomeFeedResponse, error) {
+	out := new(GetHomeFeedResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/GetHomeFeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) GoogleCallback(context.Context, *GoogleCallbackRequest) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GoogleCallback not implemented")
+
+func (c *unpaperServiceClient) GetTrendingPosts(ctx context.Context, in *GetTrendingPostsRequest, opts ...grpc.CallOption) (*GetTrendingPostsResponse, error) {
+	out := new(GetTrendingPostsResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/GetTrendingPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) GoogleOneTap(context.Context, *empty.Empty) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GoogleOneTap not implemented")
+
+func (c *unpaperServiceClient) UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*UpdatePostResponse, error) {
+	out := new(UpdatePostResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/UpdatePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) EmailSignup(context.Context, *EmailSignupRequest) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EmailSignup not implemented")
+
+func (c *unpaperServiceClient) DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error) {
+	out := new(DeletePostResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/DeletePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) EmailSignin(context.Context, *EmailSigninRequest) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EmailSignin not implemented")
+
+func (c *unpaperServiceClient) UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentResponse, error) {
+	out := new(UpdateCommentResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/UpdateComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) EmailVerify(context.Context, *EmailVerifyRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EmailVerify not implemented")
+
+func (c *unpaperServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error) {
+	out := new(DeleteCommentResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/DeleteComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) EmailCheck(context.Context, *EmailCheckRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EmailCheck not implemented")
-}
-func (*UnimplementedUnpaperServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
-}
-func (*UnimplementedUnpaperServiceServer) SendResetLink(context.Context, *SendResetLinkRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendResetLink not implemented")
-}
-func (*UnimplementedUnpaperServiceServer) ResetPassword(context.Context, *ResetPasswordRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
-}
-func (*UnimplementedUnpaperServiceServer) UpdateUsername(context.Context, *UpdateUsernameRequest) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUsername not implemented")
-}
-func (*UnimplementedUnpaperServiceServer) SignOut(context.Context, *empty.Empty) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignOut not implemented")
-}
-func (*UnimplementedUnpaperServiceServer) SetUserOnline(context.Context, *empty.Empty) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetUserOnline not implemented")
-}
-func (*UnimplementedUnpaperServiceServer) SetUserOffline(context.Context, *empty.Empty) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetUserOffline not implemented")
-}
-func (*UnimplementedUnpaperServiceServer) FollowUser(context.Context, *FollowUserRequest) (*ExtUserInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FollowUser not implemented")
-}
-func (*UnimplementedUnpaperServiceServer) GetFollowers(context.Context, *GetFollowersRequest) (*GetFollowersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFollowers not implemented")
-}
-func (*UnimplementedUnpaperServiceServer) GetFollowing(context.Context, *GetFollowingRequest) (*GetFollowingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFollowing not implemented")
-}
-func (*UnimplementedUnpaperServiceServer) GetFollowingCount(context.Context, *GetFollowingCountRequest) (*GetFollowingCountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFollowingCount not implemented")
-}
-func (*UnimplementedUnpaperServiceServer) GetFollowersCount(context.Context, *GetFollowersCountRequest) (*GetFollowersCountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFollowersCount not implemented")
-}
-func (*UnimplementedUnpaperServiceServer) UserInfo(context.Context, *UserInfoRequest) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserInfo not implemented")
-}
-func (*UnimplementedUnpaperServiceServer) ExtUserInfo(context.Context, *ExtUserInfoRequest) (*ExtUserInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExtUserInfo not implemented")
-}
-func (*UnimplementedUnpaperServiceServer) CustomerInfo(context.Context, *CustomerInfoRequest) (*Customer, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CustomerInfo not implemented")
-}
-func (*UnimplementedUnpaperServiceServer) StripeWebhook(context.Context, *StripeWebhookRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StripeWebhook not implemented")
-}
-func (*UnimplementedUnpaperServiceServer) StripeConnectWebhook(context.Context, *StripeWebhookRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StripeConnectWebhook not implemented")
+
+func (c *unpaperServiceClient) GetEditHistory(ctx context.Context, in *GetEditHistoryRequest, opts ...grpc.CallOption) (*GetEditHistoryResponse, error) {
+	out := new(GetEditHistoryResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/GetEditHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) SubscribeToPlan(context.Context, *SubscribeToPlanRequest) (*Customer, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubscribeToPlan not implemented")
+
+func (c *unpaperServiceClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error) {
+	out := new(CreateCommentResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/CreateComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) RetryInvoice(context.Context, *RetryInvoiceRequest) (*Invoice, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RetryInvoice not implemented")
+
+func (c *unpaperServiceClient) LikePost(ctx context.Context, in *LikePostRequest, opts ...grpc.CallOption) (*LikePostResponse, error) {
+	out := new(LikePostResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/LikePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) GetSubscriptionByID(context.Context, *GetSubscriptionByIDRequest) (*GetSubscriptionByIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSubscriptionByID not implemented")
+
+func (c *unpaperServiceClient) LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeCommentResponse, error) {
+	out := new(LikeCommentResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/LikeComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) CreateSetupIntent(context.Context, *CreateSetupIntentRequest) (*CreateSetupIntentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSetupIntent not implemented")
+
+func (c *unpaperServiceClient) GetPostLikers(ctx context.Context, in *GetPostLikersRequest, opts ...grpc.CallOption) (*GetPostLikersResponse, error) {
+	out := new(GetPostLikersResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/GetPostLikers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) AttachPaymentMethod(context.Context, *AttachPaymentMethodRequest) (*PaymentMethod, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AttachPaymentMethod not implemented")
+
+func (c *unpaperServiceClient) GetCommentLikers(ctx context.Context, in *GetCommentLikersRequest, opts ...grpc.CallOption) (*GetCommentLikersResponse, error) {
+	out := new(GetCommentLikersResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/GetCommentLikers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) UpdateSubscription(context.Context, *UpdateSubscriptionRequest) (*Customer, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubscription not implemented")
+
+func (c *unpaperServiceClient) BookmarkPost(ctx context.Context, in *BookmarkPostRequest, opts ...grpc.CallOption) (*BookmarkPostResponse, error) {
+	out := new(BookmarkPostResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/BookmarkPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) InvoicePreview(context.Context, *InvoicePreviewRequest) (*Invoice, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InvoicePreview not implemented")
+
+func (c *unpaperServiceClient) UnbookmarkPost(ctx context.Context, in *UnbookmarkPostRequest, opts ...grpc.CallOption) (*UnbookmarkPostResponse, error) {
+	out := new(UnbookmarkPostResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/UnbookmarkPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) CouponCheck(context.Context, *CouponCheckRequest) (*CouponCheckResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CouponCheck not implemented")
+
+func (c *unpaperServiceClient) GetBookmarks(ctx context.Context, in *GetBookmarksRequest, opts ...grpc.CallOption) (*GetBookmarksResponse, error) {
+	out := new(GetBookmarksResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/GetBookmarks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) GetConnectAccountLink(context.Context, *empty.Empty) (*GetConnectAccountLinkResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConnectAccountLink not implemented")
+
+func (c *unpaperServiceClient) GetBookmarkCollections(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetBookmarkCollectionsResponse, error) {
+	out := new(GetBookmarkCollectionsResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/GetBookmarkCollections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) MakeDonation(context.Context, *MakeDonationRequest) (*ConnectedPaymentIntentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MakeDonation not implemented")
+
+func (c *unpaperServiceClient) Repost(ctx context.Context, in *RepostRequest, opts ...grpc.CallOption) (*RepostResponse, error) {
+	out := new(RepostResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/Repost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) PayRoomEntrance(context.Context, *PayRoomEntranceRequest) (*ConnectedPaymentIntentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PayRoomEntrance not implemented")
+
+func (c *unpaperServiceClient) UndoRepost(ctx context.Context, in *UndoRepostRequest, opts ...grpc.CallOption) (*UndoRepostResponse, error) {
+	out := new(UndoRepostResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/UndoRepost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) CreateStripeAccount(context.Context, *empty.Empty) (*Customer, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateStripeAccount not implemented")
+
+func (c *unpaperServiceClient) GetDrafts(ctx context.Context, in *GetDraftsRequest, opts ...grpc.CallOption) (*GetDraftsResponse, error) {
+	out := new(GetDraftsResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/GetDrafts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) GetDashboardLink(context.Context, *empty.Empty) (*GetDashboardLinkResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDashboardLink not implemented")
+
+func (c *unpaperServiceClient) GetScheduledPosts(ctx context.Context, in *GetScheduledPostsRequest, opts ...grpc.CallOption) (*GetScheduledPostsResponse, error) {
+	out := new(GetScheduledPostsResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/GetScheduledPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) CheckRoomEntrancePI(context.Context, *CheckRoomEntrancePIRequest) (*CheckRoomEntrancePIResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckRoomEntrancePI not implemented")
+
+func (c *unpaperServiceClient) SchedulePost(ctx context.Context, in *SchedulePostRequest, opts ...grpc.CallOption) (*SchedulePostResponse, error) {
+	out := new(SchedulePostResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/SchedulePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) SubscribeToRoom(context.Context, *SubscribeToRoomRequest) (*SubscribeToRoomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubscribeToRoom not implemented")
+
+func (c *unpaperServiceClient) CancelScheduledPost(ctx context.Context, in *CancelScheduledPostRequest, opts ...grpc.CallOption) (*CancelScheduledPostResponse, error) {
+	out := new(CancelScheduledPostResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/CancelScheduledPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) GetRoomSubscriptions(context.Context, *empty.Empty) (*GetRoomSubscriptionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRoomSubscriptions not implemented")
+
+func (c *unpaperServiceClient) PublishPost(ctx context.Context, in *PublishPostRequest, opts ...grpc.CallOption) (*PublishPostResponse, error) {
+	out := new(PublishPostResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/PublishPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) ConfirmRoomSubscription(context.Context, *ConfirmRoomSubscriptionRequest) (*ConfirmRoomSubscriptionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConfirmRoomSubscription not implemented")
+
+func (c *unpaperServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) RetryRoomSubscription(context.Context, *RetryRoomSubscriptionRequest) (*ConnectedPaymentIntentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RetryRoomSubscription not implemented")
+
+func (c *unpaperServiceClient) GetPostsByHashtag(ctx context.Context, in *GetPostsByHashtagRequest, opts ...grpc.CallOption) (*GetPostsByHashtagResponse, error) {
+	out := new(GetPostsByHashtagResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/GetPostsByHashtag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) GetRoomSubscriptionByRoomID(context.Context, *GetRoomSubscriptionByRoomIDRequest) (*GetRoomSubscriptionByRoomIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRoomSubscriptionByRoomID not implemented")
+
+func (c *unpaperServiceClient) GetTrendingHashtags(ctx context.Context, in *GetTrendingHashtagsRequest, opts ...grpc.CallOption) (*GetTrendingHashtagsResponse, error) {
+	out := new(GetTrendingHashtagsResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/GetTrendingHashtags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) GetOwnConnectedAccount(context.Context, *empty.Empty) (*GetOwnConnectedAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOwnConnectedAccount not implemented")
+
+func (c *unpaperServiceClient) RecordPlayback(ctx context.Context, in *RecordPlaybackRequest, opts ...grpc.CallOption) (*RecordPlaybackResponse, error) {
+	out := new(RecordPlaybackResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/RecordPlayback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) GetMessages(context.Context, *GetMessagesRequest) (*GetMessagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMessages not implemented")
+
+func (c *unpaperServiceClient) GetPostAnalytics(ctx context.Context, in *GetPostAnalyticsRequest, opts ...grpc.CallOption) (*GetPostAnalyticsResponse, error) {
+	out := new(GetPostAnalyticsResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/GetPostAnalytics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) ListenForMessages(*ListenForMessagesRequest, UnpaperService_ListenForMessagesServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListenForMessages not implemented")
+
+func (c *unpaperServiceClient) Report(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/Report", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) SendMessage(context.Context, *SendMessageRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
+
+func (c *unpaperServiceClient) ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error) {
+	out := new(ListReportsResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/ListReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) SendAward(context.Context, *SendAwardRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendAward not implemented")
+
+func (c *unpaperServiceClient) ClaimReport(ctx context.Context, in *ClaimReportRequest, opts ...grpc.CallOption) (*ClaimReportResponse, error) {
+	out := new(ClaimReportResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/ClaimReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) SendDonation(context.Context, *SendDonationRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendDonation not implemented")
+
+func (c *unpaperServiceClient) ResolveReport(ctx context.Context, in *ResolveReportRequest, opts ...grpc.CallOption) (*ResolveReportResponse, error) {
+	out := new(ResolveReportResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/ResolveReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) SendAudio(context.Context, *SendAudioRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendAudio not implemented")
+
+func (c *unpaperServiceClient) GetModerationLog(ctx context.Context, in *GetModerationLogRequest, opts ...grpc.CallOption) (*GetModerationLogResponse, error) {
+	out := new(GetModerationLogResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/GetModerationLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) CreateList(context.Context, *CreateListRequest) (*List, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateList not implemented")
+
+func (c *unpaperServiceClient) VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*VotePollResponse, error) {
+	out := new(VotePollResponse)
+	err := c.cc.Invoke(ctx, "/v1.UnpaperService/VotePoll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedUnpaperServiceServer) UpdateList(context.Context, *UpdateListRequest) (*List, error) {
+
+// UnpaperServiceServer is the server API for UnpaperService service.
+type UnpaperServiceServer interface {
+	// Ping
+	Ping(context.Context, *PingRequest) (*User, error)
+	// Auth
+	GoogleLogin(context.Context, *GoogleLoginRequest) (*GoogleLoginResponse, error)
+	GoogleCallback(context.Context, *GoogleCallbackRequest) (*User, error)
+	GoogleOneTap(context.Context, *empty.Empty) (*User, error)
+	EmailSignup(context.Context, *EmailSignupRequest) (*User, error)
+	EmailSignin(context.Context, *EmailSigninRequest) (*User, error)
+	EmailVerify(context.Context, *EmailVerifyRequest) (*empty.Empty, error)
+	EmailCheck(context.Context, *EmailCheckRequest) (*empty.Empty, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*empty.Empty, error)
+	SendResetLink(context.Context, *SendResetLinkRequest) (*empty.Empty, error)
+	ResetPassword(context.Context, *ResetPasswordRequest) (*empty.Empty, error)
+	UpdateUsername(context.Context, *UpdateUsernameRequest) (*User, error)
+	SetMessageRequestsPolicy(context.Context, *SetMessageRequestsPolicyRequest) (*User, error)
+	SignOut(context.Context, *empty.Empty) (*empty.Empty, error)
+	SetUserOnline(context.Context, *empty.Empty) (*empty.Empty, error)
+	SetUserOffline(context.Context, *empty.Empty) (*empty.Empty, error)
+	FollowUser(context.Context, *FollowUserRequest) (*ExtUserInfoResponse, error)
+	GetFollowers(context.Context, *GetFollowersRequest) (*GetFollowersResponse, error)
+	GetFollowing(context.Context, *GetFollowingRequest) (*GetFollowingResponse, error)
+	GetFollowingCount(context.Context, *GetFollowingCountRequest) (*GetFollowingCountResponse, error)
+	GetFollowersCount(context.Context, *GetFollowersCountRequest) (*GetFollowersCountResponse, error)
+	BlockUser(context.Context, *BlockUserRequest) (*empty.Empty, error)
+	UnblockUser(context.Context, *UnblockUserRequest) (*empty.Empty, error)
+	// Info
+	UserInfo(context.Context, *UserInfoRequest) (*User, error)
+	ExtUserInfo(context.Context, *ExtUserInfoRequest) (*ExtUserInfoResponse, error)
+	CustomerInfo(context.Context, *CustomerInfoRequest) (*Customer, error)
+	// Stripe
+	StripeWebhook(context.Context, *StripeWebhookRequest) (*empty.Empty, error)
+	StripeConnectWebhook(context.Context, *StripeWebhookRequest) (*empty.Empty, error)
+	SubscribeToPlan(context.Context, *SubscribeToPlanRequest) (*Customer, error)
+	RetryInvoice(context.Context, *RetryInvoiceRequest) (*Invoice, error)
+	GetSubscriptionByID(context.Context, *GetSubscriptionByIDRequest) (*GetSubscriptionByIDResponse, error)
+	CreateSetupIntent(context.Context, *CreateSetupIntentRequest) (*CreateSetupIntentResponse, error)
+	AttachPaymentMethod(context.Context, *AttachPaymentMethodRequest) (*PaymentMethod, error)
+	UpdateSubscription(context.Context, *UpdateSubscriptionRequest) (*Customer, error)
+	InvoicePreview(context.Context, *InvoicePreviewRequest) (*Invoice, error)
+	CouponCheck(context.Context, *CouponCheckRequest) (*CouponCheckResponse, error)
+	GetConnectAccountLink(context.Context, *empty.Empty) (*GetConnectAccountLinkResponse, error)
+	MakeDonation(context.Context, *MakeDonationRequest) (*ConnectedPaymentIntentResponse, error)
+	PayRoomEntrance(context.Context, *PayRoomEntranceRequest) (*ConnectedPaymentIntentResponse, error)
+	CreateStripeAccount(context.Context, *empty.Empty) (*Customer, error)
+	GetDashboardLink(context.Context, *empty.Empty) (*GetDashboardLinkResponse, error)
+	CheckRoomEntrancePI(context.Context, *CheckRoomEntrancePIRequest) (*CheckRoomEntrancePIResponse, error)
+	SubscribeToRoom(context.Context, *SubscribeToRoomRequest) (*SubscribeToRoomResponse, error)
+	GetRoomSubscriptions(context.Context, *empty.Empty) (*GetRoomSubscriptionsResponse, error)
+	ConfirmRoomSubscription(context.Context, *ConfirmRoomSubscriptionRequest) (*ConfirmRoomSubscriptionResponse, error)
+	RetryRoomSubscription(context.Context, *RetryRoomSubscriptionRequest) (*ConnectedPaymentIntentResponse, error)
+	GetRoomSubscriptionByRoomID(context.Context, *GetRoomSubscriptionByRoomIDRequest) (*GetRoomSubscriptionByRoomIDResponse, error)
+	GetOwnConnectedAccount(context.Context, *empty.Empty) (*GetOwnConnectedAccountResponse, error)
+	// Chat
+	GetMessages(context.Context, *GetMessagesRequest) (*GetMessagesResponse, error)
+	ListenForMessages(*ListenForMessagesRequest, UnpaperService_ListenForMessagesServer) error
+	SendMessage(context.Context, *SendMessageRequest) (*empty.Empty, error)
+	SendAward(context.Context, *SendAwardRequest) (*empty.Empty, error)
+	SendDonation(context.Context, *SendDonationRequest) (*empty.Empty, error)
+	SendAudio(context.Context, *SendAudioRequest) (*empty.Empty, error)
+	CreateList(context.Context, *CreateListRequest) (*List, error)
+	UpdateList(context.Context, *UpdateListRequest) (*List, error)
+	GetUserSuggestions(context.Context, *GetUserSuggestionsRequest) (*GetUserSuggestionsResponse, error)
+	GetAllLists(context.Context, *empty.Empty) (*GetAllListsResponse, error)
+	GetListByID(context.Context, *GetListByIDRequest) (*List, error)
+	RoomAccessCheck(context.Context, *RoomAccessCheckRequest) (*RoomAccessCheckResponse, error)
+	CreateConversation(context.Context, *CreateConversationRequest) (*CreateConversationResponse, error)
+	GetConversation(context.Context, *GetConversationRequest) (*GetConversationResponse, error)
+	GetConversations(context.Context, *GetConversationsRequest) (*GetConversationsResponse, error)
+	GetConversationWithParticipants(context.Context, *GetConversationWithParticipantsRequest) (*GetConversationWithParticipantsResponse, error)
+	SetConversationRetention(context.Context, *SetConversationRetentionRequest) (*SetConversationRetentionResponse, error)
+	SetConversationMuted(context.Context, *SetConversationMutedRequest) (*SetConversationMutedResponse, error)
+	ExportConversation(*ExportConversationRequest, UnpaperService_ExportConversationServer) error
+	GetMessageRequests(context.Context, *empty.Empty) (*GetMessageRequestsResponse, error)
+	RespondToMessageRequest(context.Context, *RespondToMessageRequestRequest) (*RespondToMessageRequestResponse, error)
+	ListScheduledMessages(context.Context, *ListScheduledMessagesRequest) (*ListScheduledMessagesResponse, error)
+	CancelScheduledMessage(context.Context, *CancelScheduledMessageRequest) (*empty.Empty, error)
+	// Notifications
+	ListenForNotifications(*empty.Empty, UnpaperService_ListenForNotificationsServer) error
+	GetAllNotifications(context.Context, *empty.Empty) (*GetAllNotificationsRes, error)
+	ReadNotification(context.Context, *ReadNotificationRequest) (*ReadNotificationResponse, error)
+	// Mixes
+	GetMixes(context.Context, *empty.Empty) (*GetMixesRes, error)
+	// Posts
+	CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error)
+	GetPost(context.Context, *GetPostRequest) (*GetPostResponse, error)
+	GetPosts(context.Context, *GetPostsRequest) (*GetPostsResponse, error)
+	GetPostCategories(context.Context, *empty.Empty) (*GetPostCategoriesResponse, error)
+	GetComments(context.Context, *GetCommentsRequest) (*GetCommentsResponse, error)
+	GetCommentTree(context.Context, *GetCommentTreeRequest) (*GetCommentTreeResponse, error)
+	GetThread(context.Context, *GetThreadRequest) (*GetThreadResponse, error)
+	SubscribeToThread(context.Context, *SubscribeToThreadRequest) (*SubscribeToThreadResponse, error)
+	UnsubscribeFromThread(context.Context, *UnsubscribeFromThreadRequest) (*UnsubscribeFromThreadResponse, error)
+	GetHomeFeed(context.Context, *GetHomeFeedRequest) (*GetHomeFeedResponse, error)
+	GetTrendingPosts(context.Context, *GetTrendingPostsRequest) (*GetTrendingPostsResponse, error)
+	UpdatePost(context.Context, *UpdatePostRequest) (*UpdatePostResponse, error)
+	DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error)
+	UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentResponse, error)
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	GetEditHistory(context.Context, *GetEditHistoryRequest) (*GetEditHistoryResponse, error)
+	CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error)
+	LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error)
+	LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentResponse, error)
+	GetPostLikers(context.Context, *GetPostLikersRequest) (*GetPostLikersResponse, error)
+	GetCommentLikers(context.Context, *GetCommentLikersRequest) (*GetCommentLikersResponse, error)
+	BookmarkPost(context.Context, *BookmarkPostRequest) (*BookmarkPostResponse, error)
+	UnbookmarkPost(context.Context, *UnbookmarkPostRequest) (*UnbookmarkPostResponse, error)
+	GetBookmarks(context.Context, *GetBookmarksRequest) (*GetBookmarksResponse, error)
+	GetBookmarkCollections(context.Context, *empty.Empty) (*GetBookmarkCollectionsResponse, error)
+	Repost(context.Context, *RepostRequest) (*RepostResponse, error)
+	UndoRepost(context.Context, *UndoRepostRequest) (*UndoRepostResponse, error)
+	GetDrafts(context.Context, *GetDraftsRequest) (*GetDraftsResponse, error)
+	GetScheduledPosts(context.Context, *GetScheduledPostsRequest) (*GetScheduledPostsResponse, error)
+	SchedulePost(context.Context, *SchedulePostRequest) (*SchedulePostResponse, error)
+	CancelScheduledPost(context.Context, *CancelScheduledPostRequest) (*CancelScheduledPostResponse, error)
+	PublishPost(context.Context, *PublishPostRequest) (*PublishPostResponse, error)
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	GetPostsByHashtag(context.Context, *GetPostsByHashtagRequest) (*GetPostsByHashtagResponse, error)
+	GetTrendingHashtags(context.Context, *GetTrendingHashtagsRequest) (*GetTrendingHashtagsResponse, error)
+	RecordPlayback(context.Context, *RecordPlaybackRequest) (*RecordPlaybackResponse, error)
+	GetPostAnalytics(context.Context, *GetPostAnalyticsRequest) (*GetPostAnalyticsResponse, error)
+	Report(context.Context, *ReportRequest) (*empty.Empty, error)
+	ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error)
+	ClaimReport(context.Context, *ClaimReportRequest) (*ClaimReportResponse, error)
+	ResolveReport(context.Context, *ResolveReportRequest) (*ResolveReportResponse, error)
+	GetModerationLog(context.Context, *GetModerationLogRequest) (*GetModerationLogResponse, error)
+	VotePoll(context.Context, *VotePollRequest) (*VotePollResponse, error)
+}
+
+// UnimplementedUnpaperServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedUnpaperServiceServer struct {
+}
+
+func (*UnimplementedUnpaperServiceServer) Ping(context.Context, *PingRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GoogleLogin(context.Context, *GoogleLoginRequest) (*GoogleLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoogleLogin not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GoogleCallback(context.Context, *GoogleCallbackRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoogleCallback not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GoogleOneTap(context.Context, *empty.Empty) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoogleOneTap not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) EmailSignup(context.Context, *EmailSignupRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmailSignup not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) EmailSignin(context.Context, *EmailSigninRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmailSignin not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) EmailVerify(context.Context, *EmailVerifyRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmailVerify not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) EmailCheck(context.Context, *EmailCheckRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmailCheck not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) SendResetLink(context.Context, *SendResetLinkRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendResetLink not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) ResetPassword(context.Context, *ResetPasswordRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) UpdateUsername(context.Context, *UpdateUsernameRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUsername not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) SetMessageRequestsPolicy(context.Context, *SetMessageRequestsPolicyRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMessageRequestsPolicy not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) SignOut(context.Context, *empty.Empty) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignOut not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) SetUserOnline(context.Context, *empty.Empty) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserOnline not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) SetUserOffline(context.Context, *empty.Empty) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserOffline not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) FollowUser(context.Context, *FollowUserRequest) (*ExtUserInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowUser not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetFollowers(context.Context, *GetFollowersRequest) (*GetFollowersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowers not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetFollowing(context.Context, *GetFollowingRequest) (*GetFollowingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowing not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetFollowingCount(context.Context, *GetFollowingCountRequest) (*GetFollowingCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowingCount not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetFollowersCount(context.Context, *GetFollowersCountRequest) (*GetFollowersCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowersCount not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) BlockUser(context.Context, *BlockUserRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) UnblockUser(context.Context, *UnblockUserRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) UserInfo(context.Context, *UserInfoRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserInfo not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) ExtUserInfo(context.Context, *ExtUserInfoRequest) (*ExtUserInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtUserInfo not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) CustomerInfo(context.Context, *CustomerInfoRequest) (*Customer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CustomerInfo not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) StripeWebhook(context.Context, *StripeWebhookRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StripeWebhook not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) StripeConnectWebhook(context.Context, *StripeWebhookRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StripeConnectWebhook not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) SubscribeToPlan(context.Context, *SubscribeToPlanRequest) (*Customer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeToPlan not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) RetryInvoice(context.Context, *RetryInvoiceRequest) (*Invoice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryInvoice not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetSubscriptionByID(context.Context, *GetSubscriptionByIDRequest) (*GetSubscriptionByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubscriptionByID not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) CreateSetupIntent(context.Context, *CreateSetupIntentRequest) (*CreateSetupIntentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSetupIntent not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) AttachPaymentMethod(context.Context, *AttachPaymentMethodRequest) (*PaymentMethod, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachPaymentMethod not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) UpdateSubscription(context.Context, *UpdateSubscriptionRequest) (*Customer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubscription not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) InvoicePreview(context.Context, *InvoicePreviewRequest) (*Invoice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvoicePreview not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) CouponCheck(context.Context, *CouponCheckRequest) (*CouponCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CouponCheck not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetConnectAccountLink(context.Context, *empty.Empty) (*GetConnectAccountLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConnectAccountLink not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) MakeDonation(context.Context, *MakeDonationRequest) (*ConnectedPaymentIntentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MakeDonation not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) PayRoomEntrance(context.Context, *PayRoomEntranceRequest) (*ConnectedPaymentIntentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayRoomEntrance not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) CreateStripeAccount(context.Context, *empty.Empty) (*Customer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStripeAccount not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetDashboardLink(context.Context, *empty.Empty) (*GetDashboardLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDashboardLink not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) CheckRoomEntrancePI(context.Context, *CheckRoomEntrancePIRequest) (*CheckRoomEntrancePIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckRoomEntrancePI not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) SubscribeToRoom(context.Context, *SubscribeToRoomRequest) (*SubscribeToRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeToRoom not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetRoomSubscriptions(context.Context, *empty.Empty) (*GetRoomSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoomSubscriptions not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) ConfirmRoomSubscription(context.Context, *ConfirmRoomSubscriptionRequest) (*ConfirmRoomSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmRoomSubscription not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) RetryRoomSubscription(context.Context, *RetryRoomSubscriptionRequest) (*ConnectedPaymentIntentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryRoomSubscription not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetRoomSubscriptionByRoomID(context.Context, *GetRoomSubscriptionByRoomIDRequest) (*GetRoomSubscriptionByRoomIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoomSubscriptionByRoomID not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetOwnConnectedAccount(context.Context, *empty.Empty) (*GetOwnConnectedAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOwnConnectedAccount not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetMessages(context.Context, *GetMessagesRequest) (*GetMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessages not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) ListenForMessages(*ListenForMessagesRequest, UnpaperService_ListenForMessagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListenForMessages not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) SendMessage(context.Context, *SendMessageRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) SendAward(context.Context, *SendAwardRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendAward not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) SendDonation(context.Context, *SendDonationRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendDonation not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) SendAudio(context.Context, *SendAudioRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendAudio not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) CreateList(context.Context, *CreateListRequest) (*List, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateList not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) UpdateList(context.Context, *UpdateListRequest) (*List, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateList not implemented")
 }
 func (*UnimplementedUnpaperServiceServer) GetUserSuggestions(context.Context, *GetUserSuggestionsRequest) (*GetUserSuggestionsResponse, error) {
@@ -1791,6 +2743,27 @@ func (*UnimplementedUnpaperServiceServer) GetConversations(context.Context, *Get
 func (*UnimplementedUnpaperServiceServer) GetConversationWithParticipants(context.Context, *GetConversationWithParticipantsRequest) (*GetConversationWithParticipantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConversationWithParticipants not implemented")
 }
+func (*UnimplementedUnpaperServiceServer) SetConversationRetention(context.Context, *SetConversationRetentionRequest) (*SetConversationRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConversationRetention not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) SetConversationMuted(context.Context, *SetConversationMutedRequest) (*SetConversationMutedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConversationMuted not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) ExportConversation(*ExportConversationRequest, UnpaperService_ExportConversationServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportConversation not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetMessageRequests(context.Context, *empty.Empty) (*GetMessageRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessageRequests not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) RespondToMessageRequest(context.Context, *RespondToMessageRequestRequest) (*RespondToMessageRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondToMessageRequest not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) ListScheduledMessages(context.Context, *ListScheduledMessagesRequest) (*ListScheduledMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledMessages not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) CancelScheduledMessage(context.Context, *CancelScheduledMessageRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMessage not implemented")
+}
 func (*UnimplementedUnpaperServiceServer) ListenForNotifications(*empty.Empty, UnpaperService_ListenForNotificationsServer) error {
 	return status.Errorf(codes.Unimplemented, "method ListenForNotifications not implemented")
 }
@@ -1812,1300 +2785,2260 @@ func (*UnimplementedUnpaperServiceServer) GetPost(context.Context, *GetPostReque
 func (*UnimplementedUnpaperServiceServer) GetPosts(context.Context, *GetPostsRequest) (*GetPostsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPosts not implemented")
 }
-func (*UnimplementedUnpaperServiceServer) CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error) {
+func (*UnimplementedUnpaperServiceServer) GetPostCategories(context.Context, *empty.Empty) (*GetPostCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostCategories not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetComments(context.Context, *GetCommentsRequest) (*GetCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComments not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetCommentTree(context.Context, *GetCommentTreeRequest) (*GetCommentTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentTree not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetThread(context.Context, *GetThreadRequest) (*GetThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThread not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) SubscribeToThread(context.Context, *SubscribeToThreadRequest) (*SubscribeToThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeToThread not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) UnsubscribeFromThread(context.Context, *UnsubscribeFromThreadRequest) (*UnsubscribeFromThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeFromThread not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetHomeFeed(context.Context, *GetHomeFeedRequest) (*GetHomeFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHomeFeed not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetTrendingPosts(context.Context, *GetTrendingPostsRequest) (*GetTrendingPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrendingPosts not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) UpdatePost(context.Context, *UpdatePostRequest) (*UpdatePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePost not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetEditHistory(context.Context, *GetEditHistoryRequest) (*GetEditHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEditHistory not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
 }
-func (*UnimplementedUnpaperServiceServer) LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LikePost not implemented")
+func (*UnimplementedUnpaperServiceServer) LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikePost not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikeComment not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetPostLikers(context.Context, *GetPostLikersRequest) (*GetPostLikersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostLikers not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetCommentLikers(context.Context, *GetCommentLikersRequest) (*GetCommentLikersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentLikers not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) BookmarkPost(context.Context, *BookmarkPostRequest) (*BookmarkPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookmarkPost not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) UnbookmarkPost(context.Context, *UnbookmarkPostRequest) (*UnbookmarkPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbookmarkPost not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetBookmarks(context.Context, *GetBookmarksRequest) (*GetBookmarksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookmarks not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetBookmarkCollections(context.Context, *empty.Empty) (*GetBookmarkCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookmarkCollections not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) Repost(context.Context, *RepostRequest) (*RepostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Repost not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) UndoRepost(context.Context, *UndoRepostRequest) (*UndoRepostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoRepost not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetDrafts(context.Context, *GetDraftsRequest) (*GetDraftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDrafts not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetScheduledPosts(context.Context, *GetScheduledPostsRequest) (*GetScheduledPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledPosts not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) SchedulePost(context.Context, *SchedulePostRequest) (*SchedulePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePost not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) CancelScheduledPost(context.Context, *CancelScheduledPostRequest) (*CancelScheduledPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledPost not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) PublishPost(context.Context, *PublishPostRequest) (*PublishPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishPost not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetPostsByHashtag(context.Context, *GetPostsByHashtagRequest) (*GetPostsByHashtagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostsByHashtag not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetTrendingHashtags(context.Context, *GetTrendingHashtagsRequest) (*GetTrendingHashtagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrendingHashtags not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) RecordPlayback(context.Context, *RecordPlaybackRequest) (*RecordPlaybackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordPlayback not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetPostAnalytics(context.Context, *GetPostAnalyticsRequest) (*GetPostAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostAnalytics not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) Report(context.Context, *ReportRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Report not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReports not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) ClaimReport(context.Context, *ClaimReportRequest) (*ClaimReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimReport not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) ResolveReport(context.Context, *ResolveReportRequest) (*ResolveReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReport not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) GetModerationLog(context.Context, *GetModerationLogRequest) (*GetModerationLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModerationLog not implemented")
+}
+func (*UnimplementedUnpaperServiceServer) VotePoll(context.Context, *VotePollRequest) (*VotePollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotePoll not implemented")
+}
+
+func RegisterUnpaperServiceServer(s *grpc.Server, srv UnpaperServiceServer) {
+	s.RegisterService(&_UnpaperService_serviceDesc, srv)
+}
+
+func _UnpaperService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_GoogleLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoogleLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).GoogleLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/GoogleLogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).GoogleLogin(ctx, req.(*GoogleLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_GoogleCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoogleCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).GoogleCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/GoogleCallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).GoogleCallback(ctx, req.(*GoogleCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_GoogleOneTap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).GoogleOneTap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/GoogleOneTap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).GoogleOneTap(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_EmailSignup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmailSignupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).EmailSignup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/EmailSignup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).EmailSignup(ctx, req.(*EmailSignupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_EmailSignin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmailSigninRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).EmailSignin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/EmailSignin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).EmailSignin(ctx, req.(*EmailSigninRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_EmailVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmailVerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).EmailVerify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/EmailVerify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).EmailVerify(ctx, req.(*EmailVerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_EmailCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmailCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).EmailCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/EmailCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).EmailCheck(ctx, req.(*EmailCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_SendResetLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendResetLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).SendResetLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/SendResetLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).SendResetLink(ctx, req.(*SendResetLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/ResetPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_UpdateUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUsernameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).UpdateUsername(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/UpdateUsername",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).UpdateUsername(ctx, req.(*UpdateUsernameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_SetMessageRequestsPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMessageRequestsPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).SetMessageRequestsPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/SetMessageRequestsPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).SetMessageRequestsPolicy(ctx, req.(*SetMessageRequestsPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_SignOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).SignOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/SignOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).SignOut(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_SetUserOnline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).SetUserOnline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/SetUserOnline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).SetUserOnline(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_SetUserOffline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).SetUserOffline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/SetUserOffline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).SetUserOffline(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).FollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/FollowUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).FollowUser(ctx, req.(*FollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_GetFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).GetFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/GetFollowers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).GetFollowers(ctx, req.(*GetFollowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_GetFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).GetFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/GetFollowing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).GetFollowing(ctx, req.(*GetFollowingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_GetFollowingCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowingCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).GetFollowingCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/GetFollowingCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).GetFollowingCount(ctx, req.(*GetFollowingCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_GetFollowersCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowersCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).GetFollowersCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/GetFollowersCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).GetFollowersCount(ctx, req.(*GetFollowersCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/BlockUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).BlockUser(ctx, req.(*BlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/UnblockUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).UnblockUser(ctx, req.(*UnblockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_UserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).UserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/UserInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).UserInfo(ctx, req.(*UserInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_ExtUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtUserInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).ExtUserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/ExtUserInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).ExtUserInfo(ctx, req.(*ExtUserInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_CustomerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CustomerInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).CustomerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/CustomerInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).CustomerInfo(ctx, req.(*CustomerInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_StripeWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StripeWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).StripeWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/StripeWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).StripeWebhook(ctx, req.(*StripeWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_StripeConnectWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StripeWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).StripeConnectWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/StripeConnectWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).StripeConnectWebhook(ctx, req.(*StripeWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_SubscribeToPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeToPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).SubscribeToPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/SubscribeToPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).SubscribeToPlan(ctx, req.(*SubscribeToPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_RetryInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).RetryInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/RetryInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).RetryInvoice(ctx, req.(*RetryInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_GetSubscriptionByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscriptionByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).GetSubscriptionByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/GetSubscriptionByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).GetSubscriptionByID(ctx, req.(*GetSubscriptionByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_CreateSetupIntent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSetupIntentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).CreateSetupIntent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/CreateSetupIntent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).CreateSetupIntent(ctx, req.(*CreateSetupIntentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_AttachPaymentMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachPaymentMethodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).AttachPaymentMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/AttachPaymentMethod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).AttachPaymentMethod(ctx, req.(*AttachPaymentMethodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_UpdateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).UpdateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/UpdateSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).UpdateSubscription(ctx, req.(*UpdateSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_InvoicePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvoicePreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).InvoicePreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/InvoicePreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).InvoicePreview(ctx, req.(*InvoicePreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_CouponCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CouponCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).CouponCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/CouponCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).CouponCheck(ctx, req.(*CouponCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_GetConnectAccountLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).GetConnectAccountLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/GetConnectAccountLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).GetConnectAccountLink(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_MakeDonation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MakeDonationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).MakeDonation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/MakeDonation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).MakeDonation(ctx, req.(*MakeDonationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_PayRoomEntrance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayRoomEntranceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).PayRoomEntrance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/PayRoomEntrance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).PayRoomEntrance(ctx, req.(*PayRoomEntranceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_CreateStripeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).CreateStripeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/CreateStripeAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).CreateStripeAccount(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_GetDashboardLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).GetDashboardLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/GetDashboardLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).GetDashboardLink(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_CheckRoomEntrancePI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRoomEntrancePIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).CheckRoomEntrancePI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/CheckRoomEntrancePI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).CheckRoomEntrancePI(ctx, req.(*CheckRoomEntrancePIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedUnpaperServiceServer) LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LikeComment not implemented")
+
+func _UnpaperService_SubscribeToRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeToRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).SubscribeToRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/SubscribeToRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).SubscribeToRoom(ctx, req.(*SubscribeToRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterUnpaperServiceServer(s *grpc.Server, srv UnpaperServiceServer) {
-	s.RegisterService(&_UnpaperService_serviceDesc, srv)
+func _UnpaperService_GetRoomSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).GetRoomSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/GetRoomSubscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).GetRoomSubscriptions(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PingRequest)
+func _UnpaperService_ConfirmRoomSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmRoomSubscriptionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).Ping(ctx, in)
+		return srv.(UnpaperServiceServer).ConfirmRoomSubscription(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/Ping",
+		FullMethod: "/v1.UnpaperService/ConfirmRoomSubscription",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).Ping(ctx, req.(*PingRequest))
+		return srv.(UnpaperServiceServer).ConfirmRoomSubscription(ctx, req.(*ConfirmRoomSubscriptionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_GoogleLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GoogleLoginRequest)
+func _UnpaperService_RetryRoomSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryRoomSubscriptionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).GoogleLogin(ctx, in)
+		return srv.(UnpaperServiceServer).RetryRoomSubscription(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/GoogleLogin",
+		FullMethod: "/v1.UnpaperService/RetryRoomSubscription",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).GoogleLogin(ctx, req.(*GoogleLoginRequest))
+		return srv.(UnpaperServiceServer).RetryRoomSubscription(ctx, req.(*RetryRoomSubscriptionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_GoogleCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GoogleCallbackRequest)
+func _UnpaperService_GetRoomSubscriptionByRoomID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoomSubscriptionByRoomIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).GoogleCallback(ctx, in)
+		return srv.(UnpaperServiceServer).GetRoomSubscriptionByRoomID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/GoogleCallback",
+		FullMethod: "/v1.UnpaperService/GetRoomSubscriptionByRoomID",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).GoogleCallback(ctx, req.(*GoogleCallbackRequest))
+		return srv.(UnpaperServiceServer).GetRoomSubscriptionByRoomID(ctx, req.(*GetRoomSubscriptionByRoomIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_GoogleOneTap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _UnpaperService_GetOwnConnectedAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).GoogleOneTap(ctx, in)
+		return srv.(UnpaperServiceServer).GetOwnConnectedAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/GoogleOneTap",
+		FullMethod: "/v1.UnpaperService/GetOwnConnectedAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).GoogleOneTap(ctx, req.(*empty.Empty))
+		return srv.(UnpaperServiceServer).GetOwnConnectedAccount(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_EmailSignup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmailSignupRequest)
+func _UnpaperService_GetMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessagesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).EmailSignup(ctx, in)
+		return srv.(UnpaperServiceServer).GetMessages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/EmailSignup",
+		FullMethod: "/v1.UnpaperService/GetMessages",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).EmailSignup(ctx, req.(*EmailSignupRequest))
+		return srv.(UnpaperServiceServer).GetMessages(ctx, req.(*GetMessagesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_EmailSignin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmailSigninRequest)
+func _UnpaperService_ListenForMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListenForMessagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UnpaperServiceServer).ListenForMessages(m, &unpaperServiceListenForMessagesServer{stream})
+}
+
+type UnpaperService_ListenForMessagesServer interface {
+	Send(*ChatMessage) error
+	grpc.ServerStream
+}
+
+type unpaperServiceListenForMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *unpaperServiceListenForMessagesServer) Send(m *ChatMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _UnpaperService_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).EmailSignin(ctx, in)
+		return srv.(UnpaperServiceServer).SendMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/EmailSignin",
+		FullMethod: "/v1.UnpaperService/SendMessage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).EmailSignin(ctx, req.(*EmailSigninRequest))
+		return srv.(UnpaperServiceServer).SendMessage(ctx, req.(*SendMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_EmailVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmailVerifyRequest)
+func _UnpaperService_SendAward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendAwardRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).EmailVerify(ctx, in)
+		return srv.(UnpaperServiceServer).SendAward(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/EmailVerify",
+		FullMethod: "/v1.UnpaperService/SendAward",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).EmailVerify(ctx, req.(*EmailVerifyRequest))
+		return srv.(UnpaperServiceServer).SendAward(ctx, req.(*SendAwardRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_EmailCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmailCheckRequest)
+func _UnpaperService_SendDonation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendDonationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).EmailCheck(ctx, in)
+		return srv.(UnpaperServiceServer).SendDonation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/EmailCheck",
+		FullMethod: "/v1.UnpaperService/SendDonation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).EmailCheck(ctx, req.(*EmailCheckRequest))
+		return srv.(UnpaperServiceServer).SendDonation(ctx, req.(*SendDonationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangePasswordRequest)
+func _UnpaperService_SendAudio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendAudioRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).ChangePassword(ctx, in)
+		return srv.(UnpaperServiceServer).SendAudio(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/ChangePassword",
+		FullMethod: "/v1.UnpaperService/SendAudio",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+		return srv.(UnpaperServiceServer).SendAudio(ctx, req.(*SendAudioRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_SendResetLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendResetLinkRequest)
+func _UnpaperService_CreateList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).SendResetLink(ctx, in)
+		return srv.(UnpaperServiceServer).CreateList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/SendResetLink",
+		FullMethod: "/v1.UnpaperService/CreateList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).SendResetLink(ctx, req.(*SendResetLinkRequest))
+		return srv.(UnpaperServiceServer).CreateList(ctx, req.(*CreateListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResetPasswordRequest)
+func _UnpaperService_UpdateList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).ResetPassword(ctx, in)
+		return srv.(UnpaperServiceServer).UpdateList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/ResetPassword",
+		FullMethod: "/v1.UnpaperService/UpdateList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+		return srv.(UnpaperServiceServer).UpdateList(ctx, req.(*UpdateListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_UpdateUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUsernameRequest)
+func _UnpaperService_GetUserSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserSuggestionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).UpdateUsername(ctx, in)
+		return srv.(UnpaperServiceServer).GetUserSuggestions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/UpdateUsername",
+		FullMethod: "/v1.UnpaperService/GetUserSuggestions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).UpdateUsername(ctx, req.(*UpdateUsernameRequest))
+		return srv.(UnpaperServiceServer).GetUserSuggestions(ctx, req.(*GetUserSuggestionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_SignOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _UnpaperService_GetAllLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).SignOut(ctx, in)
+		return srv.(UnpaperServiceServer).GetAllLists(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/SignOut",
+		FullMethod: "/v1.UnpaperService/GetAllLists",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).SignOut(ctx, req.(*empty.Empty))
+		return srv.(UnpaperServiceServer).GetAllLists(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_SetUserOnline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _UnpaperService_GetListByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListByIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).SetUserOnline(ctx, in)
+		return srv.(UnpaperServiceServer).GetListByID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/SetUserOnline",
+		FullMethod: "/v1.UnpaperService/GetListByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).GetListByID(ctx, req.(*GetListByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_RoomAccessCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoomAccessCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).RoomAccessCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/RoomAccessCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnpaperServiceServer).RoomAccessCheck(ctx, req.(*RoomAccessCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnpaperService_CreateConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnpaperServiceServer).CreateConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.UnpaperService/CreateConversation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).SetUserOnline(ctx, req.(*empty.Empty))
+		return srv.(UnpaperServiceServer).CreateConversation(ctx, req.(*CreateConversationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_SetUserOffline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _UnpaperService_GetConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).SetUserOffline(ctx, in)
+		return srv.(UnpaperServiceServer).GetConversation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/SetUserOffline",
+		FullMethod: "/v1.UnpaperService/GetConversation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).SetUserOffline(ctx, req.(*empty.Empty))
+		return srv.(UnpaperServiceServer).GetConversation(ctx, req.(*GetConversationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FollowUserRequest)
+func _UnpaperService_GetConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).FollowUser(ctx, in)
+		return srv.(UnpaperServiceServer).GetConversations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/FollowUser",
+		FullMethod: "/v1.UnpaperService/GetConversations",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).FollowUser(ctx, req.(*FollowUserRequest))
+		return srv.(UnpaperServiceServer).GetConversations(ctx, req.(*GetConversationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_GetFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFollowersRequest)
+func _UnpaperService_GetConversationWithParticipants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationWithParticipantsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).GetFollowers(ctx, in)
+		return srv.(UnpaperServiceServer).GetConversationWithParticipants(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/GetFollowers",
+		FullMethod: "/v1.UnpaperService/GetConversationWithParticipants",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).GetFollowers(ctx, req.(*GetFollowersRequest))
+		return srv.(UnpaperServiceServer).GetConversationWithParticipants(ctx, req.(*GetConversationWithParticipantsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_GetFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFollowingRequest)
+func _UnpaperService_SetConversationRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConversationRetentionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).GetFollowing(ctx, in)
+		return srv.(UnpaperServiceServer).SetConversationRetention(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/GetFollowing",
+		FullMethod: "/v1.UnpaperService/SetConversationRetention",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).GetFollowing(ctx, req.(*GetFollowingRequest))
+		return srv.(UnpaperServiceServer).SetConversationRetention(ctx, req.(*SetConversationRetentionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_GetFollowingCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFollowingCountRequest)
+func _UnpaperService_SetConversationMuted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConversationMutedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).GetFollowingCount(ctx, in)
+		return srv.(UnpaperServiceServer).SetConversationMuted(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/GetFollowingCount",
+		FullMethod: "/v1.UnpaperService/SetConversationMuted",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).GetFollowingCount(ctx, req.(*GetFollowingCountRequest))
+		return srv.(UnpaperServiceServer).SetConversationMuted(ctx, req.(*SetConversationMutedRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_GetFollowersCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFollowersCountRequest)
+func _UnpaperService_ExportConversation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportConversationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UnpaperServiceServer).ExportConversation(m, &unpaperServiceExportConversationServer{stream})
+}
+
+type UnpaperService_ExportConversationServer interface {
+	Send(*ExportConversationChunk) error
+	grpc.ServerStream
+}
+
+type unpaperServiceExportConversationServer struct {
+	grpc.ServerStream
+}
+
+func (x *unpaperServiceExportConversationServer) Send(m *ExportConversationChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _UnpaperService_GetMessageRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).GetFollowersCount(ctx, in)
+		return srv.(UnpaperServiceServer).GetMessageRequests(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/GetFollowersCount",
+		FullMethod: "/v1.UnpaperService/GetMessageRequests",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).GetFollowersCount(ctx, req.(*GetFollowersCountRequest))
+		return srv.(UnpaperServiceServer).GetMessageRequests(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_UserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserInfoRequest)
+func _UnpaperService_RespondToMessageRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondToMessageRequestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).UserInfo(ctx, in)
+		return srv.(UnpaperServiceServer).RespondToMessageRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/UserInfo",
+		FullMethod: "/v1.UnpaperService/RespondToMessageRequest",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).UserInfo(ctx, req.(*UserInfoRequest))
+		return srv.(UnpaperServiceServer).RespondToMessageRequest(ctx, req.(*RespondToMessageRequestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_ExtUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExtUserInfoRequest)
+func _UnpaperService_ListScheduledMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledMessagesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).ExtUserInfo(ctx, in)
+		return srv.(UnpaperServiceServer).ListScheduledMessages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/ExtUserInfo",
+		FullMethod: "/v1.UnpaperService/ListScheduledMessages",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).ExtUserInfo(ctx, req.(*ExtUserInfoRequest))
+		return srv.(UnpaperServiceServer).ListScheduledMessages(ctx, req.(*ListScheduledMessagesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_CustomerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CustomerInfoRequest)
+func _UnpaperService_CancelScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).CustomerInfo(ctx, in)
+		return srv.(UnpaperServiceServer).CancelScheduledMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/CustomerInfo",
+		FullMethod: "/v1.UnpaperService/CancelScheduledMessage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).CustomerInfo(ctx, req.(*CustomerInfoRequest))
+		return srv.(UnpaperServiceServer).CancelScheduledMessage(ctx, req.(*CancelScheduledMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_StripeWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StripeWebhookRequest)
+func _UnpaperService_ListenForNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UnpaperServiceServer).ListenForNotifications(m, &unpaperServiceListenForNotificationsServer{stream})
+}
+
+type UnpaperService_ListenForNotificationsServer interface {
+	Send(*Notification) error
+	grpc.ServerStream
+}
+
+type unpaperServiceListenForNotificationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *unpaperServiceListenForNotificationsServer) Send(m *Notification) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _UnpaperService_GetAllNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).StripeWebhook(ctx, in)
+		return srv.(UnpaperServiceServer).GetAllNotifications(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/StripeWebhook",
+		FullMethod: "/v1.UnpaperService/GetAllNotifications",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).StripeWebhook(ctx, req.(*StripeWebhookRequest))
+		return srv.(UnpaperServiceServer).GetAllNotifications(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_StripeConnectWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StripeWebhookRequest)
+func _UnpaperService_ReadNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadNotificationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).StripeConnectWebhook(ctx, in)
+		return srv.(UnpaperServiceServer).ReadNotification(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/StripeConnectWebhook",
+		FullMethod: "/v1.UnpaperService/ReadNotification",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).StripeConnectWebhook(ctx, req.(*StripeWebhookRequest))
+		return srv.(UnpaperServiceServer).ReadNotification(ctx, req.(*ReadNotificationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_SubscribeToPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubscribeToPlanRequest)
+func _UnpaperService_GetMixes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).SubscribeToPlan(ctx, in)
+		return srv.(UnpaperServiceServer).GetMixes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/SubscribeToPlan",
+		FullMethod: "/v1.UnpaperService/GetMixes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).SubscribeToPlan(ctx, req.(*SubscribeToPlanRequest))
+		return srv.(UnpaperServiceServer).GetMixes(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_RetryInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RetryInvoiceRequest)
+func _UnpaperService_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).RetryInvoice(ctx, in)
+		return srv.(UnpaperServiceServer).CreatePost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/RetryInvoice",
+		FullMethod: "/v1.UnpaperService/CreatePost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).RetryInvoice(ctx, req.(*RetryInvoiceRequest))
+		return srv.(UnpaperServiceServer).CreatePost(ctx, req.(*CreatePostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_GetSubscriptionByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSubscriptionByIDRequest)
+func _UnpaperService_GetPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).GetSubscriptionByID(ctx, in)
+		return srv.(UnpaperServiceServer).GetPost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/GetSubscriptionByID",
+		FullMethod: "/v1.UnpaperService/GetPost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).GetSubscriptionByID(ctx, req.(*GetSubscriptionByIDRequest))
+		return srv.(UnpaperServiceServer).GetPost(ctx, req.(*GetPostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_CreateSetupIntent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSetupIntentRequest)
+func _UnpaperService_GetPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).CreateSetupIntent(ctx, in)
+		return srv.(UnpaperServiceServer).GetPosts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/CreateSetupIntent",
+		FullMethod: "/v1.UnpaperService/GetPosts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).CreateSetupIntent(ctx, req.(*CreateSetupIntentRequest))
+		return srv.(UnpaperServiceServer).GetPosts(ctx, req.(*GetPostsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_AttachPaymentMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AttachPaymentMethodRequest)
+func _UnpaperService_GetPostCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).AttachPaymentMethod(ctx, in)
+		return srv.(UnpaperServiceServer).GetPostCategories(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/AttachPaymentMethod",
+		FullMethod: "/v1.UnpaperService/GetPostCategories",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).AttachPaymentMethod(ctx, req.(*AttachPaymentMethodRequest))
+		return srv.(UnpaperServiceServer).GetPostCategories(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_UpdateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateSubscriptionRequest)
+func _UnpaperService_GetComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).UpdateSubscription(ctx, in)
+		return srv.(UnpaperServiceServer).GetComments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/UpdateSubscription",
+		FullMethod: "/v1.UnpaperService/GetComments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).UpdateSubscription(ctx, req.(*UpdateSubscriptionRequest))
+		return srv.(UnpaperServiceServer).GetComments(ctx, req.(*GetCommentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_InvoicePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InvoicePreviewRequest)
+func _UnpaperService_GetCommentTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentTreeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).InvoicePreview(ctx, in)
+		return srv.(UnpaperServiceServer).GetCommentTree(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/InvoicePreview",
+		FullMethod: "/v1.UnpaperService/GetCommentTree",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).InvoicePreview(ctx, req.(*InvoicePreviewRequest))
+		return srv.(UnpaperServiceServer).GetCommentTree(ctx, req.(*GetCommentTreeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_CouponCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CouponCheckRequest)
+func _UnpaperService_GetThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThreadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).CouponCheck(ctx, in)
+		return srv.(UnpaperServiceServer).GetThread(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/CouponCheck",
+		FullMethod: "/v1.UnpaperService/GetThread",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).CouponCheck(ctx, req.(*CouponCheckRequest))
+		return srv.(UnpaperServiceServer).GetThread(ctx, req.(*GetThreadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_GetConnectAccountLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _UnpaperService_SubscribeToThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeToThreadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).GetConnectAccountLink(ctx, in)
+		return srv.(UnpaperServiceServer).SubscribeToThread(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/GetConnectAccountLink",
+		FullMethod: "/v1.UnpaperService/SubscribeToThread",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).GetConnectAccountLink(ctx, req.(*empty.Empty))
+		return srv.(UnpaperServiceServer).SubscribeToThread(ctx, req.(*SubscribeToThreadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_MakeDonation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MakeDonationRequest)
+func _UnpaperService_UnsubscribeFromThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeFromThreadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).MakeDonation(ctx, in)
+		return srv.(UnpaperServiceServer).UnsubscribeFromThread(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/MakeDonation",
+		FullMethod: "/v1.UnpaperService/UnsubscribeFromThread",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).MakeDonation(ctx, req.(*MakeDonationRequest))
+		return srv.(UnpaperServiceServer).UnsubscribeFromThread(ctx, req.(*UnsubscribeFromThreadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_PayRoomEntrance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PayRoomEntranceRequest)
+func _UnpaperService_GetHomeFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHomeFeedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).PayRoomEntrance(ctx, in)
+		return srv.(UnpaperServiceServer).GetHomeFeed(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/PayRoomEntrance",
+		FullMethod: "/v1.UnpaperService/GetHomeFeed",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).PayRoomEntrance(ctx, req.(*PayRoomEntranceRequest))
+		return srv.(UnpaperServiceServer).GetHomeFeed(ctx, req.(*GetHomeFeedRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_CreateStripeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _UnpaperService_GetTrendingPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendingPostsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).CreateStripeAccount(ctx, in)
+		return srv.(UnpaperServiceServer).GetTrendingPosts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/CreateStripeAccount",
+		FullMethod: "/v1.UnpaperService/GetTrendingPosts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).CreateStripeAccount(ctx, req.(*empty.Empty))
+		return srv.(UnpaperServiceServer).GetTrendingPosts(ctx, req.(*GetTrendingPostsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_GetDashboardLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _UnpaperService_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).GetDashboardLink(ctx, in)
+		return srv.(UnpaperServiceServer).UpdatePost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/GetDashboardLink",
+		FullMethod: "/v1.UnpaperService/UpdatePost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).GetDashboardLink(ctx, req.(*empty.Empty))
+		return srv.(UnpaperServiceServer).UpdatePost(ctx, req.(*UpdatePostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_CheckRoomEntrancePI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckRoomEntrancePIRequest)
+func _UnpaperService_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).CheckRoomEntrancePI(ctx, in)
+		return srv.(UnpaperServiceServer).DeletePost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/CheckRoomEntrancePI",
+		FullMethod: "/v1.UnpaperService/DeletePost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).CheckRoomEntrancePI(ctx, req.(*CheckRoomEntrancePIRequest))
+		return srv.(UnpaperServiceServer).DeletePost(ctx, req.(*DeletePostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_SubscribeToRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubscribeToRoomRequest)
+func _UnpaperService_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).SubscribeToRoom(ctx, in)
+		return srv.(UnpaperServiceServer).UpdateComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/SubscribeToRoom",
+		FullMethod: "/v1.UnpaperService/UpdateComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).SubscribeToRoom(ctx, req.(*SubscribeToRoomRequest))
+		return srv.(UnpaperServiceServer).UpdateComment(ctx, req.(*UpdateCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_GetRoomSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _UnpaperService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).GetRoomSubscriptions(ctx, in)
+		return srv.(UnpaperServiceServer).DeleteComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/GetRoomSubscriptions",
+		FullMethod: "/v1.UnpaperService/DeleteComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).GetRoomSubscriptions(ctx, req.(*empty.Empty))
+		return srv.(UnpaperServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_ConfirmRoomSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConfirmRoomSubscriptionRequest)
+func _UnpaperService_GetEditHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEditHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).ConfirmRoomSubscription(ctx, in)
+		return srv.(UnpaperServiceServer).GetEditHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/ConfirmRoomSubscription",
+		FullMethod: "/v1.UnpaperService/GetEditHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).ConfirmRoomSubscription(ctx, req.(*ConfirmRoomSubscriptionRequest))
+		return srv.(UnpaperServiceServer).GetEditHistory(ctx, req.(*GetEditHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_RetryRoomSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RetryRoomSubscriptionRequest)
+func _UnpaperService_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).RetryRoomSubscription(ctx, in)
+		return srv.(UnpaperServiceServer).CreateComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/RetryRoomSubscription",
+		FullMethod: "/v1.UnpaperService/CreateComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).RetryRoomSubscription(ctx, req.(*RetryRoomSubscriptionRequest))
+		return srv.(UnpaperServiceServer).CreateComment(ctx, req.(*CreateCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_GetRoomSubscriptionByRoomID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRoomSubscriptionByRoomIDRequest)
+func _UnpaperService_LikePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikePostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).GetRoomSubscriptionByRoomID(ctx, in)
+		return srv.(UnpaperServiceServer).LikePost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/GetRoomSubscriptionByRoomID",
+		FullMethod: "/v1.UnpaperService/LikePost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).GetRoomSubscriptionByRoomID(ctx, req.(*GetRoomSubscriptionByRoomIDRequest))
+		return srv.(UnpaperServiceServer).LikePost(ctx, req.(*LikePostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_GetOwnConnectedAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _UnpaperService_LikeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).GetOwnConnectedAccount(ctx, in)
+		return srv.(UnpaperServiceServer).LikeComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/GetOwnConnectedAccount",
+		FullMethod: "/v1.UnpaperService/LikeComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).GetOwnConnectedAccount(ctx, req.(*empty.Empty))
+		return srv.(UnpaperServiceServer).LikeComment(ctx, req.(*LikeCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_GetMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMessagesRequest)
+func _UnpaperService_GetPostLikers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostLikersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).GetMessages(ctx, in)
+		return srv.(UnpaperServiceServer).GetPostLikers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/GetMessages",
+		FullMethod: "/v1.UnpaperService/GetPostLikers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).GetMessages(ctx, req.(*GetMessagesRequest))
+		return srv.(UnpaperServiceServer).GetPostLikers(ctx, req.(*GetPostLikersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_ListenForMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListenForMessagesRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(UnpaperServiceServer).ListenForMessages(m, &unpaperServiceListenForMessagesServer{stream})
-}
-
-type UnpaperService_ListenForMessagesServer interface {
-	Send(*ChatMessage) error
-	grpc.ServerStream
-}
-
-type unpaperServiceListenForMessagesServer struct {
-	grpc.ServerStream
-}
-
-func (x *unpaperServiceListenForMessagesServer) Send(m *ChatMessage) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _UnpaperService_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendMessageRequest)
+func _UnpaperService_GetCommentLikers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentLikersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).SendMessage(ctx, in)
+		return srv.(UnpaperServiceServer).GetCommentLikers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/SendMessage",
+		FullMethod: "/v1.UnpaperService/GetCommentLikers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).SendMessage(ctx, req.(*SendMessageRequest))
+		return srv.(UnpaperServiceServer).GetCommentLikers(ctx, req.(*GetCommentLikersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_SendAward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendAwardRequest)
+func _UnpaperService_BookmarkPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookmarkPostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).SendAward(ctx, in)
+		return srv.(UnpaperServiceServer).BookmarkPost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/SendAward",
+		FullMethod: "/v1.UnpaperService/BookmarkPost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).SendAward(ctx, req.(*SendAwardRequest))
+		return srv.(UnpaperServiceServer).BookmarkPost(ctx, req.(*BookmarkPostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_SendDonation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendDonationRequest)
+func _UnpaperService_UnbookmarkPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbookmarkPostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).SendDonation(ctx, in)
+		return srv.(UnpaperServiceServer).UnbookmarkPost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/SendDonation",
+		FullMethod: "/v1.UnpaperService/UnbookmarkPost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).SendDonation(ctx, req.(*SendDonationRequest))
+		return srv.(UnpaperServiceServer).UnbookmarkPost(ctx, req.(*UnbookmarkPostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_SendAudio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendAudioRequest)
+func _UnpaperService_GetBookmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookmarksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).SendAudio(ctx, in)
+		return srv.(UnpaperServiceServer).GetBookmarks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/SendAudio",
+		FullMethod: "/v1.UnpaperService/GetBookmarks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).SendAudio(ctx, req.(*SendAudioRequest))
+		return srv.(UnpaperServiceServer).GetBookmarks(ctx, req.(*GetBookmarksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_CreateList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateListRequest)
+func _UnpaperService_GetBookmarkCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).CreateList(ctx, in)
+		return srv.(UnpaperServiceServer).GetBookmarkCollections(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/CreateList",
+		FullMethod: "/v1.UnpaperService/GetBookmarkCollections",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).CreateList(ctx, req.(*CreateListRequest))
+		return srv.(UnpaperServiceServer).GetBookmarkCollections(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_UpdateList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateListRequest)
+func _UnpaperService_Repost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).UpdateList(ctx, in)
+		return srv.(UnpaperServiceServer).Repost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/UpdateList",
+		FullMethod: "/v1.UnpaperService/Repost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).UpdateList(ctx, req.(*UpdateListRequest))
+		return srv.(UnpaperServiceServer).Repost(ctx, req.(*RepostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_GetUserSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserSuggestionsRequest)
+func _UnpaperService_UndoRepost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoRepostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).GetUserSuggestions(ctx, in)
+		return srv.(UnpaperServiceServer).UndoRepost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/GetUserSuggestions",
+		FullMethod: "/v1.UnpaperService/UndoRepost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).GetUserSuggestions(ctx, req.(*GetUserSuggestionsRequest))
+		return srv.(UnpaperServiceServer).UndoRepost(ctx, req.(*UndoRepostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_GetAllLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _UnpaperService_GetDrafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDraftsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).GetAllLists(ctx, in)
+		return srv.(UnpaperServiceServer).GetDrafts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/GetAllLists",
+		FullMethod: "/v1.UnpaperService/GetDrafts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).GetAllLists(ctx, req.(*empty.Empty))
+		return srv.(UnpaperServiceServer).GetDrafts(ctx, req.(*GetDraftsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_GetListByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetListByIDRequest)
+func _UnpaperService_GetScheduledPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledPostsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).GetListByID(ctx, in)
+		return srv.(UnpaperServiceServer).GetScheduledPosts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/GetListByID",
+		FullMethod: "/v1.UnpaperService/GetScheduledPosts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).GetListByID(ctx, req.(*GetListByIDRequest))
+		return srv.(UnpaperServiceServer).GetScheduledPosts(ctx, req.(*GetScheduledPostsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_RoomAccessCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RoomAccessCheckRequest)
+func _UnpaperService_SchedulePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).RoomAccessCheck(ctx, in)
+		return srv.(UnpaperServiceServer).SchedulePost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/RoomAccessCheck",
+		FullMethod: "/v1.UnpaperService/SchedulePost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).RoomAccessCheck(ctx, req.(*RoomAccessCheckRequest))
+		return srv.(UnpaperServiceServer).SchedulePost(ctx, req.(*SchedulePostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_CreateConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateConversationRequest)
+func _UnpaperService_CancelScheduledPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledPostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).CreateConversation(ctx, in)
+		return srv.(UnpaperServiceServer).CancelScheduledPost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/CreateConversation",
+		FullMethod: "/v1.UnpaperService/CancelScheduledPost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).CreateConversation(ctx, req.(*CreateConversationRequest))
+		return srv.(UnpaperServiceServer).CancelScheduledPost(ctx, req.(*CancelScheduledPostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_GetConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetConversationRequest)
+func _UnpaperService_PublishPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishPostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).GetConversation(ctx, in)
+		return srv.(UnpaperServiceServer).PublishPost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/GetConversation",
+		FullMethod: "/v1.UnpaperService/PublishPost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).GetConversation(ctx, req.(*GetConversationRequest))
+		return srv.(UnpaperServiceServer).PublishPost(ctx, req.(*PublishPostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_GetConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetConversationsRequest)
+func _UnpaperService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).GetConversations(ctx, in)
+		return srv.(UnpaperServiceServer).Search(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/GetConversations",
+		FullMethod: "/v1.UnpaperService/Search",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).GetConversations(ctx, req.(*GetConversationsRequest))
+		return srv.(UnpaperServiceServer).Search(ctx, req.(*SearchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_GetConversationWithParticipants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetConversationWithParticipantsRequest)
+func _UnpaperService_GetPostsByHashtag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostsByHashtagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).GetConversationWithParticipants(ctx, in)
+		return srv.(UnpaperServiceServer).GetPostsByHashtag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/GetConversationWithParticipants",
+		FullMethod: "/v1.UnpaperService/GetPostsByHashtag",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).GetConversationWithParticipants(ctx, req.(*GetConversationWithParticipantsRequest))
+		return srv.(UnpaperServiceServer).GetPostsByHashtag(ctx, req.(*GetPostsByHashtagRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_ListenForNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(empty.Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(UnpaperServiceServer).ListenForNotifications(m, &unpaperServiceListenForNotificationsServer{stream})
-}
-
-type UnpaperService_ListenForNotificationsServer interface {
-	Send(*Notification) error
-	grpc.ServerStream
-}
-
-type unpaperServiceListenForNotificationsServer struct {
-	grpc.ServerStream
-}
-
-func (x *unpaperServiceListenForNotificationsServer) Send(m *Notification) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _UnpaperService_GetAllNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _UnpaperService_GetTrendingHashtags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendingHashtagsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).GetAllNotifications(ctx, in)
+		return srv.(UnpaperServiceServer).GetTrendingHashtags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/GetAllNotifications",
+		FullMethod: "/v1.UnpaperService/GetTrendingHashtags",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).GetAllNotifications(ctx, req.(*empty.Empty))
+		return srv.(UnpaperServiceServer).GetTrendingHashtags(ctx, req.(*GetTrendingHashtagsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_ReadNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadNotificationRequest)
+func _UnpaperService_RecordPlayback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordPlaybackRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).ReadNotification(ctx, in)
+		return srv.(UnpaperServiceServer).RecordPlayback(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/ReadNotification",
+		FullMethod: "/v1.UnpaperService/RecordPlayback",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).ReadNotification(ctx, req.(*ReadNotificationRequest))
+		return srv.(UnpaperServiceServer).RecordPlayback(ctx, req.(*RecordPlaybackRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_GetMixes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _UnpaperService_GetPostAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostAnalyticsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).GetMixes(ctx, in)
+		return srv.(UnpaperServiceServer).GetPostAnalytics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/GetMixes",
+		FullMethod: "/v1.UnpaperService/GetPostAnalytics",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).GetMixes(ctx, req.(*empty.Empty))
+		return srv.(UnpaperServiceServer).GetPostAnalytics(ctx, req.(*GetPostAnalyticsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePostRequest)
+func _UnpaperService_Report_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).CreatePost(ctx, in)
+		return srv.(UnpaperServiceServer).Report(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/CreatePost",
+		FullMethod: "/v1.UnpaperService/Report",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).CreatePost(ctx, req.(*CreatePostRequest))
+		return srv.(UnpaperServiceServer).Report(ctx, req.(*ReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_GetPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPostRequest)
+func _UnpaperService_ListReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).GetPost(ctx, in)
+		return srv.(UnpaperServiceServer).ListReports(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/GetPost",
+		FullMethod: "/v1.UnpaperService/ListReports",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).GetPost(ctx, req.(*GetPostRequest))
+		return srv.(UnpaperServiceServer).ListReports(ctx, req.(*ListReportsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_GetPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPostsRequest)
+func _UnpaperService_ClaimReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).GetPosts(ctx, in)
+		return srv.(UnpaperServiceServer).ClaimReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/GetPosts",
+		FullMethod: "/v1.UnpaperService/ClaimReport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).GetPosts(ctx, req.(*GetPostsRequest))
+		return srv.(UnpaperServiceServer).ClaimReport(ctx, req.(*ClaimReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCommentRequest)
+func _UnpaperService_ResolveReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).CreateComment(ctx, in)
+		return srv.(UnpaperServiceServer).ResolveReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/CreateComment",
+		FullMethod: "/v1.UnpaperService/ResolveReport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).CreateComment(ctx, req.(*CreateCommentRequest))
+		return srv.(UnpaperServiceServer).ResolveReport(ctx, req.(*ResolveReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_LikePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LikePostRequest)
+func _UnpaperService_GetModerationLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModerationLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).LikePost(ctx, in)
+		return srv.(UnpaperServiceServer).GetModerationLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/LikePost",
+		FullMethod: "/v1.UnpaperService/GetModerationLog",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).LikePost(ctx, req.(*LikePostRequest))
+		return srv.(UnpaperServiceServer).GetModerationLog(ctx, req.(*GetModerationLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UnpaperService_LikeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LikeCommentRequest)
+func _UnpaperService_VotePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VotePollRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UnpaperServiceServer).LikeComment(ctx, in)
+		return srv.(UnpaperServiceServer).VotePoll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.UnpaperService/LikeComment",
+		FullMethod: "/v1.UnpaperService/VotePoll",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UnpaperServiceServer).LikeComment(ctx, req.(*LikeCommentRequest))
+		return srv.(UnpaperServiceServer).VotePoll(ctx, req.(*VotePollRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3162,6 +5095,10 @@ var _UnpaperService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUsername",
 			Handler:    _UnpaperService_UpdateUsername_Handler,
 		},
+		{
+			MethodName: "SetMessageRequestsPolicy",
+			Handler:    _UnpaperService_SetMessageRequestsPolicy_Handler,
+		},
 		{
 			MethodName: "SignOut",
 			Handler:    _UnpaperService_SignOut_Handler,
@@ -3194,6 +5131,14 @@ var _UnpaperService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetFollowersCount",
 			Handler:    _UnpaperService_GetFollowersCount_Handler,
 		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _UnpaperService_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _UnpaperService_UnblockUser_Handler,
+		},
 		{
 			MethodName: "UserInfo",
 			Handler:    _UnpaperService_UserInfo_Handler,
@@ -3354,6 +5299,30 @@ var _UnpaperService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetConversationWithParticipants",
 			Handler:    _UnpaperService_GetConversationWithParticipants_Handler,
 		},
+		{
+			MethodName: "SetConversationRetention",
+			Handler:    _UnpaperService_SetConversationRetention_Handler,
+		},
+		{
+			MethodName: "SetConversationMuted",
+			Handler:    _UnpaperService_SetConversationMuted_Handler,
+		},
+		{
+			MethodName: "GetMessageRequests",
+			Handler:    _UnpaperService_GetMessageRequests_Handler,
+		},
+		{
+			MethodName: "RespondToMessageRequest",
+			Handler:    _UnpaperService_RespondToMessageRequest_Handler,
+		},
+		{
+			MethodName: "ListScheduledMessages",
+			Handler:    _UnpaperService_ListScheduledMessages_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMessage",
+			Handler:    _UnpaperService_CancelScheduledMessage_Handler,
+		},
 		{
 			MethodName: "GetAllNotifications",
 			Handler:    _UnpaperService_GetAllNotifications_Handler,
@@ -3378,6 +5347,58 @@ var _UnpaperService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPosts",
 			Handler:    _UnpaperService_GetPosts_Handler,
 		},
+		{
+			MethodName: "GetPostCategories",
+			Handler:    _UnpaperService_GetPostCategories_Handler,
+		},
+		{
+			MethodName: "GetComments",
+			Handler:    _UnpaperService_GetComments_Handler,
+		},
+		{
+			MethodName: "GetCommentTree",
+			Handler:    _UnpaperService_GetCommentTree_Handler,
+		},
+		{
+			MethodName: "GetThread",
+			Handler:    _UnpaperService_GetThread_Handler,
+		},
+		{
+			MethodName: "SubscribeToThread",
+			Handler:    _UnpaperService_SubscribeToThread_Handler,
+		},
+		{
+			MethodName: "UnsubscribeFromThread",
+			Handler:    _UnpaperService_UnsubscribeFromThread_Handler,
+		},
+		{
+			MethodName: "GetHomeFeed",
+			Handler:    _UnpaperService_GetHomeFeed_Handler,
+		},
+		{
+			MethodName: "GetTrendingPosts",
+			Handler:    _UnpaperService_GetTrendingPosts_Handler,
+		},
+		{
+			MethodName: "UpdatePost",
+			Handler:    _UnpaperService_UpdatePost_Handler,
+		},
+		{
+			MethodName: "DeletePost",
+			Handler:    _UnpaperService_DeletePost_Handler,
+		},
+		{
+			MethodName: "UpdateComment",
+			Handler:    _UnpaperService_UpdateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _UnpaperService_DeleteComment_Handler,
+		},
+		{
+			MethodName: "GetEditHistory",
+			Handler:    _UnpaperService_GetEditHistory_Handler,
+		},
 		{
 			MethodName: "CreateComment",
 			Handler:    _UnpaperService_CreateComment_Handler,
@@ -3390,6 +5411,102 @@ var _UnpaperService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LikeComment",
 			Handler:    _UnpaperService_LikeComment_Handler,
 		},
+		{
+			MethodName: "GetPostLikers",
+			Handler:    _UnpaperService_GetPostLikers_Handler,
+		},
+		{
+			MethodName: "GetCommentLikers",
+			Handler:    _UnpaperService_GetCommentLikers_Handler,
+		},
+		{
+			MethodName: "BookmarkPost",
+			Handler:    _UnpaperService_BookmarkPost_Handler,
+		},
+		{
+			MethodName: "UnbookmarkPost",
+			Handler:    _UnpaperService_UnbookmarkPost_Handler,
+		},
+		{
+			MethodName: "GetBookmarks",
+			Handler:    _UnpaperService_GetBookmarks_Handler,
+		},
+		{
+			MethodName: "GetBookmarkCollections",
+			Handler:    _UnpaperService_GetBookmarkCollections_Handler,
+		},
+		{
+			MethodName: "Repost",
+			Handler:    _UnpaperService_Repost_Handler,
+		},
+		{
+			MethodName: "UndoRepost",
+			Handler:    _UnpaperService_UndoRepost_Handler,
+		},
+		{
+			MethodName: "GetDrafts",
+			Handler:    _UnpaperService_GetDrafts_Handler,
+		},
+		{
+			MethodName: "GetScheduledPosts",
+			Handler:    _UnpaperService_GetScheduledPosts_Handler,
+		},
+		{
+			MethodName: "SchedulePost",
+			Handler:    _UnpaperService_SchedulePost_Handler,
+		},
+		{
+			MethodName: "CancelScheduledPost",
+			Handler:    _UnpaperService_CancelScheduledPost_Handler,
+		},
+		{
+			MethodName: "PublishPost",
+			Handler:    _UnpaperService_PublishPost_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _UnpaperService_Search_Handler,
+		},
+		{
+			MethodName: "GetPostsByHashtag",
+			Handler:    _UnpaperService_GetPostsByHashtag_Handler,
+		},
+		{
+			MethodName: "GetTrendingHashtags",
+			Handler:    _UnpaperService_GetTrendingHashtags_Handler,
+		},
+		{
+			MethodName: "RecordPlayback",
+			Handler:    _UnpaperService_RecordPlayback_Handler,
+		},
+		{
+			MethodName: "GetPostAnalytics",
+			Handler:    _UnpaperService_GetPostAnalytics_Handler,
+		},
+		{
+			MethodName: "Report",
+			Handler:    _UnpaperService_Report_Handler,
+		},
+		{
+			MethodName: "ListReports",
+			Handler:    _UnpaperService_ListReports_Handler,
+		},
+		{
+			MethodName: "ClaimReport",
+			Handler:    _UnpaperService_ClaimReport_Handler,
+		},
+		{
+			MethodName: "ResolveReport",
+			Handler:    _UnpaperService_ResolveReport_Handler,
+		},
+		{
+			MethodName: "GetModerationLog",
+			Handler:    _UnpaperService_GetModerationLog_Handler,
+		},
+		{
+			MethodName: "VotePoll",
+			Handler:    _UnpaperService_VotePoll_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3397,6 +5514,11 @@ var _UnpaperService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _UnpaperService_ListenForMessages_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ExportConversation",
+			Handler:       _UnpaperService_ExportConversation_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ListenForNotifications",
 			Handler:       _UnpaperService_ListenForNotifications_Handler,
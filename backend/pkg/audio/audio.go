@@ -0,0 +1,118 @@
+// Package audio validates uploaded audio files, detecting their real format, duration and waveform
+package audio
+
+import (
+	"bytes"
+	"errors"
+	"time"
+)
+
+const (
+	// MaxBytes is the maximum size of an audio file
+	MaxBytes = 4 << 20
+	// MaxDuration is the maximum duration of an audio file
+	MaxDuration = 10 * time.Minute
+	// PeaksCount is the number of buckets the waveform is downsampled to
+	PeaksCount = 100
+)
+
+// Format is an accepted audio container
+type Format string
+
+const (
+	// FormatWAV is a RIFF/WAVE file holding PCM or IEEE float samples
+	FormatWAV Format = "wav"
+	// FormatMP3 is an MPEG Layer III stream, optionally wrapped by ID3 tags
+	FormatMP3 Format = "mp3"
+	// FormatOpus is an Ogg container holding an Opus stream
+	FormatOpus Format = "opus"
+)
+
+var (
+	// ErrTooLarge is returned when the file exceeds MaxBytes
+	ErrTooLarge = errors.New("audio file too large")
+	// ErrTooLong is returned when the audio exceeds MaxDuration
+	ErrTooLong = errors.New("audio too long")
+	// ErrUnsupportedFormat is returned when the file is not in one of the accepted formats
+	ErrUnsupportedFormat = errors.New("unsupported audio format")
+)
+
+// Info holds the properties detected from an audio file
+type Info struct {
+	Format   Format
+	Duration time.Duration
+	// Peaks is the waveform downsampled to PeaksCount buckets, each in the [0, 1] range.
+	// WAV peaks are sample amplitudes, while compressed formats are not decoded
+	// and their peaks are estimated from the loudness information of every frame
+	Peaks []float32
+}
+
+// Probe validates the audio file, detecting its format from the content rather than trusting the client
+func Probe(data []byte) (*Info, error) {
+	if len(data) > MaxBytes {
+		return nil, ErrTooLarge
+	}
+
+	var info *Info
+	var err error
+	switch {
+	case len(data) >= 12 && bytes.Equal(data[0:4], []byte("RIFF")) && bytes.Equal(data[8:12], []byte("WAVE")):
+		info, err = probeWAV(data)
+	case bytes.HasPrefix(data, []byte("OggS")):
+		info, err = probeOpus(data)
+	case bytes.HasPrefix(data, []byte("ID3")) || isMP3FrameSync(data):
+		info, err = probeMP3(data)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	if info.Duration > MaxDuration {
+		return nil, ErrTooLong
+	}
+
+	return info, nil
+}
+
+// downsample reduces n values to PeaksCount buckets, keeping the maximum of every bucket.
+// When there are fewer values than buckets, values are stretched over multiple buckets
+func downsample(n int, value func(i int) float32) []float32 {
+	peaks := make([]float32, PeaksCount)
+	if n == 0 {
+		return peaks
+	}
+
+	for b := range peaks {
+		start := b * n / PeaksCount
+		end := (b + 1) * n / PeaksCount
+		if end <= start {
+			end = start + 1
+		}
+		for i := start; i < end; i++ {
+			if v := value(i); v > peaks[b] {
+				peaks[b] = v
+			}
+		}
+	}
+
+	return peaks
+}
+
+// normalize scales the peaks so that the highest one is 1
+func normalize(peaks []float32) []float32 {
+	var max float32
+	for _, p := range peaks {
+		if p > max {
+			max = p
+		}
+	}
+	if max == 0 {
+		return peaks
+	}
+	for i := range peaks {
+		peaks[i] /= max
+	}
+
+	return peaks
+}
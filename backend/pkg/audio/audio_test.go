@@ -0,0 +1,182 @@
+package audio_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/DagDigg/unpaper/backend/pkg/audio"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProbeWAV(t *testing.T) {
+	assert := assert.New(t)
+
+	info, err := audio.Probe(newWAV(8000, 8000))
+	assert.Nil(err)
+	assert.Equal(audio.FormatWAV, info.Format)
+	assert.Equal(time.Second, info.Duration)
+	assert.Len(info.Peaks, audio.PeaksCount)
+	for _, p := range info.Peaks {
+		assert.InDelta(0.5, p, 0.01)
+	}
+
+	// Data chunk larger than the file
+	wav := newWAV(8000, 8000)
+	_, err = audio.Probe(wav[:len(wav)-10])
+	assert.NotNil(err)
+}
+
+func TestProbeMP3(t *testing.T) {
+	assert := assert.New(t)
+
+	info, err := audio.Probe(newMP3(38))
+	assert.Nil(err)
+	assert.Equal(audio.FormatMP3, info.Format)
+	// 38 frames of 1152 samples at 44.1kHz
+	assert.Equal(time.Duration(38*1152)*time.Second/44100, info.Duration)
+	assert.Len(info.Peaks, audio.PeaksCount)
+
+	// With an ID3v2 tag and an ID3v1 trailer
+	id3 := append([]byte("ID3\x04\x00\x00\x00\x00\x00\x0a"), make([]byte, 10)...)
+	withTags := append(append(id3, newMP3(38)...), append([]byte("TAG"), make([]byte, 125)...)...)
+	info, err = audio.Probe(withTags)
+	assert.Nil(err)
+	assert.Equal(time.Duration(38*1152)*time.Second/44100, info.Duration)
+
+	// Truncated last frame
+	mp3 := newMP3(38)
+	_, err = audio.Probe(mp3[:len(mp3)-100])
+	assert.NotNil(err)
+}
+
+func TestProbeOpus(t *testing.T) {
+	assert := assert.New(t)
+
+	info, err := audio.Probe(newOpus(50))
+	assert.Nil(err)
+	assert.Equal(audio.FormatOpus, info.Format)
+	// 50 packets of 20ms, minus the pre-skip
+	assert.Equal(time.Duration(50*960-312)*time.Second/48000, info.Duration)
+	assert.Len(info.Peaks, audio.PeaksCount)
+	assert.Equal(float32(1), info.Peaks[audio.PeaksCount-1])
+
+	// Corrupted page
+	opus := newOpus(50)
+	opus[len(opus)-1] ^= 0xFF
+	_, err = audio.Probe(opus)
+	assert.NotNil(err)
+
+	// Granule position overflowing the duration
+	packet := make([]byte, 10)
+	packet[0] = 31 << 3
+	opus = append(newOpus(5), oggPage(3, 1<<62, [][]byte{packet})...)
+	_, err = audio.Probe(opus)
+	assert.Equal(audio.ErrTooLong, err)
+}
+
+func TestProbeInvalid(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := audio.Probe([]byte("definitely not audio"))
+	assert.Equal(audio.ErrUnsupportedFormat, err)
+	_, err = audio.Probe(make([]byte, audio.MaxBytes+1))
+	assert.Equal(audio.ErrTooLarge, err)
+	_, err = audio.Probe(newWAV(8000, 8000*int(audio.MaxDuration/time.Second+1)))
+	assert.Equal(audio.ErrTooLarge, err)
+	_, err = audio.Probe(newWAV(100, 100*int(audio.MaxDuration/time.Second+1)))
+	assert.Equal(audio.ErrTooLong, err)
+}
+
+// newWAV returns a mono 16 bit PCM file holding a half amplitude square wave
+func newWAV(sampleRate, samples int) []byte {
+	data := &bytes.Buffer{}
+	for i := 0; i < samples; i++ {
+		v := int16(math.MaxInt16 / 2)
+		if i%2 == 1 {
+			v = -v
+		}
+		binary.Write(data, binary.LittleEndian, v)
+	}
+
+	b := &bytes.Buffer{}
+	b.WriteString("RIFF")
+	binary.Write(b, binary.LittleEndian, uint32(36+data.Len()))
+	b.WriteString("WAVEfmt ")
+	for _, v := range []interface{}{uint32(16), uint16(1), uint16(1), uint32(sampleRate), uint32(sampleRate * 2), uint16(2), uint16(16)} {
+		binary.Write(b, binary.LittleEndian, v)
+	}
+	b.WriteString("data")
+	binary.Write(b, binary.LittleEndian, uint32(data.Len()))
+	b.Write(data.Bytes())
+
+	return b.Bytes()
+}
+
+// newMP3 returns frames of a 128kbps 44.1kHz stereo MPEG1 Layer III stream
+func newMP3(frames int) []byte {
+	b := &bytes.Buffer{}
+	for i := 0; i < frames; i++ {
+		frame := make([]byte, 144*128000/44100)
+		copy(frame, []byte{0xFF, 0xFB, 0x90, 0x00})
+		b.Write(frame)
+	}
+	return b.Bytes()
+}
+
+// newOpus returns an Ogg/Opus stream of 20ms CELT packets, growing in size
+func newOpus(packets int) []byte {
+	head := append([]byte("OpusHead\x01\x01"), 0, 0, 0, 0, 0, 0, 0, 0, 0)
+	binary.LittleEndian.PutUint16(head[10:12], 312)
+	binary.LittleEndian.PutUint32(head[12:16], 48000)
+
+	b := &bytes.Buffer{}
+	b.Write(oggPage(0, 0, [][]byte{head}))
+	b.Write(oggPage(1, 0, [][]byte{[]byte("OpusTags\x00\x00\x00\x00\x00\x00\x00\x00")}))
+	audioPackets := [][]byte{}
+	for i := 0; i < packets; i++ {
+		packet := make([]byte, 10+i)
+		packet[0] = 31 << 3
+		audioPackets = append(audioPackets, packet)
+	}
+	b.Write(oggPage(2, uint64(packets*960), audioPackets))
+
+	return b.Bytes()
+}
+
+func oggPage(seq uint32, granule uint64, packets [][]byte) []byte {
+	segments := []byte{}
+	body := []byte{}
+	for _, p := range packets {
+		n := len(p)
+		for ; n >= 255; n -= 255 {
+			segments = append(segments, 255)
+		}
+		segments = append(segments, byte(n))
+		body = append(body, p...)
+	}
+
+	page := append([]byte("OggS\x00\x00"), make([]byte, 21)...)
+	binary.LittleEndian.PutUint64(page[6:14], granule)
+	binary.LittleEndian.PutUint32(page[14:18], 1)
+	binary.LittleEndian.PutUint32(page[18:22], seq)
+	page[26] = byte(len(segments))
+	page = append(append(page, segments...), body...)
+
+	var crc uint32
+	for _, c := range page {
+		crc ^= uint32(c) << 24
+		for i := 0; i < 8; i++ {
+			if crc&0x80000000 != 0 {
+				crc = crc<<1 ^ 0x04C11DB7
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	binary.LittleEndian.PutUint32(page[22:26], crc)
+
+	return page
+}
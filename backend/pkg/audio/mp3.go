@@ -0,0 +1,222 @@
+package audio
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"time"
+)
+
+const (
+	mpegVersion25 = 0
+	mpegVersion2  = 2
+	mpegVersion1  = 3
+	mpegLayer3    = 1
+	channelMono   = 3
+)
+
+var (
+	// Layer III bitrates, in kbps, by bitrate index
+	mpeg1Bitrates = [16]int{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 0}
+	mpeg2Bitrates = [16]int{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0}
+	// MPEG1 sample rates by sample rate index. MPEG2 halves them, MPEG2.5 quarters them
+	mpeg1SampleRates = [3]int{44100, 48000, 32000}
+)
+
+type mp3Frame struct {
+	version    int
+	sampleRate int
+	samples    int
+	length     int
+	mono       bool
+	crc        bool
+}
+
+// isMP3FrameSync returns whether data begins with an MPEG Layer III frame header
+func isMP3FrameSync(data []byte) bool {
+	_, ok := parseMP3Header(data)
+	return ok
+}
+
+func parseMP3Header(b []byte) (mp3Frame, bool) {
+	if len(b) < 4 || b[0] != 0xFF || b[1]&0xE0 != 0xE0 {
+		return mp3Frame{}, false
+	}
+	version := int(b[1]>>3) & 0x03
+	layer := int(b[1]>>1) & 0x03
+	bitrateIndex := int(b[2] >> 4)
+	sampleRateIndex := int(b[2]>>2) & 0x03
+	if version == 1 || layer != mpegLayer3 || sampleRateIndex == 3 {
+		return mp3Frame{}, false
+	}
+
+	f := mp3Frame{
+		version:    version,
+		sampleRate: mpeg1SampleRates[sampleRateIndex],
+		samples:    1152,
+		mono:       int(b[3]>>6) == channelMono,
+		crc:        b[1]&0x01 == 0,
+	}
+	bitrate := mpeg1Bitrates[bitrateIndex]
+	coefficient := 144
+	if version != mpegVersion1 {
+		bitrate = mpeg2Bitrates[bitrateIndex]
+		f.sampleRate /= 2
+		f.samples = 576
+		coefficient = 72
+	}
+	if version == mpegVersion25 {
+		f.sampleRate /= 2
+	}
+	// Free format streams are not supported
+	if bitrate == 0 {
+		return mp3Frame{}, false
+	}
+	padding := int(b[2]>>1) & 0x01
+	f.length = coefficient*bitrate*1000/f.sampleRate + padding
+
+	return f, true
+}
+
+// probeMP3 walks the MPEG frames, skipping ID3 and APE tags.
+// Frames are not decoded: the waveform is estimated from the global gain of every frame
+func probeMP3(data []byte) (*Info, error) {
+	off := 0
+	// ID3v2 tag, whose size is a 28 bit sync safe integer
+	if bytes.HasPrefix(data, []byte("ID3")) {
+		if len(data) < 10 {
+			return nil, errors.New("malformed mp3: short id3 tag")
+		}
+		size := int(data[6]&0x7F)<<21 | int(data[7]&0x7F)<<14 | int(data[8]&0x7F)<<7 | int(data[9]&0x7F)
+		off = 10 + size
+		if data[5]&0x10 != 0 {
+			// Footer
+			off += 10
+		}
+		// Some encoders pad the tag with zeros
+		for off < len(data) && data[off] == 0 {
+			off++
+		}
+	}
+
+	samples := 0
+	sampleRate := 0
+	gains := []float32{}
+	for off < len(data) {
+		rest := data[off:]
+		if bytes.HasPrefix(rest, []byte("TAG")) || bytes.HasPrefix(rest, []byte("APETAGEX")) {
+			break
+		}
+		f, ok := parseMP3Header(rest)
+		if !ok {
+			return nil, errors.New("malformed mp3: lost frame sync")
+		}
+		if f.length > len(rest) {
+			return nil, errors.New("malformed mp3: truncated frame")
+		}
+		if sampleRate != 0 && f.sampleRate != sampleRate {
+			return nil, errors.New("malformed mp3: sample rate changes between frames")
+		}
+		sampleRate = f.sampleRate
+
+		// The Xing, Info or VBRI header of VBR files is a silent frame holding the stream metadata
+		if len(gains) > 0 || !isMP3InfoFrame(f, rest) {
+			samples += f.samples
+			gains = append(gains, mp3FrameGain(f, rest))
+		}
+		off += f.length
+	}
+	if samples == 0 {
+		return nil, errors.New("malformed mp3: no frames")
+	}
+
+	return &Info{
+		Format:   FormatMP3,
+		Duration: time.Duration(samples) * time.Second / time.Duration(sampleRate),
+		Peaks: normalize(downsample(len(gains), func(i int) float32 {
+			return gains[i]
+		})),
+	}, nil
+}
+
+// mp3SideInfoOffset returns the offset of the side information within the frame
+func mp3SideInfoOffset(f mp3Frame) int {
+	if f.crc {
+		return 6
+	}
+	return 4
+}
+
+func isMP3InfoFrame(f mp3Frame, frame []byte) bool {
+	sideInfoLength := 32
+	switch {
+	case f.version == mpegVersion1 && f.mono:
+		sideInfoLength = 17
+	case f.version != mpegVersion1 && f.mono:
+		sideInfoLength = 9
+	case f.version != mpegVersion1:
+		sideInfoLength = 17
+	}
+	off := mp3SideInfoOffset(f) + sideInfoLength
+	if off+4 <= f.length {
+		if tag := string(frame[off : off+4]); tag == "Xing" || tag == "Info" {
+			return true
+		}
+	}
+	// VBRI headers are at a fixed offset
+	return 36+4 <= f.length && string(frame[36:40]) == "VBRI"
+}
+
+// mp3FrameGain returns the amplitude matching the global gain of the first granule of the frame.
+// Granules without coded samples are silent
+func mp3FrameGain(f mp3Frame, frame []byte) float32 {
+	r := bitReader{b: frame[mp3SideInfoOffset(f):f.length]}
+	if f.version == mpegVersion1 {
+		// main_data_begin, private bits and scfsi
+		r.skip(9)
+		if f.mono {
+			r.skip(5 + 4)
+		} else {
+			r.skip(3 + 8)
+		}
+	} else {
+		r.skip(8)
+		if f.mono {
+			r.skip(1)
+		} else {
+			r.skip(2)
+		}
+	}
+	part23Length := r.read(12)
+	bigValues := r.read(9)
+	globalGain := r.read(8)
+	if part23Length == 0 && bigValues == 0 {
+		return 0
+	}
+
+	// Every global gain step is 1.5dB, 210 being the unity gain
+	return float32(math.Pow(2, (float64(globalGain)-210)/4))
+}
+
+// bitReader reads big endian bit fields. Reading past the end returns zeros
+type bitReader struct {
+	b   []byte
+	pos int
+}
+
+func (r *bitReader) skip(n int) {
+	r.pos += n
+}
+
+func (r *bitReader) read(n int) int {
+	v := 0
+	for i := 0; i < n; i++ {
+		bit := 0
+		if byteIdx := r.pos / 8; byteIdx < len(r.b) {
+			bit = int(r.b[byteIdx]>>(7-uint(r.pos%8))) & 0x01
+		}
+		v = v<<1 | bit
+		r.pos++
+	}
+	return v
+}
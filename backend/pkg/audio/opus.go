@@ -0,0 +1,156 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"time"
+)
+
+// opusSampleRate is the rate of Opus granule positions, regardless of the input sample rate
+const opusSampleRate = 48000
+
+// Opus frame durations, in 1/10 of a millisecond, by TOC configuration
+var opusFrameDurations = [32]int{
+	100, 200, 400, 600, 100, 200, 400, 600, 100, 200, 400, 600, // SILK
+	100, 200, 100, 200, // Hybrid
+	25, 50, 100, 200, 25, 50, 100, 200, 25, 50, 100, 200, 25, 50, 100, 200, // CELT
+}
+
+var oggCRCTable = func() [256]uint32 {
+	var t [256]uint32
+	for i := range t {
+		r := uint32(i) << 24
+		for j := 0; j < 8; j++ {
+			if r&0x80000000 != 0 {
+				r = r<<1 ^ 0x04C11DB7
+			} else {
+				r <<= 1
+			}
+		}
+		t[i] = r
+	}
+	return t
+}()
+
+func oggCRC(page []byte) uint32 {
+	var crc uint32
+	for i, b := range page {
+		// The checksum field is computed as zero
+		if i >= 22 && i < 26 {
+			b = 0
+		}
+		crc = crc<<8 ^ oggCRCTable[byte(crc>>24)^b]
+	}
+	return crc
+}
+
+// probeOpus walks the Ogg pages of the first logical stream, which must be Opus.
+// Packets are not decoded: the waveform is estimated from the bitrate of every packet,
+// as the encoder spends more bits on louder and richer frames
+func probeOpus(data []byte) (*Info, error) {
+	var serial uint32
+	var preSkip, granule int64
+	packets := 0
+	packet := []byte{}
+	bitrates := []float32{}
+
+	for off := 0; off < len(data); {
+		page := data[off:]
+		if len(page) < 27 || !bytes.HasPrefix(page, []byte("OggS")) || page[4] != 0 {
+			return nil, errors.New("malformed ogg: invalid page header")
+		}
+		segmentsCount := int(page[26])
+		if len(page) < 27+segmentsCount {
+			return nil, errors.New("malformed ogg: truncated page")
+		}
+		segments := page[27 : 27+segmentsCount]
+		length := 27 + segmentsCount
+		for _, s := range segments {
+			length += int(s)
+		}
+		if len(page) < length {
+			return nil, errors.New("malformed ogg: truncated page")
+		}
+		page = page[:length]
+		off += length
+		if binary.LittleEndian.Uint32(page[22:26]) != oggCRC(page) {
+			return nil, errors.New("malformed ogg: checksum mismatch")
+		}
+
+		pageSerial := binary.LittleEndian.Uint32(page[14:18])
+		if packets == 0 && len(packet) == 0 {
+			serial = pageSerial
+		}
+		// Multiplexed streams other than the first one are ignored
+		if pageSerial != serial {
+			continue
+		}
+		if g := int64(binary.LittleEndian.Uint64(page[6:14])); g != -1 {
+			granule = g
+		}
+
+		body := page[27+segmentsCount:]
+		for _, s := range segments {
+			packet = append(packet, body[:s]...)
+			body = body[s:]
+			// A lacing value lower than 255 terminates the packet
+			if s == 255 {
+				continue
+			}
+			switch packets {
+			case 0:
+				if len(packet) < 19 || !bytes.HasPrefix(packet, []byte("OpusHead")) {
+					return nil, ErrUnsupportedFormat
+				}
+				preSkip = int64(binary.LittleEndian.Uint16(packet[10:12]))
+			case 1:
+				if !bytes.HasPrefix(packet, []byte("OpusTags")) {
+					return nil, errors.New("malformed opus: missing comment header")
+				}
+			default:
+				if duration := opusPacketDuration(packet); duration > 0 {
+					bitrates = append(bitrates, float32(len(packet))/float32(duration))
+				}
+			}
+			packets++
+			packet = packet[:0]
+		}
+	}
+	if len(bitrates) == 0 || granule <= preSkip {
+		return nil, errors.New("malformed opus: no audio packets")
+	}
+	// Granule positions are 64 bits wide, reject them before the duration overflows
+	samples := granule - preSkip
+	if samples/opusSampleRate > int64(MaxDuration/time.Second) {
+		return nil, ErrTooLong
+	}
+
+	return &Info{
+		Format:   FormatOpus,
+		Duration: time.Duration(samples) * time.Second / opusSampleRate,
+		Peaks: normalize(downsample(len(bitrates), func(i int) float32 {
+			return bitrates[i]
+		})),
+	}, nil
+}
+
+// opusPacketDuration returns the packet duration, in 1/10 of a millisecond, read from its TOC byte
+func opusPacketDuration(packet []byte) int {
+	if len(packet) == 0 {
+		return 0
+	}
+	toc := packet[0]
+	frames := 1
+	switch toc & 0x03 {
+	case 1, 2:
+		frames = 2
+	case 3:
+		if len(packet) < 2 {
+			return 0
+		}
+		frames = int(packet[1] & 0x3F)
+	}
+
+	return frames * opusFrameDurations[toc>>3]
+}
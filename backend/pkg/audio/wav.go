@@ -0,0 +1,133 @@
+package audio
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"time"
+)
+
+const (
+	wavFormatPCM        = 1
+	wavFormatFloat      = 3
+	wavFormatExtensible = 0xFFFE
+)
+
+type wavFormat struct {
+	audioFormat   uint16
+	channels      int
+	sampleRate    int
+	blockAlign    int
+	bitsPerSample int
+}
+
+// probeWAV walks the RIFF chunks, decoding the samples of the 'data' chunk
+func probeWAV(data []byte) (*Info, error) {
+	var format *wavFormat
+	var samples []byte
+
+	for off := 12; off+8 <= len(data); {
+		id := string(data[off : off+4])
+		size := int(binary.LittleEndian.Uint32(data[off+4 : off+8]))
+		off += 8
+		if size > len(data)-off {
+			return nil, errors.New("malformed wav: chunk exceeds file size")
+		}
+		chunk := data[off : off+size]
+
+		switch id {
+		case "fmt ":
+			f, err := parseWAVFormat(chunk)
+			if err != nil {
+				return nil, err
+			}
+			format = f
+		case "data":
+			samples = chunk
+		}
+		// Chunks are padded to an even size
+		off += size + size%2
+	}
+	if format == nil || samples == nil {
+		return nil, errors.New("malformed wav: missing fmt or data chunk")
+	}
+
+	frames := len(samples) / format.blockAlign
+	if frames == 0 {
+		return nil, errors.New("malformed wav: no samples")
+	}
+	bytesPerSample := format.bitsPerSample / 8
+
+	return &Info{
+		Format:   FormatWAV,
+		Duration: time.Duration(frames) * time.Second / time.Duration(format.sampleRate),
+		Peaks: downsample(frames, func(i int) float32 {
+			var peak float32
+			frame := samples[i*format.blockAlign:]
+			for c := 0; c < format.channels; c++ {
+				if v := wavSample(format, frame[c*bytesPerSample:]); v > peak {
+					peak = v
+				}
+			}
+			return peak
+		}),
+	}, nil
+}
+
+func parseWAVFormat(chunk []byte) (*wavFormat, error) {
+	if len(chunk) < 16 {
+		return nil, errors.New("malformed wav: short fmt chunk")
+	}
+	f := &wavFormat{
+		audioFormat:   binary.LittleEndian.Uint16(chunk[0:2]),
+		channels:      int(binary.LittleEndian.Uint16(chunk[2:4])),
+		sampleRate:    int(binary.LittleEndian.Uint32(chunk[4:8])),
+		blockAlign:    int(binary.LittleEndian.Uint16(chunk[12:14])),
+		bitsPerSample: int(binary.LittleEndian.Uint16(chunk[14:16])),
+	}
+	if f.audioFormat == wavFormatExtensible {
+		// The actual format is at the beginning of the sub format GUID
+		if len(chunk) < 26 {
+			return nil, errors.New("malformed wav: short extensible fmt chunk")
+		}
+		f.audioFormat = binary.LittleEndian.Uint16(chunk[24:26])
+	}
+
+	switch {
+	case f.audioFormat == wavFormatPCM && (f.bitsPerSample == 8 || f.bitsPerSample == 16 || f.bitsPerSample == 24 || f.bitsPerSample == 32):
+	case f.audioFormat == wavFormatFloat && f.bitsPerSample == 32:
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if f.channels == 0 || f.sampleRate == 0 || f.blockAlign != f.channels*f.bitsPerSample/8 {
+		return nil, errors.New("malformed wav: inconsistent fmt chunk")
+	}
+
+	return f, nil
+}
+
+// wavSample returns the absolute amplitude, in the [0, 1] range, of the sample at the beginning of b
+func wavSample(f *wavFormat, b []byte) float32 {
+	var v float64
+	switch f.bitsPerSample {
+	case 8:
+		// 8 bit samples are unsigned
+		v = (float64(b[0]) - 128) / 128
+	case 16:
+		v = float64(int16(binary.LittleEndian.Uint16(b))) / (1 << 15)
+	case 24:
+		s := int32(uint32(b[0])<<8|uint32(b[1])<<16|uint32(b[2])<<24) >> 8
+		v = float64(s) / (1 << 23)
+	case 32:
+		if f.audioFormat == wavFormatFloat {
+			v = float64(math.Float32frombits(binary.LittleEndian.Uint32(b)))
+		} else {
+			v = float64(int32(binary.LittleEndian.Uint32(b))) / (1 << 31)
+		}
+	}
+
+	if math.IsNaN(v) {
+		return 0
+	}
+	return float32(math.Min(math.Abs(v), 1))
+}
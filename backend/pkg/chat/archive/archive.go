@@ -0,0 +1,184 @@
+// Package archive renders a chat conversation as a downloadable zip archive
+package archive
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+	"time"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/chat/message"
+)
+
+const (
+	// ContentType is the MIME type of the archive
+	ContentType = "application/zip"
+	// JSONFilename is the name of the messages JSON file inside the archive
+	JSONFilename = "messages.json"
+	// HTMLFilename is the name of the HTML transcript inside the archive
+	HTMLFilename = "transcript.html"
+	// AttachmentsDir is the archive directory containing audio and attachments
+	AttachmentsDir = "attachments"
+)
+
+// Conversation is the exported conversation, as rendered in the JSON file
+type Conversation struct {
+	ID           string        `json:"id"`
+	CreatedAt    time.Time     `json:"created_at"`
+	ExportedAt   time.Time     `json:"exported_at"`
+	ExportedBy   string        `json:"exported_by"`
+	Participants []Participant `json:"participants"`
+	Messages     []Message     `json:"messages"`
+}
+
+// Participant of the exported conversation
+type Participant struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// Message is an exported message. Audio and attachments are
+// stored as separate files, referenced by `Attachment`
+type Message struct {
+	ID             string    `json:"id"`
+	Type           string    `json:"type"`
+	UserID         string    `json:"user_id"`
+	Username       string    `json:"username"`
+	CreatedAt      time.Time `json:"created_at"`
+	Text           string    `json:"text,omitempty"`
+	AwardID        string    `json:"award_id,omitempty"`
+	DonationAmount int64     `json:"donation_amount,omitempty"`
+	Attachment     string    `json:"attachment,omitempty"`
+}
+
+// Filename returns the suggested archive filename for the conversation
+func Filename(conversationID string, exportedAt time.Time) string {
+	return fmt.Sprintf("conversation-%s-%s.zip", conversationID, exportedAt.UTC().Format("20060102T150405Z"))
+}
+
+// Write renders the conversation messages into a zip archive written to `w`.
+// The archive contains the messages as JSON, a self-contained HTML transcript and every attachment as a separate file
+func Write(w io.Writer, conv *v1API.Conversation, exportedBy string, msgs []*message.Message, exportedAt time.Time) error {
+	zw := zip.NewWriter(w)
+
+	exported := Conversation{
+		ID:           conv.Id,
+		CreatedAt:    conv.CreatedAt.AsTime(),
+		ExportedAt:   exportedAt,
+		ExportedBy:   exportedBy,
+		Participants: participantsList(conv.Participants),
+		Messages:     []Message{},
+	}
+
+	for _, m := range msgs {
+		exportedMsg := Message{
+			ID:             m.ID,
+			Type:           m.ToProtobuf().Type.String(),
+			UserID:         m.UserID,
+			Username:       m.SenderUsername,
+			CreatedAt:      m.CreatedAt,
+			Text:           m.Text.Content,
+			AwardID:        m.AwardID,
+			DonationAmount: m.Donation.Amount,
+		}
+
+		if m.Audio.Bytes != nil {
+			exportedMsg.Attachment = path.Join(AttachmentsDir, m.ID+attachmentExtension(m.Audio.Bytes))
+			if err := writeFile(zw, exportedMsg.Attachment, exportedAt, m.Audio.Bytes); err != nil {
+				return err
+			}
+		}
+
+		exported.Messages = append(exported.Messages, exportedMsg)
+	}
+
+	b, err := json.MarshalIndent(exported, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeFile(zw, JSONFilename, exportedAt, b); err != nil {
+		return err
+	}
+
+	f, err := zw.CreateHeader(&zip.FileHeader{Name: HTMLFilename, Method: zip.Deflate, Modified: exportedAt})
+	if err != nil {
+		return err
+	}
+	if err := transcriptTemplate.Execute(f, exported); err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+func writeFile(zw *zip.Writer, name string, modified time.Time, b []byte) error {
+	f, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: modified})
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(b)
+	return err
+}
+
+func participantsList(participants map[string]*v1API.ConversationParticipant) []Participant {
+	res := []Participant{}
+	for _, p := range participants {
+		res = append(res, Participant{UserID: p.UserId, Username: p.Username})
+	}
+	// Keep the output stable
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].UserID < res[j].UserID
+	})
+
+	return res
+}
+
+// attachmentExtension sniffs the attachment content, returning its file extension
+func attachmentExtension(b []byte) string {
+	switch http.DetectContentType(b) {
+	case "audio/wave":
+		return ".wav"
+	case "audio/mpeg":
+		return ".mp3"
+	case "application/ogg":
+		return ".ogg"
+	case "audio/aiff":
+		return ".aiff"
+	default:
+		return ".bin"
+	}
+}
+
+var transcriptTemplate = template.Must(template.New("transcript").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Conversation {{.ID}}</title>
+<style>
+body { font-family: sans-serif; max-width: 48rem; margin: 2rem auto; color: #222; }
+header { border-bottom: 1px solid #ddd; margin-bottom: 1rem; }
+.message { padding: .5rem 0; border-bottom: 1px solid #f0f0f0; }
+.meta { color: #888; font-size: .8rem; }
+.type { text-transform: lowercase; font-style: italic; }
+</style>
+</head>
+<body>
+<header>
+<h1>Conversation {{.ID}}</h1>
+<p class="meta">Exported at {{.ExportedAt.UTC.Format "2006-01-02 15:04:05 UTC"}}</p>
+<p>Participants: {{range $i, $p := .Participants}}{{if $i}}, {{end}}{{$p.Username}}{{end}}</p>
+</header>
+{{range .Messages}}<div class="message" id="{{.ID}}">
+<div class="meta"><strong>{{.Username}}</strong> &middot; {{.CreatedAt.UTC.Format "2006-01-02 15:04:05 UTC"}}</div>
+{{if .Text}}<p>{{.Text}}</p>{{end}}{{if .AwardID}}<p class="type">Sent award {{.AwardID}}</p>{{end}}{{if .DonationAmount}}<p class="type">Donated {{.DonationAmount}}</p>{{end}}{{if .Attachment}}<p><audio controls src="{{.Attachment}}"></audio> <a href="{{.Attachment}}">{{.Attachment}}</a></p>{{end}}
+</div>
+{{else}}<p>No messages.</p>
+{{end}}</body>
+</html>
+`))
@@ -0,0 +1,70 @@
+package archive_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/chat/archive"
+	"github.com/DagDigg/unpaper/backend/pkg/chat/message"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestWrite(t *testing.T) {
+	assert := assert.New(t)
+
+	conv := &v1API.Conversation{
+		Id:        "conversation_id",
+		CreatedAt: timestamppb.New(time.Date(2021, time.April, 1, 0, 0, 0, 0, time.UTC)),
+		Participants: map[string]*v1API.ConversationParticipant{
+			"alice": {UserId: "alice", Username: "alice"},
+			"bob":   {UserId: "bob", Username: "bob"},
+		},
+	}
+	msgs := []*message.Message{
+		{ID: "msg_text", UserID: "alice", SenderUsername: "alice", Text: message.Text{Content: "<b>hello</b>"}},
+		{ID: "msg_award", UserID: "bob", SenderUsername: "bob", Award: message.Award{AwardID: "award_id"}},
+		{ID: "msg_donation", UserID: "bob", SenderUsername: "bob", Donation: message.Donation{Amount: 10}},
+		{ID: "msg_audio", UserID: "alice", SenderUsername: "alice", Audio: message.Audio{Bytes: []byte("OggS\x00\x02rest")}},
+	}
+
+	t.Run("When writing a conversation archive", func(t *testing.T) {
+		b := &bytes.Buffer{}
+		err := archive.Write(b, conv, "alice", msgs, time.Now())
+		assert.Nil(err)
+
+		zr, err := zip.NewReader(bytes.NewReader(b.Bytes()), int64(b.Len()))
+		assert.Nil(err)
+
+		files := map[string][]byte{}
+		for _, f := range zr.File {
+			rc, err := f.Open()
+			assert.Nil(err)
+			content, err := ioutil.ReadAll(rc)
+			assert.Nil(err)
+			rc.Close()
+			files[f.Name] = content
+		}
+
+		assert.Contains(files, archive.JSONFilename)
+		assert.Contains(files, archive.HTMLFilename)
+		assert.Equal([]byte("OggS\x00\x02rest"), files["attachments/msg_audio.ogg"])
+
+		exported := archive.Conversation{}
+		assert.Nil(json.Unmarshal(files[archive.JSONFilename], &exported))
+		assert.Len(exported.Participants, 2)
+		assert.Len(exported.Messages, 4)
+		assert.Equal("TEXT", exported.Messages[0].Type)
+		assert.Equal("AWARD", exported.Messages[1].Type)
+		assert.Equal(int64(10), exported.Messages[2].DonationAmount)
+		assert.Equal("attachments/msg_audio.ogg", exported.Messages[3].Attachment)
+
+		// Text content must be escaped in the transcript
+		assert.Contains(string(files[archive.HTMLFilename]), "&lt;b&gt;hello&lt;/b&gt;")
+	})
+}
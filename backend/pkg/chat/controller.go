@@ -2,14 +2,22 @@ package chat
 
 import (
 	"context"
+	"io"
+	"time"
 
 	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/chat/message"
 )
 
+// SenderCheck returns ErrSenderSuspended if the user is not allowed to send messages anymore
+type SenderCheck func(ctx context.Context, userID string) error
+
 // Controller for the chat package
 type Controller interface {
 	SendMessage(ctx context.Context, ch string, msg Message) error
 	GetMessages(ctx context.Context, userID, ch string, offset int64) (*v1API.GetMessagesResponse, error)
+	GetMessage(ctx context.Context, userID, ch, messageID string) (*message.Message, error)
+	DeleteMessage(ctx context.Context, ch, messageID string) error
 	ListenForMessages(ctx context.Context, userID, ch string) <-chan Message
 	SetActiveConversation(ctx context.Context, userID, conversationID string) error
 	DeleteActiveConversation(ctx context.Context, userID string) error
@@ -17,5 +25,16 @@ type Controller interface {
 	GetConversations(ctx context.Context, userID string) ([]*v1API.Conversation, error)
 	CreateConversation(ctx context.Context, conversation Conversation) error
 	GetConversationsWithUser(ctx context.Context, userID, targetUserID string) ([]*v1API.Conversation, error)
+	GetMessageRequests(ctx context.Context, userID string) ([]*v1API.Conversation, error)
+	RespondToMessageRequest(ctx context.Context, userID, conversationID string, accept bool) (*v1API.Conversation, error)
 	ReadConversationMessages(ctx context.Context, userID, conversationID string) (*v1API.Conversation, error)
+	SetConversationRetention(ctx context.Context, userID, conversationID string, retention time.Duration) (*v1API.Conversation, error)
+	ExportConversation(ctx context.Context, userID, ch string, w io.Writer) error
+	SetConversationMuted(ctx context.Context, userID, conversationID string, muted bool) (*v1API.Conversation, error)
+	ScheduleMessage(ctx context.Context, scheduled *message.Scheduled) error
+	GetScheduledMessages(ctx context.Context, userID, ch string) ([]*v1API.ScheduledMessage, error)
+	CancelScheduledMessage(ctx context.Context, userID, scheduledID string) error
+	PurgeExpiredMessages(ctx context.Context) error
+	DispatchScheduledMessages(ctx context.Context) error
+	RunWorkers(ctx context.Context, interval time.Duration)
 }
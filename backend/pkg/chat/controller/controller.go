@@ -2,28 +2,65 @@ package controller
 
 import (
 	"context"
+	"errors"
+	"io"
+	"time"
 
+	dbNotifications "github.com/DagDigg/unpaper/backend/notifications"
 	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
 	"github.com/DagDigg/unpaper/backend/pkg/chat"
+	"github.com/DagDigg/unpaper/backend/pkg/chat/archive"
 	"github.com/DagDigg/unpaper/backend/pkg/chat/conversation"
+	"github.com/DagDigg/unpaper/backend/pkg/chat/message"
+	"github.com/DagDigg/unpaper/backend/pkg/logger"
+	"github.com/DagDigg/unpaper/backend/pkg/notifications"
+	"go.uber.org/zap"
 )
 
 type ctrl struct {
-	ucs chat.Usecase
+	ucs         chat.Usecase
+	nm          notifications.SendListenReceiver
+	checkSender chat.SenderCheck
 }
 
-// New returns a new chat.Controller
-func New(ucs chat.Usecase) chat.Controller {
+// New returns a new chat.Controller.
+// If `nm` is nil, no notifications are sent for new messages.
+// If `checkSender` is nil, scheduled messages are dispatched without checking their sender
+func New(ucs chat.Usecase, nm notifications.SendListenReceiver, checkSender chat.SenderCheck) chat.Controller {
 	return &ctrl{
-		ucs: ucs,
+		ucs:         ucs,
+		nm:          nm,
+		checkSender: checkSender,
 	}
 }
 
 func (c *ctrl) SendMessage(ctx context.Context, ch string, msg chat.Message) error {
+	// Apply the conversation default retention, if the message has no expiration of its own
+	raw := msg.GetRaw()
+	if raw.ExpiresAt.IsZero() {
+		retention, err := c.ucs.GetConversationRetention(ctx, raw.UserID, ch)
+		if err != nil {
+			return err
+		}
+		if retention > 0 {
+			raw.ExpiresAt = raw.CreatedAt.Add(retention)
+		}
+	}
+
+	requestMessage, err := c.checkMessageRequest(ctx, ch, raw.UserID)
+	if err != nil {
+		return err
+	}
+
 	// Send message
 	if err := c.ucs.SendMessage(ctx, ch, msg); err != nil {
 		return err
 	}
+	if requestMessage {
+		if _, err := c.ucs.SetConversationRequestMessageSent(ctx, raw.UserID, ch); err != nil {
+			return err
+		}
+	}
 
 	// Increment unread messages count to inactive users, if any
 	inactiveUsers, err := c.ucs.GetConversationInactiveUsers(ctx, msg.GetRaw().UserID, ch)
@@ -36,12 +73,115 @@ func (c *ctrl) SendMessage(ctx context.Context, ch string, msg chat.Message) err
 			if err := c.ucs.IncrementInactiveUserMsgsCount(ctx, u, ch); err != nil {
 				return err
 			}
+			c.notifyNewMessage(ctx, u, ch, msg.GetRaw())
 		}
 	}
 
+	c.notifyMentions(ctx, ch, msg.GetRaw())
+
 	return nil
 }
 
+// notifyMentions notifies the mentioned users who are participants of the conversation.
+// Failures are logged and never block the message
+func (c *ctrl) notifyMentions(ctx context.Context, ch string, msg *message.Message) {
+	if c.nm == nil || len(msg.Mentions) == 0 {
+		return
+	}
+
+	conv, err := c.ucs.GetConversation(ctx, msg.UserID, ch)
+	if err != nil {
+		logger.Log.Error("failed to retrieve conversation for mentions", zap.Error(err))
+		return
+	}
+
+	notified := map[string]bool{}
+	for _, m := range msg.Mentions {
+		// Users who are not in the conversation must not know about it
+		if _, ok := conv.Participants[m.UserID]; !ok || notified[m.UserID] {
+			continue
+		}
+		notified[m.UserID] = true
+
+		_, err := c.nm.Send(notifications.SendNotificationParams{
+			Ctx:            ctx,
+			SenderUserID:   msg.UserID,
+			ReceiverUserID: m.UserID,
+			TriggerID:      ch,
+			EventID:        string(dbNotifications.EventIDMention),
+			Content:        notificationContent(msg),
+		})
+		if err != nil {
+			logger.Log.Error("failed to send mention notification", zap.Error(err))
+		}
+	}
+}
+
+// checkMessageRequest verifies that the user can send a message to the conversation, if it is a message request.
+// The requester can send a single message until the request is accepted, while a reply from the recipient accepts it.
+// It returns true when the message is the one allowed to the requester, which must be recorded once sent
+func (c *ctrl) checkMessageRequest(ctx context.Context, ch, userID string) (bool, error) {
+	conv, err := c.ucs.GetConversation(ctx, userID, ch)
+	if err != nil {
+		return false, err
+	}
+
+	switch conv.RequestStatus {
+	case v1API.MessageRequestStatus_PENDING:
+		if conv.RequestedBy != userID {
+			_, err := c.ucs.SetConversationRequestStatus(ctx, userID, ch, conversation.RequestStatusAccepted)
+			return false, err
+		}
+		if conv.RequestMessageSent {
+			return false, chat.ErrMessageRequestPending
+		}
+		return true, nil
+	case v1API.MessageRequestStatus_DECLINED:
+		return false, chat.ErrMessageRequestDeclined
+	}
+
+	return false, nil
+}
+
+// notifyNewMessage notifies the inactive user about the new message, unless the conversation is muted.
+// Notifications are collapsed per conversation until read. Failures are logged and never block the message
+func (c *ctrl) notifyNewMessage(ctx context.Context, userID, ch string, msg *message.Message) {
+	if c.nm == nil || userID == msg.UserID {
+		return
+	}
+
+	muted, err := c.ucs.IsConversationMuted(ctx, userID, ch)
+	if err != nil {
+		logger.Log.Error("failed to retrieve conversation muted status", zap.Error(err))
+		return
+	}
+	if muted {
+		return
+	}
+
+	_, err = c.nm.Send(notifications.SendNotificationParams{
+		Ctx:            ctx,
+		SenderUserID:   msg.UserID,
+		ReceiverUserID: userID,
+		TriggerID:      ch,
+		EventID:        string(dbNotifications.EventIDNewMessage),
+		Content:        notificationContent(msg),
+		Collapse:       true,
+	})
+	if err != nil {
+		logger.Log.Error("failed to send new message notification", zap.Error(err))
+	}
+}
+
+// notificationContent returns the message text stored in its notifications.
+// Disappearing messages are left out, since notifications outlive them
+func notificationContent(msg *message.Message) string {
+	if !msg.ExpiresAt.IsZero() {
+		return ""
+	}
+	return msg.Text.Content
+}
+
 func (c *ctrl) GetMessages(ctx context.Context, userID, ch string, offset int64) (*v1API.GetMessagesResponse, error) {
 	messages := []*v1API.ChatMessage{}
 
@@ -68,6 +208,16 @@ func (c *ctrl) GetMessages(ctx context.Context, userID, ch string, offset int64)
 	return res, nil
 }
 
+// GetMessage returns a message of the conversation, if the user can see it
+func (c *ctrl) GetMessage(ctx context.Context, userID, ch, messageID string) (*message.Message, error) {
+	return c.ucs.GetMessage(ctx, userID, ch, messageID)
+}
+
+// DeleteMessage removes a message from the conversation, notifying the participants
+func (c *ctrl) DeleteMessage(ctx context.Context, ch, messageID string) error {
+	return c.ucs.DeleteMessage(ctx, ch, messageID)
+}
+
 func (c *ctrl) ListenForMessages(ctx context.Context, userID, ch string) <-chan chat.Message {
 	messages := make(chan chat.Message)
 
@@ -108,9 +258,68 @@ func (c *ctrl) GetConversation(ctx context.Context, userID, conversationID strin
 	return c.ucs.GetConversation(ctx, userID, conversationID)
 }
 
-// GetConversations retrieves the stored conversations for the userID
+// GetConversations retrieves the stored conversations for the userID.
+// Message requests received by the user are excluded
 func (c *ctrl) GetConversations(ctx context.Context, userID string) ([]*v1API.Conversation, error) {
-	return c.ucs.GetConversations(ctx, userID)
+	convs, err := c.ucs.GetConversations(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []*v1API.Conversation{}
+	for _, conv := range convs {
+		if isReceivedRequest(conv, userID) {
+			continue
+		}
+		res = append(res, conv)
+	}
+
+	return res, nil
+}
+
+// GetMessageRequests retrieves the pending message requests received by the userID
+func (c *ctrl) GetMessageRequests(ctx context.Context, userID string) ([]*v1API.Conversation, error) {
+	convs, err := c.ucs.GetConversations(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []*v1API.Conversation{}
+	for _, conv := range convs {
+		if isReceivedRequest(conv, userID) && conv.RequestStatus == v1API.MessageRequestStatus_PENDING {
+			res = append(res, conv)
+		}
+	}
+
+	return res, nil
+}
+
+// RespondToMessageRequest accepts or declines a pending message request received by the userID
+func (c *ctrl) RespondToMessageRequest(ctx context.Context, userID, conversationID string, accept bool) (*v1API.Conversation, error) {
+	conv, err := c.ucs.GetConversation(ctx, userID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	if !isReceivedRequest(conv, userID) || conv.RequestStatus != v1API.MessageRequestStatus_PENDING {
+		return nil, chat.ErrMessageRequestNotFound
+	}
+
+	status := conversation.RequestStatusDeclined
+	if accept {
+		status = conversation.RequestStatusAccepted
+	}
+
+	return c.ucs.SetConversationRequestStatus(ctx, userID, conversationID, status)
+}
+
+// isReceivedRequest returns whether the conversation is a not accepted message request sent to the userID
+func isReceivedRequest(conv *v1API.Conversation, userID string) bool {
+	switch conv.RequestStatus {
+	case v1API.MessageRequestStatus_PENDING, v1API.MessageRequestStatus_DECLINED:
+		return conv.RequestedBy != userID
+	default:
+		return false
+	}
 }
 
 // GetConversationsWithUser retrieves the stored conversations between the userID and target userID
@@ -122,3 +331,152 @@ func (c *ctrl) GetConversationsWithUser(ctx context.Context, userID, targetUserI
 func (c *ctrl) ReadConversationMessages(ctx context.Context, userID, conversationID string) (*v1API.Conversation, error) {
 	return c.ucs.ReadConversationMessages(ctx, userID, conversationID)
 }
+
+// SetConversationRetention sets the default expiration of the messages sent in the conversation.
+// A zero retention disables it
+func (c *ctrl) SetConversationRetention(ctx context.Context, userID, conversationID string, retention time.Duration) (*v1API.Conversation, error) {
+	return c.ucs.SetConversationRetention(ctx, userID, conversationID, retention)
+}
+
+// ExportConversation writes to `w` a zip archive of every message the user can see in the conversation
+func (c *ctrl) ExportConversation(ctx context.Context, userID, ch string, w io.Writer) error {
+	conv, err := c.ucs.GetConversation(ctx, userID, ch)
+	if err != nil {
+		return err
+	}
+
+	messages, err := c.ucs.GetAllMessages(ctx, userID, ch)
+	if err != nil {
+		return err
+	}
+
+	return archive.Write(w, conv, userID, messages, time.Now())
+}
+
+// SetConversationMuted mutes or unmutes the conversation notifications for the user
+func (c *ctrl) SetConversationMuted(ctx context.Context, userID, conversationID string, muted bool) (*v1API.Conversation, error) {
+	return c.ucs.SetConversationMuted(ctx, userID, conversationID, muted)
+}
+
+// ScheduleMessage holds the message server-side until its send time
+func (c *ctrl) ScheduleMessage(ctx context.Context, scheduled *message.Scheduled) error {
+	return c.ucs.ScheduleMessage(ctx, scheduled)
+}
+
+// GetScheduledMessages returns the user's pending scheduled messages.
+// If `ch` is not empty, only the ones scheduled on that conversation are returned
+func (c *ctrl) GetScheduledMessages(ctx context.Context, userID, ch string) ([]*v1API.ScheduledMessage, error) {
+	scheduled, err := c.ucs.GetScheduledMessages(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []*v1API.ScheduledMessage{}
+	for _, s := range scheduled {
+		if ch != "" && s.ConversationID != ch {
+			continue
+		}
+		res = append(res, s.ToProtobuf())
+	}
+
+	return res, nil
+}
+
+// CancelScheduledMessage cancels a pending scheduled message owned by the user
+func (c *ctrl) CancelScheduledMessage(ctx context.Context, userID, scheduledID string) error {
+	return c.ucs.CancelScheduledMessage(ctx, userID, scheduledID)
+}
+
+// PurgeExpiredMessages removes every expired message from the conversations
+func (c *ctrl) PurgeExpiredMessages(ctx context.Context) error {
+	_, err := c.ucs.PurgeExpiredMessages(ctx, time.Now())
+	return err
+}
+
+const (
+	// scheduledRetryDelay is the delay before retrying to send a scheduled message, multiplied by the failed attempts
+	scheduledRetryDelay = time.Minute
+	// maxScheduledAttempts is the number of attempts after which a scheduled message is dropped
+	maxScheduledAttempts = 5
+)
+
+// DispatchScheduledMessages sends every scheduled message which is due,
+// going through the same path of any other sent message.
+// Messages which fail to be sent are retried, and dropped after maxScheduledAttempts.
+// Messages which can never be sent, such as those to a declined message request
+// or from a sender suspended in the meantime, are dropped right away
+func (c *ctrl) DispatchScheduledMessages(ctx context.Context) error {
+	now := time.Now()
+	due, err := c.ucs.ClaimDueScheduledMessages(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range due {
+		msg := *s.Message
+		msg.CreatedAt = now
+		if s.ExpiresAfter > 0 {
+			msg.ExpiresAt = msg.CreatedAt.Add(s.ExpiresAfter)
+		}
+		sendErr := c.sendScheduledMessage(ctx, s.ConversationID, &msg)
+		if sendErr == nil || isPermanentSendError(sendErr) || s.Attempts+1 >= maxScheduledAttempts {
+			if sendErr != nil {
+				logger.Log.Error("dropping scheduled message", zap.String("id", s.ID), zap.Error(sendErr))
+			}
+			if err := c.ucs.CompleteScheduledMessage(ctx, s); err != nil {
+				logger.Log.Error("failed to complete scheduled message", zap.String("id", s.ID), zap.Error(err))
+			}
+			continue
+		}
+
+		// Do not hold back the other messages
+		logger.Log.Error("failed to send scheduled message", zap.String("id", s.ID), zap.Error(sendErr))
+		retryAt := now.Add(time.Duration(s.Attempts+1) * scheduledRetryDelay)
+		if err := c.ucs.RetryScheduledMessage(ctx, s, retryAt); err != nil {
+			logger.Log.Error("failed to retry scheduled message", zap.String("id", s.ID), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// sendScheduledMessage sends the scheduled message, checking that its sender is still allowed to send messages
+func (c *ctrl) sendScheduledMessage(ctx context.Context, ch string, msg *message.Message) error {
+	if c.checkSender != nil {
+		if err := c.checkSender(ctx, msg.UserID); err != nil {
+			return err
+		}
+	}
+
+	return c.SendMessage(ctx, ch, msg)
+}
+
+// isPermanentSendError returns whether the error prevents the message from ever being sent, so that retrying is pointless
+func isPermanentSendError(err error) bool {
+	return errors.Is(err, chat.ErrMessageRequestPending) ||
+		errors.Is(err, chat.ErrMessageRequestDeclined) ||
+		errors.Is(err, chat.ErrSenderSuspended)
+}
+
+// RunWorkers starts the background sweeper for expired messages and the scheduled messages dispatcher.
+// Both run every `interval` until the context is done
+func (c *ctrl) RunWorkers(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := c.PurgeExpiredMessages(ctx); err != nil {
+					logger.Log.Error("failed to purge expired messages", zap.Error(err))
+				}
+				if err := c.DispatchScheduledMessages(ctx); err != nil {
+					logger.Log.Error("failed to dispatch scheduled messages", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
@@ -7,7 +7,10 @@ import (
 	"time"
 
 	v1Helpers "github.com/DagDigg/unpaper/backend/helpers"
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/chat"
 	"github.com/DagDigg/unpaper/backend/pkg/chat/controller"
+	"github.com/DagDigg/unpaper/backend/pkg/chat/conversation"
 	"github.com/DagDigg/unpaper/backend/pkg/chat/message"
 	"github.com/DagDigg/unpaper/backend/pkg/chat/usecase"
 	v1Testing "github.com/DagDigg/unpaper/backend/pkg/service/v1/testing"
@@ -19,7 +22,7 @@ func TestSubscribe(t *testing.T) {
 	cfg := v1Testing.InitConfig()
 	rdbURL := v1Helpers.StartRedisDB(t, cfg.GetRDBConnURL())
 	u := usecase.New(v1Helpers.GetRDBInstance(t, rdbURL))
-	c := controller.New(u)
+	c := controller.New(u, nil, nil)
 	assert := assert.New(t)
 
 	t.Run("When subscribing and sending a single message", func(t *testing.T) {
@@ -59,3 +62,157 @@ func TestSubscribe(t *testing.T) {
 		<-done
 	})
 }
+
+func TestMessageRequests(t *testing.T) {
+	cfg := v1Testing.InitConfig()
+	rdbURL := v1Helpers.StartRedisDB(t, cfg.GetRDBConnURL())
+	u := usecase.New(v1Helpers.GetRDBInstance(t, rdbURL))
+	c := controller.New(u, nil, nil)
+	assert := assert.New(t)
+
+	newRequest := func(ctx context.Context) (*conversation.Conversation, string, string) {
+		sender := &v1API.User{Id: uuid.NewString(), Username: "sender"}
+		receiver := &v1API.User{Id: uuid.NewString(), Username: "receiver"}
+		conv := conversation.New(sender, receiver)
+		conv.RequestStatus = conversation.RequestStatusPending
+		conv.RequestedBy = sender.Id
+		assert.Nil(c.CreateConversation(ctx, conv))
+		return conv, sender.Id, receiver.Id
+	}
+	newMsg := func(userID string) *message.Message {
+		return &message.Message{
+			ID:        uuid.NewString(),
+			Type:      message.TypeText,
+			UserID:    userID,
+			CreatedAt: time.Now(),
+			Text:      message.Text{Content: "hi"},
+		}
+	}
+
+	t.Run("When the sender sends more than one message before acceptance", func(t *testing.T) {
+		ctx := context.Background()
+		conv, senderID, receiverID := newRequest(ctx)
+
+		assert.Nil(c.SendMessage(ctx, conv.ID, newMsg(senderID)))
+		assert.Equal(chat.ErrMessageRequestPending, c.SendMessage(ctx, conv.ID, newMsg(senderID)))
+
+		// The request is in the receiver requests only
+		requests, err := c.GetMessageRequests(ctx, receiverID)
+		assert.Nil(err)
+		assert.Len(requests, 1)
+		convs, err := c.GetConversations(ctx, receiverID)
+		assert.Nil(err)
+		assert.Len(convs, 0)
+		convs, err = c.GetConversations(ctx, senderID)
+		assert.Nil(err)
+		assert.Len(convs, 1)
+	})
+
+	t.Run("When the request message has expired", func(t *testing.T) {
+		ctx := context.Background()
+		conv, senderID, _ := newRequest(ctx)
+
+		msg := newMsg(senderID)
+		msg.ExpiresAt = time.Now().Add(time.Millisecond)
+		assert.Nil(c.SendMessage(ctx, conv.ID, msg))
+		_, err := u.PurgeExpiredMessages(ctx, time.Now().Add(time.Second))
+		assert.Nil(err)
+
+		// The request message is gone, but the sender cannot send another one
+		assert.Equal(chat.ErrMessageRequestPending, c.SendMessage(ctx, conv.ID, newMsg(senderID)))
+		res, err := u.GetConversation(ctx, senderID, conv.ID)
+		assert.Nil(err)
+		assert.True(res.RequestMessageSent)
+		assert.Nil(res.LastMessage)
+	})
+
+	t.Run("When the receiver accepts the request", func(t *testing.T) {
+		ctx := context.Background()
+		conv, senderID, receiverID := newRequest(ctx)
+
+		// Only the receiver can respond
+		_, err := c.RespondToMessageRequest(ctx, senderID, conv.ID, true)
+		assert.Equal(chat.ErrMessageRequestNotFound, err)
+
+		res, err := c.RespondToMessageRequest(ctx, receiverID, conv.ID, true)
+		assert.Nil(err)
+		assert.Equal(v1API.MessageRequestStatus_ACCEPTED, res.RequestStatus)
+
+		assert.Nil(c.SendMessage(ctx, conv.ID, newMsg(senderID)))
+		assert.Nil(c.SendMessage(ctx, conv.ID, newMsg(senderID)))
+	})
+
+	t.Run("When the receiver declines the request", func(t *testing.T) {
+		ctx := context.Background()
+		conv, senderID, receiverID := newRequest(ctx)
+
+		_, err := c.RespondToMessageRequest(ctx, receiverID, conv.ID, false)
+		assert.Nil(err)
+		assert.Equal(chat.ErrMessageRequestDeclined, c.SendMessage(ctx, conv.ID, newMsg(senderID)))
+
+		requests, err := c.GetMessageRequests(ctx, receiverID)
+		assert.Nil(err)
+		assert.Len(requests, 0)
+	})
+
+	t.Run("When a scheduled message targets a declined request", func(t *testing.T) {
+		ctx := context.Background()
+		conv, senderID, receiverID := newRequest(ctx)
+
+		_, err := c.RespondToMessageRequest(ctx, receiverID, conv.ID, false)
+		assert.Nil(err)
+		assert.Nil(c.ScheduleMessage(ctx, &message.Scheduled{
+			ID:             uuid.NewString(),
+			ConversationID: conv.ID,
+			SendAt:         time.Now().Add(-time.Second),
+			Message:        newMsg(senderID),
+		}))
+
+		// The message can never be sent, so it is dropped instead of being retried
+		assert.Nil(c.DispatchScheduledMessages(ctx))
+		scheduled, err := c.GetScheduledMessages(ctx, senderID, conv.ID)
+		assert.Nil(err)
+		assert.Len(scheduled, 0)
+	})
+}
+
+func TestDispatchScheduledMessages(t *testing.T) {
+	cfg := v1Testing.InitConfig()
+	rdbURL := v1Helpers.StartRedisDB(t, cfg.GetRDBConnURL())
+	u := usecase.New(v1Helpers.GetRDBInstance(t, rdbURL))
+	suspendedID := uuid.NewString()
+	c := controller.New(u, nil, func(ctx context.Context, userID string) error {
+		if userID == suspendedID {
+			return chat.ErrSenderSuspended
+		}
+		return nil
+	})
+	assert := assert.New(t)
+
+	t.Run("When the sender of a scheduled message has been suspended", func(t *testing.T) {
+		ctx := context.Background()
+		conv := conversation.New(&v1API.User{Id: suspendedID, Username: "sender"}, &v1API.User{Id: uuid.NewString(), Username: "receiver"})
+		assert.Nil(c.CreateConversation(ctx, conv))
+		assert.Nil(c.ScheduleMessage(ctx, &message.Scheduled{
+			ID:             uuid.NewString(),
+			ConversationID: conv.ID,
+			SendAt:         time.Now().Add(-time.Second),
+			Message: &message.Message{
+				ID:        uuid.NewString(),
+				Type:      message.TypeText,
+				UserID:    suspendedID,
+				CreatedAt: time.Now(),
+				Text:      message.Text{Content: "hi"},
+			},
+		}))
+
+		// The message is dropped without being sent
+		assert.Nil(c.DispatchScheduledMessages(ctx))
+		scheduled, err := c.GetScheduledMessages(ctx, suspendedID, conv.ID)
+		assert.Nil(err)
+		assert.Len(scheduled, 0)
+		res, err := c.GetMessages(ctx, suspendedID, conv.ID, 0)
+		assert.Nil(err)
+		assert.Len(res.Messages, 0)
+	})
+}
@@ -4,11 +4,13 @@ import (
 	"bytes"
 	"encoding/base64"
 	"encoding/gob"
+	"strings"
 	"time"
 
 	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
 	"github.com/DagDigg/unpaper/backend/pkg/chat/message"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -25,8 +27,36 @@ type Conversation struct {
 	CreatedAt           time.Time
 	UnreadMessagesCount int64
 	LastMessage         *message.Message
+	// Retention is the default expiration applied to the messages
+	// sent in the conversation. Zero means messages are kept forever
+	Retention time.Duration
+	// Muted is set on the participant's own copy of the conversation.
+	// Muted conversations do not notify new messages
+	Muted bool
+	// RequestStatus is set when the conversation has been
+	// started as a message request by `RequestedBy`
+	RequestStatus RequestStatus
+	RequestedBy   string
+	// RequestMessageSent is set once the requester has sent the single
+	// message allowed while the request is pending. It is kept even if
+	// that message expires or is deleted
+	RequestMessageSent bool
 }
 
+// RequestStatus denotes the message request state of a conversation
+type RequestStatus string
+
+const (
+	// RequestStatusNone is for conversations which are not message requests
+	RequestStatusNone = RequestStatus("")
+	// RequestStatusPending is a message request waiting for the recipient response
+	RequestStatusPending = RequestStatus("PENDING")
+	// RequestStatusAccepted is a message request accepted by the recipient
+	RequestStatusAccepted = RequestStatus("ACCEPTED")
+	// RequestStatusDeclined is a message request declined by the recipient
+	RequestStatusDeclined = RequestStatus("DECLINED")
+)
+
 // Participant of a conversation
 type Participant struct {
 	UserID   string
@@ -55,6 +85,20 @@ func New(participants ...*v1API.User) *Conversation {
 	}
 }
 
+// ToProtobuf converts the request status to its proto counterpart
+func (s RequestStatus) ToProtobuf() v1API.MessageRequestStatus_Enum {
+	switch s {
+	case RequestStatusPending:
+		return v1API.MessageRequestStatus_PENDING
+	case RequestStatusAccepted:
+		return v1API.MessageRequestStatus_ACCEPTED
+	case RequestStatusDeclined:
+		return v1API.MessageRequestStatus_DECLINED
+	default:
+		return v1API.MessageRequestStatus_NONE
+	}
+}
+
 // ToProtobuf converts a conversation to proto data structure
 func (c *Conversation) ToProtobuf() *v1API.Conversation {
 	conv := &v1API.Conversation{
@@ -62,10 +106,17 @@ func (c *Conversation) ToProtobuf() *v1API.Conversation {
 		Participants:        participantsMapToProtobuf(c.Participants),
 		CreatedAt:           timestamppb.New(c.CreatedAt),
 		UnreadMessagesCount: c.UnreadMessagesCount,
+		Muted:               c.Muted,
+		RequestStatus:       c.RequestStatus.ToProtobuf(),
+		RequestedBy:         c.RequestedBy,
+		RequestMessageSent:  c.RequestMessageSent,
 	}
 	if c.LastMessage != nil {
 		conv.LastMessage = c.LastMessage.ToProtobuf()
 	}
+	if c.Retention > 0 {
+		conv.Retention = durationpb.New(c.Retention)
+	}
 
 	return conv
 }
@@ -90,9 +141,13 @@ func participantsMapToProtobuf(p map[string]Participant) map[string]*v1API.Conve
 
 // EncodeBinary returns the base64 encoded form of the conversation
 func (c *Conversation) EncodeBinary() (string, error) {
+	// The last message is read from the conversation messages, so that purged messages are never kept around
+	stored := *c
+	stored.LastMessage = nil
+
 	b := &bytes.Buffer{}
 	e := gob.NewEncoder(b)
-	if err := e.Encode(c); err != nil {
+	if err := e.Encode(&stored); err != nil {
 		return "", err
 	}
 
@@ -149,3 +204,40 @@ func GetUserConversationsLastReadKey(userID string) string {
 func GetActiveUserConversationIDKey(userID string) string {
 	return "conversations:active" + userID
 }
+
+// GetExpiringMessagesKey returns the key of the sorted set indexing every message having an expiration.
+// Members are scored by expiration unix time and are created with `ExpiringMessageMember`
+func GetExpiringMessagesKey() string {
+	return "conversations:messages:expiring"
+}
+
+// ExpiringMessageMember returns the member stored in the expiring messages sorted set,
+// composed by the conversation ID and the encoded message
+func ExpiringMessageMember(conversationID, encodedMsg string) string {
+	return conversationID + ":" + encodedMsg
+}
+
+// ParseExpiringMessageMember splits an expiring messages sorted set member
+// into the conversation ID and the encoded message
+func ParseExpiringMessageMember(member string) (conversationID, encodedMsg string, ok bool) {
+	i := strings.Index(member, ":")
+	if i < 0 {
+		return "", "", false
+	}
+	return member[:i], member[i+1:], true
+}
+
+// GetScheduledMessagesKey returns the key of the hashmap storing encoded scheduled messages by their ID
+func GetScheduledMessagesKey() string {
+	return "conversations:messages:scheduled"
+}
+
+// GetScheduledMessagesQueueKey returns the key of the sorted set of scheduled message IDs, scored by send time
+func GetScheduledMessagesQueueKey() string {
+	return "conversations:messages:scheduled:queue"
+}
+
+// GetUserScheduledMessagesKey returns the key of the set containing the user's scheduled message IDs
+func GetUserScheduledMessagesKey(userID string) string {
+	return "user:" + userID + ":scheduled_messages"
+}
@@ -0,0 +1,6 @@
+package message
+
+// Deletion message, published when a message is removed from a conversation
+type Deletion struct {
+	MessageID string
+}
@@ -0,0 +1,34 @@
+package message
+
+import (
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+)
+
+// Mention of a conversation participant within the message text.
+// Start and End are code point offsets of the `@username` token
+type Mention struct {
+	UserID   string
+	Username string
+	Start    int32
+	End      int32
+}
+
+// NewMentionFromProtobuf converts a proto mention to `Mention`
+func NewMentionFromProtobuf(m *v1API.Mention) Mention {
+	return Mention{
+		UserID:   m.UserId,
+		Username: m.Username,
+		Start:    m.Start,
+		End:      m.End,
+	}
+}
+
+// ToProtobuf converts `Mention` to its proto counterpart
+func (m Mention) ToProtobuf() *v1API.Mention {
+	return &v1API.Mention{
+		UserId:   m.UserID,
+		Username: m.Username,
+		Start:    m.Start,
+		End:      m.End,
+	}
+}
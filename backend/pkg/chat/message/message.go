@@ -19,12 +19,18 @@ type Message struct {
 	UserID         string
 	CreatedAt      time.Time
 	SenderUsername string
+	// ExpiresAt is the time after which the message gets purged.
+	// Zero means the message never expires
+	ExpiresAt time.Time
+	// Mentions of conversation participants within the text content
+	Mentions []Mention
 
 	// Embedded message types
 	Award
 	Text
 	Donation
 	Audio
+	Deletion
 }
 
 // MsgType denotes the type of message
@@ -39,6 +45,8 @@ const (
 	TypeDonation = MsgType("DONATION")
 	// TypeAudio is an audio message type
 	TypeAudio = MsgType("AUDIO")
+	// TypeDeletion is a deletion event message type
+	TypeDeletion = MsgType("DELETION")
 )
 
 // ToProtobuf converts `Message` to its proto counterpart
@@ -66,10 +74,25 @@ func (m *Message) ToProtobuf() *v1API.ChatMessage {
 		res.Type = v1API.MessageType_AUDIO
 		res.Audio = &v1API.MessageAudio{Bytes: m.Bytes}
 	}
+	if m.Deletion.MessageID != "" {
+		res.Type = v1API.MessageType_DELETION
+		res.Deletion = &v1API.MessageDeletion{MessageId: m.Deletion.MessageID}
+	}
+	if !m.ExpiresAt.IsZero() {
+		res.ExpiresAt = timestamppb.New(m.ExpiresAt)
+	}
+	for _, mention := range m.Mentions {
+		res.Mentions = append(res.Mentions, mention.ToProtobuf())
+	}
 
 	return res
 }
 
+// IsExpired returns whether the message has an expiration and it is due at `now`
+func (m *Message) IsExpired(now time.Time) bool {
+	return !m.ExpiresAt.IsZero() && !m.ExpiresAt.After(now)
+}
+
 // EncodeBinary encodes the *Message as base64 string
 func (m *Message) EncodeBinary() (string, error) {
 	b := bytes.Buffer{}
@@ -0,0 +1,71 @@
+package message
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/gob"
+	"time"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Scheduled is a message held server-side until `SendAt`,
+// when it is sent to the conversation as a regular message
+type Scheduled struct {
+	ID             string
+	ConversationID string
+	SendAt         time.Time
+	// ExpiresAfter is applied to the message when it gets sent.
+	// Zero means the message does not expire
+	ExpiresAfter time.Duration
+	Message      *Message
+	// Attempts is the number of failed attempts to send the message
+	Attempts int
+}
+
+// ToProtobuf converts `Scheduled` to its proto counterpart
+func (s *Scheduled) ToProtobuf() *v1API.ScheduledMessage {
+	res := &v1API.ScheduledMessage{
+		Id:      s.ID,
+		Channel: s.ConversationID,
+		SendAt:  timestamppb.New(s.SendAt),
+	}
+	if s.ExpiresAfter > 0 {
+		res.ExpiresAfter = durationpb.New(s.ExpiresAfter)
+	}
+	if s.Message != nil {
+		res.Message = s.Message.ToProtobuf()
+	}
+
+	return res
+}
+
+// EncodeBinary encodes the *Scheduled message as base64 string
+func (s *Scheduled) EncodeBinary() (string, error) {
+	b := bytes.Buffer{}
+	e := gob.NewEncoder(&b)
+	if err := e.Encode(s); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(b.Bytes()), nil
+}
+
+// DecodeBinary decodes into *Scheduled the raw base64 *Scheduled string value
+func (s *Scheduled) DecodeBinary(str string) error {
+	p, err := base64.StdEncoding.DecodeString(str)
+	if err != nil {
+		return err
+	}
+
+	b := bytes.Buffer{}
+	_, err = b.Write(p)
+	if err != nil {
+		return err
+	}
+
+	decoder := gob.NewDecoder(&b)
+	return decoder.Decode(s)
+}
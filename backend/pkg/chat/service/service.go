@@ -4,6 +4,7 @@ import (
 	"github.com/DagDigg/unpaper/backend/pkg/chat"
 	"github.com/DagDigg/unpaper/backend/pkg/chat/controller"
 	"github.com/DagDigg/unpaper/backend/pkg/chat/usecase"
+	"github.com/DagDigg/unpaper/backend/pkg/notifications"
 	"github.com/go-redis/redis/v8"
 )
 
@@ -11,9 +12,9 @@ type Chat struct {
 	controller chat.Controller
 }
 
-func New(rdb *redis.Client) chat.Controller {
+func New(rdb *redis.Client, nm notifications.SendListenReceiver, checkSender chat.SenderCheck) chat.Controller {
 	ucs := usecase.New(rdb)
-	ctrl := controller.New(ucs)
+	ctrl := controller.New(ucs, nm, checkSender)
 
 	return ctrl
 }
@@ -2,13 +2,40 @@ package chat
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/chat/conversation"
+	"github.com/DagDigg/unpaper/backend/pkg/chat/message"
 )
 
+// ErrScheduledMessageNotFound is returned when a scheduled message does not exist,
+// has already been sent, or does not belong to the user
+var ErrScheduledMessageNotFound = errors.New("scheduled message not found")
+
+// ErrMessageNotFound is returned when a message does not exist or is not visible to the user
+var ErrMessageNotFound = errors.New("message not found")
+
+// ErrMessageRequestPending is returned when the sender of a message request
+// tries to send more messages before the recipient has accepted it
+var ErrMessageRequestPending = errors.New("message request has not been accepted yet")
+
+// ErrMessageRequestDeclined is returned when sending a message to a declined message request
+var ErrMessageRequestDeclined = errors.New("message request has been declined")
+
+// ErrSenderSuspended is returned when the sender of a message has been suspended
+var ErrSenderSuspended = errors.New("sender has been suspended")
+
+// ErrMessageRequestNotFound is returned when responding to a conversation which is not a pending request for the user
+var ErrMessageRequestNotFound = errors.New("message request not found")
+
 // Usecase for chat package
 type Usecase interface {
 	GetMessages(ctx context.Context, userID, ch string, offset int64) ([]*v1API.ChatMessage, error)
+	GetAllMessages(ctx context.Context, userID, ch string) ([]*message.Message, error)
+	GetMessage(ctx context.Context, userID, ch, messageID string) (*message.Message, error)
+	DeleteMessage(ctx context.Context, ch, messageID string) error
 	Subscribe(ctx context.Context, ch string) <-chan Message
 	SendMessage(ctx context.Context, ch string, msg Message) error
 	CreateConversation(ctx context.Context, conversation Conversation) error
@@ -20,4 +47,17 @@ type Usecase interface {
 	ReadConversationMessages(ctx context.Context, userID, conversationID string) (*v1API.Conversation, error)
 	GetConversationInactiveUsers(ctx context.Context, senderUserID, conversationID string) ([]string, error)
 	IncrementInactiveUserMsgsCount(ctx context.Context, userID, conversationID string) error
+	GetConversationRetention(ctx context.Context, userID, conversationID string) (time.Duration, error)
+	SetConversationRetention(ctx context.Context, userID, conversationID string, retention time.Duration) (*v1API.Conversation, error)
+	IsConversationMuted(ctx context.Context, userID, conversationID string) (bool, error)
+	SetConversationMuted(ctx context.Context, userID, conversationID string, muted bool) (*v1API.Conversation, error)
+	SetConversationRequestStatus(ctx context.Context, userID, conversationID string, status conversation.RequestStatus) (*v1API.Conversation, error)
+	SetConversationRequestMessageSent(ctx context.Context, userID, conversationID string) (*v1API.Conversation, error)
+	PurgeExpiredMessages(ctx context.Context, now time.Time) (int, error)
+	ScheduleMessage(ctx context.Context, scheduled *message.Scheduled) error
+	GetScheduledMessages(ctx context.Context, userID string) ([]*message.Scheduled, error)
+	CancelScheduledMessage(ctx context.Context, userID, scheduledID string) error
+	ClaimDueScheduledMessages(ctx context.Context, now time.Time) ([]*message.Scheduled, error)
+	CompleteScheduledMessage(ctx context.Context, scheduled *message.Scheduled) error
+	RetryScheduledMessage(ctx context.Context, scheduled *message.Scheduled, retryAt time.Time) error
 }
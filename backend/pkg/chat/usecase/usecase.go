@@ -3,7 +3,9 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
+	"time"
 
 	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
 	"github.com/DagDigg/unpaper/backend/pkg/chat"
@@ -86,6 +88,18 @@ func (u *ucs) SendMessage(ctx context.Context, conversationID string, msg chat.M
 	if err != nil {
 		return err
 	}
+
+	// Index the message expiration, so that it can be purged by the sweeper
+	if expiresAt := msg.GetRaw().ExpiresAt; !expiresAt.IsZero() {
+		err = u.rdb.ZAdd(ctx, conversation.GetExpiringMessagesKey(), &redis.Z{
+			Score:  float64(expiresAt.UTC().Unix()),
+			Member: conversation.ExpiringMessageMember(conversationID, val),
+		}).Err()
+		if err != nil {
+			return err
+		}
+	}
+
 	return u.rdb.Publish(ctx, pubsubK, val).Err()
 }
 
@@ -119,15 +133,102 @@ func (u *ucs) GetMessages(ctx context.Context, userID, conversationID string, of
 		return nil, res.Err()
 	}
 	// TODO: slice message limit
+	now := time.Now()
 	for _, v := range res.Val() {
 		m := &message.Message{}
 		_ = m.DecodeBinary(v)
+		if m.IsExpired(now) {
+			// Expired but not yet purged by the sweeper
+			continue
+		}
 		messages = append(messages, m.ToProtobuf())
 	}
 
 	return messages, nil
 }
 
+// GetAllMessages returns every non-expired message sent in the conversation since the user has joined it, oldest first
+func (u *ucs) GetAllMessages(ctx context.Context, userID, conversationID string) ([]*message.Message, error) {
+	unlock, _ := u.lock.RLock(conversationID)
+	defer unlock()
+
+	conv, err := u.getConversation(ctx, userID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	participant, ok := conv.Participants[userID]
+	if !ok {
+		return nil, fmt.Errorf("participant not found: %v", userID)
+	}
+
+	res, err := u.rdb.ZRangeByScore(ctx, conversation.GetConversationMessagesKey(conversationID), &redis.ZRangeBy{
+		Min: strconv.FormatInt(participant.JoinedAt.Unix(), 10),
+		Max: "+inf",
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	messages := []*message.Message{}
+	for _, v := range res {
+		m := &message.Message{}
+		if err := m.DecodeBinary(v); err != nil {
+			return nil, err
+		}
+		if m.IsExpired(now) {
+			continue
+		}
+		messages = append(messages, m)
+	}
+
+	return messages, nil
+}
+
+// GetMessage returns a message of the conversation, if the user can see it
+func (u *ucs) GetMessage(ctx context.Context, userID, conversationID, messageID string) (*message.Message, error) {
+	messages, err := u.GetAllMessages(ctx, userID, conversationID)
+	if err != nil {
+		if err == redis.Nil {
+			return nil, chat.ErrMessageNotFound
+		}
+		return nil, err
+	}
+
+	for _, m := range messages {
+		if m.ID == messageID {
+			return m, nil
+		}
+	}
+
+	return nil, chat.ErrMessageNotFound
+}
+
+// DeleteMessage removes a message from the conversation, publishing its deletion
+func (u *ucs) DeleteMessage(ctx context.Context, conversationID, messageID string) error {
+	vals, err := u.rdb.ZRange(ctx, conversation.GetConversationMessagesKey(conversationID), 0, -1).Result()
+	if err != nil {
+		return err
+	}
+
+	for _, v := range vals {
+		m := &message.Message{}
+		if err := m.DecodeBinary(v); err != nil {
+			return err
+		}
+		if m.ID != messageID {
+			continue
+		}
+		// The message is gone, the sweeper has nothing left to purge
+		if err := u.rdb.ZRem(ctx, conversation.GetExpiringMessagesKey(), conversation.ExpiringMessageMember(conversationID, v)).Err(); err != nil {
+			return err
+		}
+		return u.deleteMessage(ctx, conversationID, v)
+	}
+
+	return chat.ErrMessageNotFound
+}
+
 // CreateConversation stores a conversation into rdb
 func (u *ucs) CreateConversation(ctx context.Context, conv chat.Conversation) error {
 	c := conv.GetRaw()
@@ -167,25 +268,38 @@ func (u *ucs) GetConversation(ctx context.Context, userID, conversationID string
 		return nil, err
 	}
 
-	// Retrieve conversation last message
-	messages, err := u.rdb.ZRevRange(ctx, conversation.GetConversationMessagesKey(conversationID), 0, 0).Result()
+	conv.LastMessage, err = u.lastMessage(ctx, conversationID, time.Now())
 	if err != nil {
 		return nil, err
 	}
-	// Conversation has no messages
-	if len(messages) == 0 {
-		return conv.ToProtobuf(), nil
-	}
 
-	// Decode last message
-	m := &message.Message{}
-	if err := m.DecodeBinary(messages[0]); err != nil {
-		return nil, err
-	}
+	return conv.ToProtobuf(), nil
+}
 
-	conv.LastMessage = m
+// lastMessageLookup is the number of messages read at once when looking for the last message which has not expired
+const lastMessageLookup = 10
 
-	return conv.ToProtobuf(), nil
+// lastMessage returns the latest message of the conversation which has not expired at `now`,
+// skipping the ones waiting to be purged. Nil is returned when there are none
+func (u *ucs) lastMessage(ctx context.Context, conversationID string, now time.Time) (*message.Message, error) {
+	for start := int64(0); ; start += lastMessageLookup {
+		messages, err := u.rdb.ZRevRange(ctx, conversation.GetConversationMessagesKey(conversationID), start, start+lastMessageLookup-1).Result()
+		if err != nil {
+			return nil, err
+		}
+		for _, encoded := range messages {
+			m := &message.Message{}
+			if err := m.DecodeBinary(encoded); err != nil {
+				return nil, err
+			}
+			if m.ExpiresAt.IsZero() || m.ExpiresAt.After(now) {
+				return m, nil
+			}
+		}
+		if len(messages) < lastMessageLookup {
+			return nil, nil
+		}
+	}
 }
 
 func (u *ucs) ReadConversationMessages(ctx context.Context, userID, conversationID string) (*v1API.Conversation, error) {
@@ -274,23 +388,12 @@ func (u *ucs) decodeConversation(ctx context.Context, params *decodeConversation
 		}
 	}
 
-	// Retrieve conversation last message
-	messages, err := u.rdb.ZRevRange(ctx, conversation.GetConversationMessagesKey(conv.ID), 0, 0).Result()
+	lastMessage, err := u.lastMessage(ctx, conv.ID, time.Now())
 	if err != nil {
 		return nil, err
 	}
-	// Conversation has no messages
-	if len(messages) == 0 {
-		return conv, nil
-	}
-
-	// Decode last message
-	m := &message.Message{}
-	if err := m.DecodeBinary(messages[0]); err != nil {
-		return nil, err
-	}
 
-	conv.LastMessage = m
+	conv.LastMessage = lastMessage
 	return conv, nil
 }
 
@@ -378,6 +481,378 @@ func (u *ucs) IncrementInactiveUserMsgsCount(ctx context.Context, userID, conver
 	return nil
 }
 
+// GetConversationRetention returns the default messages retention for the conversation.
+// A conversation which cannot be found has no retention
+func (u *ucs) GetConversationRetention(ctx context.Context, userID, conversationID string) (time.Duration, error) {
+	conv, err := u.getConversation(ctx, userID, conversationID)
+	if err != nil {
+		if err == redis.Nil {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return conv.Retention, nil
+}
+
+// SetConversationRetention updates the default messages retention on every participant's copy of the conversation
+func (u *ucs) SetConversationRetention(ctx context.Context, userID, conversationID string, retention time.Duration) (*v1API.Conversation, error) {
+	return u.updateSharedConversation(ctx, userID, conversationID, func(c *conversation.Conversation) {
+		c.Retention = retention
+	})
+}
+
+// SetConversationRequestStatus updates the message request status on every participant's copy of the conversation
+func (u *ucs) SetConversationRequestStatus(ctx context.Context, userID, conversationID string, status conversation.RequestStatus) (*v1API.Conversation, error) {
+	return u.updateSharedConversation(ctx, userID, conversationID, func(c *conversation.Conversation) {
+		c.RequestStatus = status
+	})
+}
+
+// updateSharedConversation applies `update` to every participant's copy of the conversation,
+// returning the userID copy once updated
+func (u *ucs) updateSharedConversation(ctx context.Context, userID, conversationID string, update func(*conversation.Conversation)) (*v1API.Conversation, error) {
+	conv, err := u.getConversation(ctx, userID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res *conversation.Conversation
+	for participantID := range conv.Participants {
+		participantConv, err := u.getConversation(ctx, participantID, conversationID)
+		if err != nil {
+			if err == redis.Nil {
+				// Participant has no copy of the conversation
+				continue
+			}
+			return nil, err
+		}
+		update(participantConv)
+
+		b64Conv, err := participantConv.EncodeBinary()
+		if err != nil {
+			return nil, err
+		}
+		if err := u.rdb.HSet(ctx, conversation.GetUserConversationsKey(participantID), conversationID, b64Conv).Err(); err != nil {
+			return nil, err
+		}
+
+		if participantID == userID {
+			res = participantConv
+		}
+	}
+	if res == nil {
+		return nil, fmt.Errorf("participant not found: %v", userID)
+	}
+
+	return res.ToProtobuf(), nil
+}
+
+// SetConversationRequestMessageSent records on every participant's copy of the conversation
+// that the requester has sent the message allowed while the request is pending
+func (u *ucs) SetConversationRequestMessageSent(ctx context.Context, userID, conversationID string) (*v1API.Conversation, error) {
+	return u.updateSharedConversation(ctx, userID, conversationID, func(c *conversation.Conversation) {
+		c.RequestMessageSent = true
+	})
+}
+
+// IsConversationMuted returns whether the user has muted the conversation
+func (u *ucs) IsConversationMuted(ctx context.Context, userID, conversationID string) (bool, error) {
+	conv, err := u.getConversation(ctx, userID, conversationID)
+	if err != nil {
+		return false, err
+	}
+
+	return conv.Muted, nil
+}
+
+// SetConversationMuted mutes or unmutes the conversation for the user only
+func (u *ucs) SetConversationMuted(ctx context.Context, userID, conversationID string, muted bool) (*v1API.Conversation, error) {
+	conv, err := u.getConversation(ctx, userID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	conv.Muted = muted
+
+	b64Conv, err := conv.EncodeBinary()
+	if err != nil {
+		return nil, err
+	}
+	if err := u.rdb.HSet(ctx, conversation.GetUserConversationsKey(userID), conversationID, b64Conv).Err(); err != nil {
+		return nil, err
+	}
+
+	return conv.ToProtobuf(), nil
+}
+
+// PurgeExpiredMessages removes from the conversations every message expired at `now`,
+// publishing a deletion message for each of them. It returns the number of purged messages.
+// Each expiration is claimed by removing it from the index, so concurrent sweepers never purge twice
+func (u *ucs) PurgeExpiredMessages(ctx context.Context, now time.Time) (int, error) {
+	members, err := u.rdb.ZRangeByScore(ctx, conversation.GetExpiringMessagesKey(), &redis.ZRangeBy{
+		Min: "-inf",
+		Max: strconv.FormatInt(now.UTC().Unix(), 10),
+	}).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, member := range members {
+		removed, err := u.rdb.ZRem(ctx, conversation.GetExpiringMessagesKey(), member).Result()
+		if err != nil {
+			return purged, err
+		}
+		if removed == 0 {
+			// Already claimed by another sweeper
+			continue
+		}
+
+		conversationID, val, ok := conversation.ParseExpiringMessageMember(member)
+		if !ok {
+			continue
+		}
+		if err := u.deleteMessage(ctx, conversationID, val); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+
+	return purged, nil
+}
+
+// deleteMessage removes the encoded message from the conversation and publishes its deletion
+func (u *ucs) deleteMessage(ctx context.Context, conversationID, encodedMsg string) error {
+	msgK := conversation.GetConversationMessagesKey(conversationID)
+
+	unlock, err := u.lock.Lock(msgK)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := u.rdb.ZRem(ctx, msgK, encodedMsg).Err(); err != nil {
+		return err
+	}
+
+	m := &message.Message{}
+	if err := m.DecodeBinary(encodedMsg); err != nil {
+		return err
+	}
+	deletion := &message.Message{
+		ID:        m.ID,
+		Type:      message.TypeDeletion,
+		UserID:    m.UserID,
+		CreatedAt: time.Now(),
+		Deletion:  message.Deletion{MessageID: m.ID},
+	}
+	val, err := deletion.EncodeBinary()
+	if err != nil {
+		return err
+	}
+
+	return u.rdb.Publish(ctx, conversation.GetConversationPubSubKey(conversationID), val).Err()
+}
+
+// ScheduleMessage stores the message, holding it until its send time
+func (u *ucs) ScheduleMessage(ctx context.Context, scheduled *message.Scheduled) error {
+	val, err := scheduled.EncodeBinary()
+	if err != nil {
+		return err
+	}
+
+	if err := u.rdb.HSet(ctx, conversation.GetScheduledMessagesKey(), scheduled.ID, val).Err(); err != nil {
+		return err
+	}
+	if err := u.rdb.SAdd(ctx, conversation.GetUserScheduledMessagesKey(scheduled.Message.UserID), scheduled.ID).Err(); err != nil {
+		return err
+	}
+
+	return u.rdb.ZAdd(ctx, conversation.GetScheduledMessagesQueueKey(), &redis.Z{
+		Score:  float64(scheduled.SendAt.UTC().Unix()),
+		Member: scheduled.ID,
+	}).Err()
+}
+
+// GetScheduledMessages returns the user's pending scheduled messages, sorted by send time
+func (u *ucs) GetScheduledMessages(ctx context.Context, userID string) ([]*message.Scheduled, error) {
+	ids, err := u.rdb.SMembers(ctx, conversation.GetUserScheduledMessagesKey(userID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	res := []*message.Scheduled{}
+	if len(ids) == 0 {
+		return res, nil
+	}
+
+	vals, err := u.rdb.HMGet(ctx, conversation.GetScheduledMessagesKey(), ids...).Result()
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range vals {
+		str, ok := v.(string)
+		if !ok {
+			// Sent or cancelled in the meantime
+			continue
+		}
+		scheduled := &message.Scheduled{}
+		if err := scheduled.DecodeBinary(str); err != nil {
+			return nil, err
+		}
+		res = append(res, scheduled)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].SendAt.Before(res[j].SendAt)
+	})
+
+	return res, nil
+}
+
+// CancelScheduledMessage removes a pending scheduled message owned by the user
+func (u *ucs) CancelScheduledMessage(ctx context.Context, userID, scheduledID string) error {
+	scheduled, err := u.getScheduledMessage(ctx, scheduledID)
+	if err != nil {
+		return err
+	}
+	if scheduled.Message.UserID != userID {
+		return chat.ErrScheduledMessageNotFound
+	}
+
+	// Claim the message from the queue, so that it cannot be sent anymore
+	removed, err := u.rdb.ZRem(ctx, conversation.GetScheduledMessagesQueueKey(), scheduledID).Result()
+	if err != nil {
+		return err
+	}
+	if removed == 0 {
+		return chat.ErrScheduledMessageNotFound
+	}
+
+	return u.removeScheduledMessage(ctx, scheduled)
+}
+
+// scheduledClaimTTL is the time after which a claimed scheduled message which has been neither
+// completed nor retried, such as when the dispatcher crashes, is claimed again
+const scheduledClaimTTL = 5 * time.Minute
+
+// claimScheduledScript pushes the send time of the due messages forward by the claim TTL,
+// so that each message is claimed by one dispatcher at a time
+var claimScheduledScript = redis.NewScript(`
+local ids = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1])
+for _, id in ipairs(ids) do
+	redis.call('ZADD', KEYS[1], ARGV[2], id)
+end
+return ids
+`)
+
+// retryScheduledScript stores the updated message and its new send time,
+// unless it has been cancelled in the meantime
+var retryScheduledScript = redis.NewScript(`
+if redis.call('ZSCORE', KEYS[1], ARGV[1]) then
+	redis.call('HSET', KEYS[2], ARGV[1], ARGV[3])
+	redis.call('ZADD', KEYS[1], ARGV[2], ARGV[1])
+end
+return 0
+`)
+
+// ClaimDueScheduledMessages returns the scheduled messages whose send time is due at `now`, claiming them.
+// Claimed messages are kept until they are completed, and are claimed again after a while if they are not,
+// so that a message is never lost before being sent
+func (u *ucs) ClaimDueScheduledMessages(ctx context.Context, now time.Time) ([]*message.Scheduled, error) {
+	val, err := claimScheduledScript.Run(
+		ctx,
+		u.rdb,
+		[]string{conversation.GetScheduledMessagesQueueKey()},
+		now.UTC().Unix(),
+		now.Add(scheduledClaimTTL).UTC().Unix(),
+	).Result()
+	if err != nil && err != redis.Nil {
+		return nil, err
+	}
+	ids, _ := val.([]interface{})
+
+	res := []*message.Scheduled{}
+	for _, v := range ids {
+		id, ok := v.(string)
+		if !ok {
+			continue
+		}
+		scheduled, err := u.getScheduledMessage(ctx, id)
+		if err != nil {
+			if err == chat.ErrScheduledMessageNotFound {
+				// Stale queue entry
+				if err := u.rdb.ZRem(ctx, conversation.GetScheduledMessagesQueueKey(), id).Err(); err != nil {
+					return res, err
+				}
+				continue
+			}
+			return res, err
+		}
+		res = append(res, scheduled)
+	}
+
+	return res, nil
+}
+
+// CompleteScheduledMessage removes a claimed scheduled message once it has been sent
+func (u *ucs) CompleteScheduledMessage(ctx context.Context, scheduled *message.Scheduled) error {
+	if err := u.rdb.ZRem(ctx, conversation.GetScheduledMessagesQueueKey(), scheduled.ID).Err(); err != nil {
+		return err
+	}
+
+	return u.removeScheduledMessage(ctx, scheduled)
+}
+
+// RetryScheduledMessage requeues a claimed scheduled message which failed to be sent,
+// recording the failed attempt. Cancelled messages are not requeued
+func (u *ucs) RetryScheduledMessage(ctx context.Context, scheduled *message.Scheduled, retryAt time.Time) error {
+	scheduled.Attempts++
+	val, err := scheduled.EncodeBinary()
+	if err != nil {
+		return err
+	}
+
+	err = retryScheduledScript.Run(
+		ctx,
+		u.rdb,
+		[]string{conversation.GetScheduledMessagesQueueKey(), conversation.GetScheduledMessagesKey()},
+		scheduled.ID,
+		retryAt.UTC().Unix(),
+		val,
+	).Err()
+	if err != nil && err != redis.Nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *ucs) getScheduledMessage(ctx context.Context, scheduledID string) (*message.Scheduled, error) {
+	val, err := u.rdb.HGet(ctx, conversation.GetScheduledMessagesKey(), scheduledID).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, chat.ErrScheduledMessageNotFound
+		}
+		return nil, err
+	}
+
+	scheduled := &message.Scheduled{}
+	if err := scheduled.DecodeBinary(val); err != nil {
+		return nil, err
+	}
+
+	return scheduled, nil
+}
+
+func (u *ucs) removeScheduledMessage(ctx context.Context, scheduled *message.Scheduled) error {
+	if err := u.rdb.HDel(ctx, conversation.GetScheduledMessagesKey(), scheduled.ID).Err(); err != nil {
+		return err
+	}
+
+	return u.rdb.SRem(ctx, conversation.GetUserScheduledMessagesKey(scheduled.Message.UserID), scheduled.ID).Err()
+}
+
 // TODO: conversations stored in one place
 // Users have a list of joined conversations which is map[conversationID]{JoinedAt: time.Time, unreadMessagesCount: number}
 // TODO: reduce number of redis operations (sendMessage get conversation)
@@ -9,6 +9,7 @@ import (
 	v1Helpers "github.com/DagDigg/unpaper/backend/helpers"
 	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
 	"github.com/DagDigg/unpaper/backend/pkg/chat"
+	"github.com/DagDigg/unpaper/backend/pkg/chat/conversation"
 	"github.com/DagDigg/unpaper/backend/pkg/chat/message"
 	chatUsecase "github.com/DagDigg/unpaper/backend/pkg/chat/usecase"
 	v1Testing "github.com/DagDigg/unpaper/backend/pkg/service/v1/testing"
@@ -139,6 +140,208 @@ func TestGetMessages(t *testing.T) {
 	})
 }
 
+func TestPurgeExpiredMessages(t *testing.T) {
+	t.Parallel()
+	cfg := v1Testing.InitConfig()
+	c := initChatUseCase(t, cfg.GetRDBConnURL())
+	assert := assert.New(t)
+
+	t.Run("When purging expired messages", func(t *testing.T) {
+		ctx := context.Background()
+		ch := uuid.NewString()
+		now := time.Now()
+		msgs := []*message.Message{
+			{
+				ID:        "expired_msg",
+				Type:      message.TypeText,
+				UserID:    "bob",
+				CreatedAt: now.Add(-time.Hour),
+				ExpiresAt: now.Add(-time.Minute),
+				Text:      message.Text{Content: "gone"},
+			},
+			{
+				ID:        "expiring_msg",
+				Type:      message.TypeText,
+				UserID:    "bob",
+				CreatedAt: now,
+				ExpiresAt: now.Add(time.Hour),
+				Text:      message.Text{Content: "still here"},
+			},
+		}
+		for _, m := range msgs {
+			if err := c.SendMessage(ctx, ch, m); err != nil {
+				t.Errorf("error sending message: %v", err)
+			}
+		}
+
+		purged, err := c.PurgeExpiredMessages(ctx, now)
+		assert.Nil(err)
+		assert.Equal(1, purged)
+
+		// The same expiration cannot be purged twice
+		purged, err = c.PurgeExpiredMessages(ctx, now)
+		assert.Nil(err)
+		assert.Equal(0, purged)
+	})
+	t.Run("When the last message of a conversation expires", func(t *testing.T) {
+		ctx := context.Background()
+		user := &v1API.User{Id: uuid.NewString(), Username: "bob"}
+		conv := conversation.New(user)
+		assert.Nil(c.CreateConversation(ctx, conv))
+		now := time.Now()
+		kept := &message.Message{
+			ID:        "kept_msg",
+			Type:      message.TypeText,
+			UserID:    user.Id,
+			CreatedAt: now.Add(-time.Hour),
+			Text:      message.Text{Content: "kept"},
+		}
+		expired := &message.Message{
+			ID:        "expired_msg",
+			Type:      message.TypeText,
+			UserID:    user.Id,
+			CreatedAt: now.Add(-time.Minute),
+			ExpiresAt: now.Add(-time.Second),
+			Text:      message.Text{Content: "gone"},
+		}
+		assert.Nil(c.SendMessage(ctx, conv.ID, kept))
+		assert.Nil(c.SendMessage(ctx, conv.ID, expired))
+
+		// Expired messages are not shown while waiting to be purged, nor after
+		res, err := c.GetConversation(ctx, user.Id, conv.ID)
+		assert.Nil(err)
+		assert.Equal(kept.ID, res.LastMessage.Id)
+		_, err = c.PurgeExpiredMessages(ctx, now)
+		assert.Nil(err)
+		res, err = c.GetConversation(ctx, user.Id, conv.ID)
+		assert.Nil(err)
+		assert.Equal(kept.ID, res.LastMessage.Id)
+	})
+}
+
+func TestScheduledMessages(t *testing.T) {
+	t.Parallel()
+	cfg := v1Testing.InitConfig()
+	c := initChatUseCase(t, cfg.GetRDBConnURL())
+	assert := assert.New(t)
+
+	newScheduled := func(userID string, sendAt time.Time) *message.Scheduled {
+		return &message.Scheduled{
+			ID:             uuid.NewString(),
+			ConversationID: uuid.NewString(),
+			SendAt:         sendAt,
+			Message: &message.Message{
+				ID:     uuid.NewString(),
+				Type:   message.TypeText,
+				UserID: userID,
+				Text:   message.Text{Content: "later"},
+			},
+		}
+	}
+
+	t.Run("When scheduling and listing messages", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.NewString()
+		later := newScheduled(userID, time.Now().Add(2*time.Hour))
+		sooner := newScheduled(userID, time.Now().Add(time.Hour))
+		assert.Nil(c.ScheduleMessage(ctx, later))
+		assert.Nil(c.ScheduleMessage(ctx, sooner))
+
+		res, err := c.GetScheduledMessages(ctx, userID)
+		assert.Nil(err)
+		assert.Len(res, 2)
+		assert.Equal(sooner.ID, res[0].ID)
+		assert.Equal(later.ID, res[1].ID)
+	})
+
+	t.Run("When cancelling a scheduled message", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.NewString()
+		s := newScheduled(userID, time.Now().Add(time.Hour))
+		assert.Nil(c.ScheduleMessage(ctx, s))
+
+		// Only the owner can cancel it
+		assert.Equal(chat.ErrScheduledMessageNotFound, c.CancelScheduledMessage(ctx, uuid.NewString(), s.ID))
+		assert.Nil(c.CancelScheduledMessage(ctx, userID, s.ID))
+		assert.Equal(chat.ErrScheduledMessageNotFound, c.CancelScheduledMessage(ctx, userID, s.ID))
+
+		res, err := c.GetScheduledMessages(ctx, userID)
+		assert.Nil(err)
+		assert.Len(res, 0)
+	})
+
+	t.Run("When claiming due scheduled messages", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.NewString()
+		sendAt := time.Now().Add(time.Hour)
+		s := newScheduled(userID, sendAt)
+		assert.Nil(c.ScheduleMessage(ctx, s))
+
+		due, err := c.ClaimDueScheduledMessages(ctx, sendAt.Add(-time.Minute))
+		assert.Nil(err)
+		assert.False(containsScheduled(due, s.ID))
+
+		due, err = c.ClaimDueScheduledMessages(ctx, sendAt.Add(time.Minute))
+		assert.Nil(err)
+		assert.True(containsScheduled(due, s.ID))
+
+		// Claimed messages are not claimed twice, and are kept until completed
+		due, err = c.ClaimDueScheduledMessages(ctx, sendAt.Add(time.Minute))
+		assert.Nil(err)
+		assert.False(containsScheduled(due, s.ID))
+		res, err := c.GetScheduledMessages(ctx, userID)
+		assert.Nil(err)
+		assert.Len(res, 1)
+
+		assert.Nil(c.CompleteScheduledMessage(ctx, s))
+		res, err = c.GetScheduledMessages(ctx, userID)
+		assert.Nil(err)
+		assert.Len(res, 0)
+	})
+
+	t.Run("When retrying a scheduled message which failed to be sent", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.NewString()
+		sendAt := time.Now().Add(time.Hour)
+		s := newScheduled(userID, sendAt)
+		assert.Nil(c.ScheduleMessage(ctx, s))
+
+		due, err := c.ClaimDueScheduledMessages(ctx, sendAt)
+		assert.Nil(err)
+		assert.True(containsScheduled(due, s.ID))
+		retryAt := sendAt.Add(time.Minute)
+		assert.Nil(c.RetryScheduledMessage(ctx, s, retryAt))
+
+		due, err = c.ClaimDueScheduledMessages(ctx, retryAt)
+		assert.Nil(err)
+		assert.True(containsScheduled(due, s.ID))
+		for _, d := range due {
+			if d.ID == s.ID {
+				assert.Equal(1, d.Attempts)
+			}
+		}
+
+		// Cancelled messages are not requeued
+		assert.Nil(c.CancelScheduledMessage(ctx, userID, s.ID))
+		assert.Nil(c.RetryScheduledMessage(ctx, s, retryAt))
+		due, err = c.ClaimDueScheduledMessages(ctx, retryAt.Add(time.Hour))
+		assert.Nil(err)
+		assert.False(containsScheduled(due, s.ID))
+		res, err := c.GetScheduledMessages(ctx, userID)
+		assert.Nil(err)
+		assert.Len(res, 0)
+	})
+}
+
+func containsScheduled(scheduled []*message.Scheduled, id string) bool {
+	for _, s := range scheduled {
+		if s.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
 func createUUIDSlice(size int) []string {
 	res := []string{}
 	for i := 0; i < size; i++ {
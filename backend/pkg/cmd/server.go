@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net"
+	"time"
 
 	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
 	"github.com/DagDigg/unpaper/backend/pkg/logger"
@@ -73,6 +74,11 @@ func RunServer() error {
 	v1API.RegisterUnpaperServiceServer(gServer.Srv, v1Server)
 	reflection.Register(gServer.Srv)
 
+	// Purge expired chat messages and dispatch the scheduled ones
+	v1Server.GetChat().RunWorkers(ctx, time.Second)
+	// Publish the scheduled posts
+	v1Server.RunWorkers(ctx, 10*time.Second)
+
 	// Serve gRPC server
 	go func() {
 		logger.Log.Info("starting grpc server on: " + cfg.APIPort)
@@ -59,6 +59,31 @@ func AudioRawJSONToPB(audio json.RawMessage) (*v1API.Audio, error) {
 	return a, nil
 }
 
+// NewMentionsRawJSON converts a list of API Mention to json.RawMessage
+func NewMentionsRawJSON(m []*v1API.Mention) (json.RawMessage, error) {
+	if m == nil {
+		m = []*v1API.Mention{}
+	}
+	bytes, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(bytes), nil
+}
+
+// MentionsRawJSONToPB unmarshal a mentions json.RawMessage into a list of protobuf *Mention
+func MentionsRawJSONToPB(mentions json.RawMessage) ([]*v1API.Mention, error) {
+	res := []*v1API.Mention{}
+	if len(mentions) == 0 {
+		return res, nil
+	}
+	if err := json.Unmarshal(mentions, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func NewMixRawJSON(m *v1API.Mix) ([]byte, error) {
 	return json.Marshal(m)
 }
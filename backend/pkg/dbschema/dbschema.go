@@ -0,0 +1,34 @@
+// Package dbschema applies the database objects which cannot be declared as SchemaHero tables,
+// such as extensions, SQL functions and expression indexes. They are listed in schema.sql
+package dbschema
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+)
+
+// lockID is the key of the advisory lock held while applying the schema,
+// so that replicas starting together do not replace the same functions concurrently
+const lockID = 7204312
+
+//go:embed schema.sql
+var schema string
+
+// Ensure applies schema.sql to the database. Every statement is idempotent, so it runs on each server start
+func Ensure(ctx context.Context, db *sql.DB) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", lockID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, schema); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
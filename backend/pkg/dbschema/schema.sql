@@ -0,0 +1,51 @@
+-- Database objects which the SchemaHero tables in artifacts/db/tables cannot declare:
+-- extensions, SQL functions and expression indexes.
+-- The server applies this file on start, so every statement must be idempotent. Functions are replaced
+-- in place, so changing one only needs a deploy. Since they refer to the tables, SchemaHero must have
+-- applied the tables changes first: until then the server fails to start, and is restarted.
+-- On large tables, a new index can be built beforehand with CREATE INDEX CONCURRENTLY under the same
+-- name, so that the server start does not hold a lock while building it.
+
+create extension if not exists pg_trgm;
+
+-- Full text and fuzzy search
+create index if not exists "posts_message_fts_idx" on "posts" using gin (to_tsvector('simple', "message"));
+create index if not exists "comments_message_fts_idx" on "comments" using gin (to_tsvector('simple', "message"));
+create index if not exists "users_username_trgm_idx" on "users" using gin ("username" gin_trgm_ops);
+create index if not exists "users_full_name_trgm_idx" on "users" using gin ((coalesce("given_name", '') || ' ' || coalesce("family_name", '')) gin_trgm_ops);
+
+-- can_view_post returns whether the post is published and its visibility lets the viewer read it
+create or replace function can_view_post(viewer_id character varying, p posts) returns boolean
+language sql stable as $$
+	select p.status = 'published' and (
+		p.visibility = 'public' or p.author = viewer_id or
+		(p.visibility = 'followers' and exists (
+			select 1 from follows vf
+			where vf.follower_user_id = viewer_id and vf.following_user_id = p.author and
+			(vf.follow_date > vf.unfollow_date or vf.unfollow_date is null)
+		)) or
+		(p.visibility = 'lists' and exists (
+			select 1 from lists vl
+			where vl.id = any(p.visibility_list_ids) and vl.owner_user_id = p.author and
+			(vl.allowed_users::jsonb -> viewer_id) is not null
+		))
+	)
+$$;
+
+-- can_access_post returns whether the viewer can read the full content of a supporters only post
+create or replace function can_access_post(viewer_id character varying, p posts) returns boolean
+language sql stable as $$
+	select p.supporters_room_id is null or p.author = viewer_id or exists (
+		select 1 from room_subscriptions rs
+		join connected_accounts ca on ca.account_id = rs.account_id
+		where rs.user_id = viewer_id and rs.room_id = p.supporters_room_id and ca.user_id = p.author and
+		rs.status in ('active', 'trialing')
+	)
+$$;
+
+-- post_preview returns the part of a locked post message shown to non supporters.
+-- It must match posts.PreviewLength
+create or replace function post_preview(message character varying) returns text
+language sql immutable as $$
+	select left(btrim(split_part(message, E'\n', 1), E' \t\r\f\v'), 24)
+$$;
@@ -0,0 +1,63 @@
+// Package mentions parses `@username` tokens in user provided text
+package mentions
+
+import (
+	"unicode"
+)
+
+// MaxUsernameLength is the maximum length of a mentionable username
+const MaxUsernameLength = 24
+
+// Span is a parsed `@username` token. Start and End are offsets
+// in runes (code points) of the whole token, `@` included. End is exclusive
+type Span struct {
+	Username string
+	Start    int32
+	End      int32
+}
+
+// Parse returns every `@username` token found in text, in order of appearance.
+// A token must be at the beginning of the text or preceded by a character
+// which cannot belong to a username, so that emails are not matched.
+// Trailing dots and dashes are not considered part of the username
+func Parse(text string) []Span {
+	res := []Span{}
+	runes := []rune(text)
+
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '@' {
+			continue
+		}
+		if i > 0 && (isUsernameRune(runes[i-1]) || runes[i-1] == '@') {
+			continue
+		}
+
+		end := i + 1
+		for end < len(runes) && isUsernameRune(runes[end]) {
+			end++
+		}
+		// Do not consider sentence punctuation as part of the username
+		for end > i+1 && (runes[end-1] == '.' || runes[end-1] == '-') {
+			end--
+		}
+
+		username := runes[i+1 : end]
+		if len(username) == 0 || len(username) > MaxUsernameLength {
+			i = end - 1
+			continue
+		}
+
+		res = append(res, Span{
+			Username: string(username),
+			Start:    int32(i),
+			End:      int32(end),
+		})
+		i = end - 1
+	}
+
+	return res
+}
+
+func isUsernameRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.' || r == '-'
+}
@@ -0,0 +1,62 @@
+package mentions_test
+
+import (
+	"testing"
+
+	"github.com/DagDigg/unpaper/backend/pkg/mentions"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []mentions.Span
+	}{
+		{
+			name: "When text has no mentions",
+			text: "hello world",
+			want: []mentions.Span{},
+		},
+		{
+			name: "When text has multiple mentions",
+			text: "@alice and @bob_99 listen",
+			want: []mentions.Span{
+				{Username: "alice", Start: 0, End: 6},
+				{Username: "bob_99", Start: 11, End: 18},
+			},
+		},
+		{
+			name: "When a mention ends a sentence",
+			text: "thanks @alice.",
+			want: []mentions.Span{{Username: "alice", Start: 7, End: 13}},
+		},
+		{
+			name: "When text contains an email",
+			text: "write to alice@example.com",
+			want: []mentions.Span{},
+		},
+		{
+			name: "When text contains multibyte characters",
+			text: "ciao è @bob",
+			want: []mentions.Span{{Username: "bob", Start: 7, End: 11}},
+		},
+		{
+			name: "When the username is too long",
+			text: "@abcdefghijklmnopqrstuvwxyz",
+			want: []mentions.Span{},
+		},
+		{
+			name: "When the token is a lone @",
+			text: "@ @@alice",
+			want: []mentions.Span{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, mentions.Parse(tt.text))
+		})
+	}
+}
@@ -62,21 +62,44 @@ type SendNotificationParams struct {
 	TriggerID       string
 	EventID         string
 	Content         string
+	// Collapse merges the notification into the receiver's unread one having the same
+	// trigger id and event id, if any, incrementing its count instead of creating a new one
+	Collapse bool
+	// AllowSelf sends the notification even if the receiver is the sender,
+	// for events about the receiver's own content such as a closed poll
+	AllowSelf bool
 }
 
 // Send creates a notification in db and sends it to the user
 func (m *Manager) Send(p SendNotificationParams) (*v1API.Notification, error) {
-	ok, err := m.shouldSendNotification(p)
-	if err != nil {
-		return nil, err
-	}
-	if !ok {
-		// Should not send notification
-		return nil, nil
-	}
+	var notification *v1API.Notification
+	if p.Collapse {
+		if p.ReceiverUserID == p.SenderUserID && !p.AllowSelf {
+			// Do nothing and return if user performed action on its behalf
+			return nil, nil
+		}
 
-	// Store notification on db
-	notification, err := m.createNotification(p)
+		n, err := m.collapseNotification(p)
+		if err != nil {
+			return nil, err
+		}
+		notification = n
+	} else {
+		ok, err := m.shouldSendNotification(p)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			// Should not send notification
+			return nil, nil
+		}
+
+		// Store notification on db
+		notification, err = m.createNotification(p)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	isOnline, err := m.usersession.IsOnline(p.Ctx, p.ReceiverUserID)
 	if err != nil {
@@ -110,7 +133,7 @@ func (m *Manager) shouldSendNotification(p SendNotificationParams) (bool, error)
 			return false, err
 		}
 	}
-	if p.ReceiverUserID == p.SenderUserID {
+	if p.ReceiverUserID == p.SenderUserID && !p.AllowSelf {
 		// Do nothing and return if user performed action on its behalf
 		return false, nil
 	}
@@ -143,6 +166,26 @@ func (m *Manager) createNotification(p SendNotificationParams) (*v1API.Notificat
 	return m.dir.CreateNotification(p.Ctx, params)
 }
 
+// collapseNotification creates the notification, or merges it into the receiver's unread one with the same trigger and event.
+// Creation and merge happen in a single statement, so concurrent events are never lost nor duplicated
+func (m *Manager) collapseNotification(p SendNotificationParams) (*v1API.Notification, error) {
+	params := dbNotifications.UpsertCollapsedNotificationParams{
+		ID:                  uuid.NewString(),
+		Date:                time.Now(),
+		UserIDToNotify:      p.ReceiverUserID,
+		UserIDWhoFiredEvent: p.SenderUserID,
+		EventID:             p.EventID,
+	}
+	if p.Content != "" {
+		params.Content = sql.NullString{String: truncateString(p.Content, 64), Valid: true}
+	}
+	if p.TriggerID != "" {
+		params.TriggerID = sql.NullString{String: p.TriggerID, Valid: true}
+	}
+
+	return m.dir.UpsertCollapsedNotification(p.Ctx, params)
+}
+
 // Listen subscribes to the userID notifications channel
 func (m *Manager) Listen(ctx context.Context, userID string) <-chan *v1API.Notification {
 	return m.pushmanager.Subscribe(ctx, userID)
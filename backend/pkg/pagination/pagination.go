@@ -0,0 +1,80 @@
+// Package pagination encodes and decodes opaque keyset cursors
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultPageSize is the page size used when none is requested
+	DefaultPageSize = 20
+	// MaxPageSize is the maximum page size a client can request
+	MaxPageSize = 100
+)
+
+// ErrInvalidCursor is returned when a cursor cannot be decoded
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor points to the last item of a page, ordered by creation time and id.
+// Items ordered by a computed value, such as likes, use Score in place of the creation time
+type Cursor struct {
+	CreatedAt time.Time
+	Score     float64
+	ID        string
+}
+
+// Encode returns the opaque string representation of the cursor
+func (c Cursor) Encode() string {
+	raw := strconv.FormatInt(c.CreatedAt.UnixNano(), 10) + ":" + strconv.FormatFloat(c.Score, 'g', -1, 64) + ":" + c.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// Decode parses an opaque cursor. An empty string returns a nil cursor, which refers to the first page
+func Decode(s string) (*Cursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	parts := strings.SplitN(string(raw), ":", 3)
+	if len(parts) != 3 || parts[2] == "" {
+		return nil, ErrInvalidCursor
+	}
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	score, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &Cursor{CreatedAt: time.Unix(0, nanos).UTC(), Score: score, ID: parts[2]}, nil
+}
+
+// PageSize returns the requested page size, falling back to DefaultPageSize
+// when not set and capping it to MaxPageSize
+func PageSize(requested int32) int32 {
+	if requested <= 0 {
+		return DefaultPageSize
+	}
+	if requested > MaxPageSize {
+		return MaxPageSize
+	}
+	return requested
+}
+
+// NextCursor returns the encoded cursor of the next page. A page smaller than
+// pageSize is the last one, in which case an empty cursor is returned
+func NextCursor(itemsCount int, pageSize int32, last Cursor) string {
+	if itemsCount < int(pageSize) {
+		return ""
+	}
+	return last.Encode()
+}
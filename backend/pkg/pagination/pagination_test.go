@@ -0,0 +1,47 @@
+package pagination_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DagDigg/unpaper/backend/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursor(t *testing.T) {
+	assert := assert.New(t)
+
+	t.Run("When encoding and decoding a cursor", func(t *testing.T) {
+		c := pagination.Cursor{CreatedAt: time.Date(2021, time.May, 3, 10, 0, 0, 42, time.UTC), Score: -1620036000.000042, ID: "post:id"}
+		decoded, err := pagination.Decode(c.Encode())
+		assert.Nil(err)
+		assert.Equal(c, *decoded)
+	})
+
+	t.Run("When decoding an empty cursor", func(t *testing.T) {
+		decoded, err := pagination.Decode("")
+		assert.Nil(err)
+		assert.Nil(decoded)
+	})
+
+	t.Run("When decoding a malformed cursor", func(t *testing.T) {
+		_, err := pagination.Decode("not a cursor")
+		assert.Equal(pagination.ErrInvalidCursor, err)
+	})
+}
+
+func TestPageSize(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(int32(pagination.DefaultPageSize), pagination.PageSize(0))
+	assert.Equal(int32(5), pagination.PageSize(5))
+	assert.Equal(int32(pagination.MaxPageSize), pagination.PageSize(1000))
+}
+
+func TestNextCursor(t *testing.T) {
+	assert := assert.New(t)
+	last := pagination.Cursor{CreatedAt: time.Now(), ID: "id"}
+
+	assert.Equal("", pagination.NextCursor(3, 5, last))
+	assert.Equal(last.Encode(), pagination.NextCursor(5, 5, last))
+}
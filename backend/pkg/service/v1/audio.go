@@ -0,0 +1,31 @@
+package v1
+
+import (
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/audio"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newAudio validates the uploaded audio, returning it along with the format, duration and waveform
+// detected from its bytes. Posts and comments without audio have an empty audio
+func newAudio(b []byte) (*v1API.Audio, error) {
+	a := &v1API.Audio{
+		Id:    uuid.NewString(),
+		Bytes: b,
+	}
+	if len(b) == 0 {
+		return a, nil
+	}
+
+	info, err := audio.Probe(b)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid audio: %v", err)
+	}
+	a.Format = string(info.Format)
+	a.DurationMs = int32(info.Duration.Milliseconds())
+	a.Peaks = info.Peaks
+
+	return a, nil
+}
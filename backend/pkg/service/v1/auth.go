@@ -765,6 +765,21 @@ func (s *unpaperServiceServer) UpdateUsername(ctx context.Context, req *v1API.Up
 	return user, nil
 }
 
+// SetMessageRequestsPolicy updates which new conversations are routed to the user's message requests
+func (s *unpaperServiceServer) SetMessageRequestsPolicy(ctx context.Context, req *v1API.SetMessageRequestsPolicyRequest) (*v1API.User, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+
+	user, err := users.NewDirectory(s.db).UpdateMessageRequestsPolicy(ctx, userID, req.Policy)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update message requests policy: %v", err)
+	}
+
+	return user, nil
+}
+
 // SetUserOnline sets the user in the active users underlying rdb
 func (s *unpaperServiceServer) SetUserOnline(ctx context.Context, req *empty.Empty) (*empty.Empty, error) {
 	userID, ok := mdutils.GetUserIDFromMD(ctx)
@@ -791,6 +806,9 @@ func (s *unpaperServiceServer) FollowUser(ctx context.Context, req *v1API.Follow
 	if !ok {
 		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
 	}
+	if err := checkNotSuspended(ctx, s.db, userID); err != nil {
+		return nil, err
+	}
 	followsDir := follows.NewDirectory(s.db)
 	usersDir := users.NewDirectory(s.db)
 
@@ -818,6 +836,11 @@ func (s *unpaperServiceServer) FollowUser(ctx context.Context, req *v1API.Follow
 		return nil, status.Errorf(codes.Internal, "failure occurred while following user: %v", err)
 	}
 
+	if err := s.timeline.Rebuild(ctx, userID); err != nil {
+		// Do not throw error on timeline rebuild failure
+		logger.Log.Error(err.Error())
+	}
+
 	if res.IsFollowed {
 		_, err = s.nm.Send(notifications.SendNotificationParams{
 			Ctx:             ctx,
@@ -0,0 +1,55 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/DagDigg/unpaper/backend/blocks"
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/mdutils"
+	"github.com/DagDigg/unpaper/backend/users"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BlockUser blocks the target user. Blocked users cannot mention nor be mentioned by the blocker
+func (s *unpaperServiceServer) BlockUser(ctx context.Context, req *v1API.BlockUserRequest) (*empty.Empty, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return new(empty.Empty), status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	if req.UserId == "" || req.UserId == userID {
+		return new(empty.Empty), status.Error(codes.InvalidArgument, "invalid user id to block")
+	}
+
+	if _, err := users.NewDirectory(s.db).GetUser(ctx, req.UserId); err != nil {
+		if err == sql.ErrNoRows {
+			return new(empty.Empty), status.Error(codes.NotFound, "user not found")
+		}
+		return new(empty.Empty), status.Errorf(codes.Internal, "failed to retrieve user: %v", err)
+	}
+
+	if err := blocks.NewDirectory(s.db).BlockUser(ctx, userID, req.UserId); err != nil {
+		return new(empty.Empty), status.Errorf(codes.Internal, "failed to block user: %v", err)
+	}
+
+	return new(empty.Empty), nil
+}
+
+// UnblockUser removes the block on the target user
+func (s *unpaperServiceServer) UnblockUser(ctx context.Context, req *v1API.UnblockUserRequest) (*empty.Empty, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return new(empty.Empty), status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	if req.UserId == "" {
+		return new(empty.Empty), status.Error(codes.InvalidArgument, "missing user id to unblock")
+	}
+
+	if err := blocks.NewDirectory(s.db).UnblockUser(ctx, userID, req.UserId); err != nil {
+		return new(empty.Empty), status.Errorf(codes.Internal, "failed to unblock user: %v", err)
+	}
+
+	return new(empty.Empty), nil
+}
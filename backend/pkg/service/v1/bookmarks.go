@@ -0,0 +1,169 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/DagDigg/unpaper/backend/bookmarks"
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/mdutils"
+	"github.com/DagDigg/unpaper/backend/pkg/pagination"
+	"github.com/DagDigg/unpaper/backend/posts"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BookmarkPost RPC bookmarks a post for the requesting user, optionally inside a named collection
+func (s *unpaperServiceServer) BookmarkPost(ctx context.Context, req *v1API.BookmarkPostRequest) (*v1API.BookmarkPostResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	collection, err := bookmarks.NormalizeCollection(req.Collection)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid collection: %v", err)
+	}
+
+	if err := checkPostVisible(ctx, s.db, req.PostId, userID); err != nil {
+		return nil, err
+	}
+
+	err = bookmarks.NewDirectory(s.db).BookmarkPost(ctx, bookmarks.BookmarkPostParams{
+		UserID:     userID,
+		PostID:     req.PostId,
+		Collection: collection,
+	})
+	if err == bookmarks.ErrPostNotFound {
+		return nil, status.Errorf(codes.NotFound, "post %q not found", req.PostId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to bookmark post: %v", err)
+	}
+
+	post, err := fetchPost(fetchPostParams{
+		ctx:    ctx,
+		db:     s.db,
+		postID: req.PostId,
+		userID: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1API.BookmarkPostResponse{
+		Post: post,
+	}, nil
+}
+
+// UnbookmarkPost RPC removes a post from the requesting user bookmarks
+func (s *unpaperServiceServer) UnbookmarkPost(ctx context.Context, req *v1API.UnbookmarkPostRequest) (*v1API.UnbookmarkPostResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+
+	if err := bookmarks.NewDirectory(s.db).UnbookmarkPost(ctx, userID, req.PostId); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove bookmark: %v", err)
+	}
+
+	post, err := fetchPost(fetchPostParams{
+		ctx:    ctx,
+		db:     s.db,
+		postID: req.PostId,
+		userID: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1API.UnbookmarkPostResponse{
+		Post: post,
+	}, nil
+}
+
+// GetBookmarks RPC retrieves a page of the requesting user bookmarked posts, from the latest bookmark.
+// Bookmarks are private, so only the caller ones are returned
+func (s *unpaperServiceServer) GetBookmarks(ctx context.Context, req *v1API.GetBookmarksRequest) (*v1API.GetBookmarksResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	collection, err := bookmarks.NormalizeCollection(req.Collection)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid collection: %v", err)
+	}
+	cursor, err := pagination.Decode(req.Cursor)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to decode cursor: %v", err)
+	}
+	pageSize := pagination.PageSize(req.PageSize)
+
+	params := bookmarks.GetBookmarkKeysParams{
+		UserID:        userID,
+		HasCollection: collection != "",
+		Collection:    collection,
+		PageSize:      pageSize,
+	}
+	if cursor != nil {
+		params.HasCursor = true
+		params.CursorCreatedAt = cursor.CreatedAt
+		params.CursorID = cursor.ID
+	}
+	keys, err := bookmarks.NewDirectory(s.db).GetBookmarkKeys(ctx, params)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve bookmarks: %v", err)
+	}
+	if len(keys) == 0 {
+		return &v1API.GetBookmarksResponse{Posts: []*v1API.Post{}}, nil
+	}
+
+	postIDs := []string{}
+	for _, k := range keys {
+		postIDs = append(postIDs, k.PostID)
+	}
+	postsList, err := posts.NewDirectory(s.db).GetPostsByIDs(ctx, userID, postIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve posts: %v", err)
+	}
+	byID := map[string]*v1API.Post{}
+	for _, p := range postsList {
+		byID[p.Id] = p
+	}
+	// Keep the bookmarks order
+	res := []*v1API.Post{}
+	for _, k := range keys {
+		if p, ok := byID[k.PostID]; ok {
+			res = append(res, p)
+		}
+	}
+
+	if err := addPostsDetails(ctx, s.db, userID, res); err != nil {
+		return nil, err
+	}
+
+	last := keys[len(keys)-1]
+	return &v1API.GetBookmarksResponse{
+		Posts: res,
+		NextCursor: pagination.NextCursor(len(keys), pageSize, pagination.Cursor{
+			CreatedAt: last.CreatedAt,
+			ID:        last.PostID,
+		}),
+	}, nil
+}
+
+// GetBookmarkCollections RPC retrieves the requesting user bookmark collections
+func (s *unpaperServiceServer) GetBookmarkCollections(ctx context.Context, req *empty.Empty) (*v1API.GetBookmarkCollectionsResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+
+	collections, err := bookmarks.NewDirectory(s.db).GetBookmarkCollections(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve bookmark collections: %v", err)
+	}
+
+	return &v1API.GetBookmarkCollectionsResponse{
+		Collections: collections,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package v1_test
+
+import (
+	"context"
+	"testing"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	v1Testing "github.com/DagDigg/unpaper/backend/pkg/service/v1/testing"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestBookmarks(t *testing.T) {
+	t.Parallel()
+	ws := v1Testing.GetWrappedServer(t)
+	assert := assert.New(t)
+
+	t.Run("When bookmarking posts into collections", func(t *testing.T) {
+		userID := uuid.NewString()
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", userID))
+		otherCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", uuid.NewString()))
+
+		first, err := ws.Server.CreatePost(ctx, &v1API.CreatePostRequest{Message: "first"})
+		assert.Nil(err)
+		second, err := ws.Server.CreatePost(ctx, &v1API.CreatePostRequest{Message: "second"})
+		assert.Nil(err)
+
+		bookmarked, err := ws.Server.BookmarkPost(ctx, &v1API.BookmarkPostRequest{PostId: first.Post.Id, Collection: " listen later "})
+		assert.Nil(err)
+		assert.True(bookmarked.Post.IsBookmarked)
+		_, err = ws.Server.BookmarkPost(ctx, &v1API.BookmarkPostRequest{PostId: second.Post.Id})
+		assert.Nil(err)
+
+		_, err = ws.Server.BookmarkPost(ctx, &v1API.BookmarkPostRequest{PostId: uuid.NewString()})
+		assert.Equal(codes.NotFound, status.Code(err))
+
+		all, err := ws.Server.GetBookmarks(ctx, &v1API.GetBookmarksRequest{})
+		assert.Nil(err)
+		assert.Len(all.Posts, 2)
+		assert.Equal(second.Post.Id, all.Posts[0].Id)
+
+		listenLater, err := ws.Server.GetBookmarks(ctx, &v1API.GetBookmarksRequest{Collection: "listen later"})
+		assert.Nil(err)
+		assert.Len(listenLater.Posts, 1)
+		assert.Equal(first.Post.Id, listenLater.Posts[0].Id)
+
+		collections, err := ws.Server.GetBookmarkCollections(ctx, &empty.Empty{})
+		assert.Nil(err)
+		assert.Len(collections.Collections, 1)
+		assert.Equal("listen later", collections.Collections[0].Name)
+
+		// Bookmarks are private
+		others, err := ws.Server.GetBookmarks(otherCtx, &v1API.GetBookmarksRequest{})
+		assert.Nil(err)
+		assert.Len(others.Posts, 0)
+
+		unbookmarked, err := ws.Server.UnbookmarkPost(ctx, &v1API.UnbookmarkPostRequest{PostId: second.Post.Id})
+		assert.Nil(err)
+		assert.False(unbookmarked.Post.IsBookmarked)
+
+		// Deleting a post removes its bookmarks
+		_, err = ws.Server.DeletePost(ctx, &v1API.DeletePostRequest{PostId: first.Post.Id})
+		assert.Nil(err)
+		all, err = ws.Server.GetBookmarks(ctx, &v1API.GetBookmarksRequest{})
+		assert.Nil(err)
+		assert.Len(all.Posts, 0)
+	})
+}
@@ -1,16 +1,21 @@
 package v1
 
 import (
+	"bufio"
 	"context"
 	"database/sql"
 	"fmt"
 	"reflect"
 	"time"
 
+	"github.com/DagDigg/unpaper/backend/blocks"
 	"github.com/DagDigg/unpaper/backend/customers"
+	"github.com/DagDigg/unpaper/backend/follows"
 	"github.com/DagDigg/unpaper/backend/helpers"
 	"github.com/DagDigg/unpaper/backend/lists"
 	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/chat"
+	"github.com/DagDigg/unpaper/backend/pkg/chat/archive"
 	"github.com/DagDigg/unpaper/backend/pkg/chat/conversation"
 	"github.com/DagDigg/unpaper/backend/pkg/chat/message"
 	"github.com/DagDigg/unpaper/backend/pkg/dbentities"
@@ -20,6 +25,8 @@ import (
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ErrConversationNotFound error returned when no conversation has been found
@@ -124,25 +131,127 @@ func (s *unpaperServiceServer) SendMessage(ctx context.Context, req *v1API.SendM
 	// 	return nil, status.Error(codes.Internal, "unauthorized")
 	// }
 
-	err := s.chat.SendMessage(ctx, req.Channel, &message.Message{
-		ID:             uuid.New().String(),
-		UserID:         userID,
-		CreatedAt:      time.Now(),
-		Text:           message.Text{Content: req.Content},
-		SenderUsername: req.Username,
+	mentions, err := s.resolveConversationMentions(ctx, userID, req.Channel, req.Content)
+	if err != nil {
+		return new(empty.Empty), err
+	}
+
+	err = s.sendOrScheduleMessage(ctx, sendOrScheduleMessageParams{
+		channel:      req.Channel,
+		expiresAfter: req.ExpiresAfter,
+		sendAt:       req.SendAt,
+		msg: &message.Message{
+			ID:             uuid.New().String(),
+			UserID:         userID,
+			CreatedAt:      time.Now(),
+			Text:           message.Text{Content: req.Content},
+			SenderUsername: req.Username,
+			Mentions:       mentions,
+		},
 	})
 	if err != nil {
-		return new(empty.Empty), status.Errorf(codes.Internal, "error sending message: %q", err)
+		return new(empty.Empty), err
 	}
 	return new(empty.Empty), nil
 }
 
+// resolveConversationMentions resolves the mentions in the message content, keeping only the conversation participants
+func (s *unpaperServiceServer) resolveConversationMentions(ctx context.Context, userID, conversationID, content string) ([]message.Mention, error) {
+	resolved, err := s.resolveMentions(ctx, userID, content)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resolve mentions: %v", err)
+	}
+	if len(resolved) == 0 {
+		return nil, nil
+	}
+
+	conv, err := s.chat.GetConversation(ctx, userID, conversationID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "failed to retrieve conversation: %v", err)
+	}
+
+	res := []message.Mention{}
+	for _, m := range resolved {
+		if _, ok := conv.Participants[m.UserId]; !ok {
+			continue
+		}
+		res = append(res, message.NewMentionFromProtobuf(m))
+	}
+
+	return res, nil
+}
+
+type sendOrScheduleMessageParams struct {
+	channel      string
+	expiresAfter *durationpb.Duration
+	sendAt       *timestamppb.Timestamp
+	msg          *message.Message
+}
+
+// sendOrScheduleMessage sends the message right away, or holds it server-side if
+// a future send time is provided. An optional expiration is applied to the message once sent
+func (s *unpaperServiceServer) sendOrScheduleMessage(ctx context.Context, params sendOrScheduleMessageParams) error {
+	if err := checkNotSuspended(ctx, s.db, params.msg.UserID); err != nil {
+		return err
+	}
+	var expiresAfter time.Duration
+	if params.expiresAfter != nil {
+		if err := params.expiresAfter.CheckValid(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid expires_after: %v", err)
+		}
+		expiresAfter = params.expiresAfter.AsDuration()
+		if expiresAfter <= 0 {
+			return status.Error(codes.InvalidArgument, "expires_after must be positive")
+		}
+	}
+
+	if params.sendAt != nil {
+		if err := params.sendAt.CheckValid(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid send_at: %v", err)
+		}
+		sendAt := params.sendAt.AsTime()
+		if sendAt.After(time.Now()) {
+			// Verify the user belongs to the conversation before holding the message
+			if _, err := s.chat.GetConversation(ctx, params.msg.UserID, params.channel); err != nil {
+				return status.Errorf(codes.NotFound, "failed to retrieve conversation: %v", err)
+			}
+
+			err := s.chat.ScheduleMessage(ctx, &message.Scheduled{
+				ID:             uuid.NewString(),
+				ConversationID: params.channel,
+				SendAt:         sendAt,
+				ExpiresAfter:   expiresAfter,
+				Message:        params.msg,
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "error scheduling message: %q", err)
+			}
+			return nil
+		}
+	}
+
+	if expiresAfter > 0 {
+		params.msg.ExpiresAt = params.msg.CreatedAt.Add(expiresAfter)
+	}
+	if err := s.chat.SendMessage(ctx, params.channel, params.msg); err != nil {
+		if err == chat.ErrMessageRequestPending || err == chat.ErrMessageRequestDeclined {
+			return status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return status.Errorf(codes.Internal, "error sending message: %q", err)
+	}
+
+	return nil
+}
+
 // SendAward sends an award to the param passed channel, returning any error if occurred
 func (s *unpaperServiceServer) SendAward(ctx context.Context, req *v1API.SendAwardRequest) (*empty.Empty, error) {
 	userID, ok := mdutils.GetUserIDFromMD(ctx)
 	if !ok {
 		return new(empty.Empty), status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
 	}
+	if err := checkNotSuspended(ctx, s.db, userID); err != nil {
+		return new(empty.Empty), err
+	}
 	if req.AwardId == "" {
 		return new(empty.Empty), status.Errorf(codes.InvalidArgument, "missing content")
 	}
@@ -180,6 +289,9 @@ func (s *unpaperServiceServer) SendDonation(ctx context.Context, req *v1API.Send
 	if !ok {
 		return new(empty.Empty), status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
 	}
+	if err := checkNotSuspended(ctx, s.db, userID); err != nil {
+		return new(empty.Empty), err
+	}
 	if req.Channel == "" {
 		return new(empty.Empty), status.Errorf(codes.InvalidArgument, "missing channel")
 	}
@@ -238,18 +350,23 @@ func (s *unpaperServiceServer) SendAudio(ctx context.Context, req *v1API.SendAud
 	// 	return nil, status.Error(codes.Internal, "unauthorized")
 	// }
 
-	err := s.chat.SendMessage(ctx, req.Channel, &message.Message{
-		ID:             uuid.NewString(),
-		Type:           message.TypeDonation,
-		UserID:         userID,
-		CreatedAt:      time.Now(),
-		SenderUsername: req.Username,
-		Audio: message.Audio{
-			Bytes: req.Audio,
+	err := s.sendOrScheduleMessage(ctx, sendOrScheduleMessageParams{
+		channel:      req.Channel,
+		expiresAfter: req.ExpiresAfter,
+		sendAt:       req.SendAt,
+		msg: &message.Message{
+			ID:             uuid.NewString(),
+			Type:           message.TypeDonation,
+			UserID:         userID,
+			CreatedAt:      time.Now(),
+			SenderUsername: req.Username,
+			Audio: message.Audio{
+				Bytes: req.Audio,
+			},
 		},
 	})
 	if err != nil {
-		return new(empty.Empty), status.Errorf(codes.Internal, "failed to send chat audio")
+		return new(empty.Empty), err
 	}
 	return new(empty.Empty), nil
 }
@@ -396,9 +513,14 @@ func userInList(ctx context.Context, db *sql.DB, listIDs []string, userID string
 	listsDir := lists.NewDirectory(db)
 
 	for _, l := range listIDs {
-		listFound, _ := listsDir.GetListByID(ctx, l) // Ignore error
-		_, ok := listFound.AllowedUsers[userID]
-		if ok {
+		listFound, err := listsDir.GetListByID(ctx, l)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+		if _, ok := listFound.AllowedUsers[userID]; ok {
 			return true, nil
 		}
 	}
@@ -546,6 +668,9 @@ func (s *unpaperServiceServer) CreateConversation(ctx context.Context, req *v1AP
 	if !ok {
 		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
 	}
+	if err := checkNotSuspended(ctx, s.db, userID); err != nil {
+		return nil, err
+	}
 
 	usersDir := users.NewDirectory(s.db)
 	senderUser, err := usersDir.GetUser(ctx, userID)
@@ -557,6 +682,18 @@ func (s *unpaperServiceServer) CreateConversation(ctx context.Context, req *v1AP
 		return nil, status.Errorf(codes.Internal, "failed to retrieve participant user: %v", err)
 	}
 
+	blocked, err := blocks.NewDirectory(s.db).IsBlockedBetween(ctx, userID, receiverUser.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve blocks: %v", err)
+	}
+	if blocked {
+		return nil, status.Error(codes.PermissionDenied, "cannot start a conversation with this user")
+	}
+	isRequest, err := s.isMessageRequest(ctx, userID, receiverUser)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve message requests policy: %v", err)
+	}
+
 	_, err = s.getConversationWithUser(ctx, userID, receiverUser.Id)
 	if err != nil {
 		// A conversation already exists, or another error occurred
@@ -564,6 +701,10 @@ func (s *unpaperServiceServer) CreateConversation(ctx context.Context, req *v1AP
 	}
 
 	conv := conversation.New(senderUser, receiverUser)
+	if isRequest {
+		conv.RequestStatus = conversation.RequestStatusPending
+		conv.RequestedBy = userID
+	}
 
 	err = s.chat.CreateConversation(ctx, conv)
 	if err != nil {
@@ -575,6 +716,73 @@ func (s *unpaperServiceServer) CreateConversation(ctx context.Context, req *v1AP
 	}, nil
 }
 
+// isMessageRequest returns whether a conversation started by the sender must be routed
+// to the receiver message requests, according to the receiver policy
+func (s *unpaperServiceServer) isMessageRequest(ctx context.Context, senderUserID string, receiver *v1API.User) (bool, error) {
+	switch receiver.MessageRequestsPolicy {
+	case v1API.MessageRequestsPolicy_EVERYONE:
+		return false, nil
+	case v1API.MessageRequestsPolicy_NOBODY:
+		return true, nil
+	default:
+		isFollowing, err := follows.NewDirectory(s.db).IsFollowingUser(ctx, follows.IsFollowingUserParams{
+			FollowerUserID:  receiver.Id,
+			FollowingUserID: senderUserID,
+		})
+		if err != nil {
+			return false, err
+		}
+		return !isFollowing, nil
+	}
+}
+
+// GetMessageRequests returns the pending message requests received by the user
+func (s *unpaperServiceServer) GetMessageRequests(ctx context.Context, req *empty.Empty) (*v1API.GetMessageRequestsResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+
+	convs, err := s.chat.GetMessageRequests(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve message requests: %v", err)
+	}
+
+	return &v1API.GetMessageRequestsResponse{
+		Conversations: convs,
+	}, nil
+}
+
+// RespondToMessageRequest accepts, declines, or declines and blocks the sender of a message request
+func (s *unpaperServiceServer) RespondToMessageRequest(ctx context.Context, req *v1API.RespondToMessageRequestRequest) (*v1API.RespondToMessageRequestResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	if req.ConversationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing conversation id")
+	}
+
+	accept := req.Action == v1API.MessageRequestAction_ACCEPT
+	conv, err := s.chat.RespondToMessageRequest(ctx, userID, req.ConversationId, accept)
+	if err != nil {
+		if err == chat.ErrMessageRequestNotFound {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to respond to message request: %v", err)
+	}
+
+	if req.Action == v1API.MessageRequestAction_BLOCK {
+		if err := blocks.NewDirectory(s.db).BlockUser(ctx, userID, conv.RequestedBy); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to block user: %v", err)
+		}
+	}
+
+	return &v1API.RespondToMessageRequestResponse{
+		Conversation: conv,
+	}, nil
+}
+
 // TODO: Support multiple users
 func (s *unpaperServiceServer) getConversationWithUser(ctx context.Context, userID, receiverUserID string) (*v1API.Conversation, error) {
 	prevConv, err := s.chat.GetConversationsWithUser(ctx, userID, receiverUserID)
@@ -667,3 +875,141 @@ func (s *unpaperServiceServer) GetConversationWithParticipants(ctx context.Conte
 		Found:        true,
 	}, nil
 }
+
+// SetConversationRetention sets the default expiration applied to every message sent in the conversation
+func (s *unpaperServiceServer) SetConversationRetention(ctx context.Context, req *v1API.SetConversationRetentionRequest) (*v1API.SetConversationRetentionResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	if req.ConversationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing conversation id")
+	}
+
+	var retention time.Duration
+	if req.Retention != nil {
+		if err := req.Retention.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid retention: %v", err)
+		}
+		retention = req.Retention.AsDuration()
+	}
+	if retention < 0 {
+		return nil, status.Error(codes.InvalidArgument, "retention cannot be negative")
+	}
+
+	conv, err := s.chat.SetConversationRetention(ctx, userID, req.ConversationId, retention)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set conversation retention: %v", err)
+	}
+
+	return &v1API.SetConversationRetentionResponse{
+		Conversation: conv,
+	}, nil
+}
+
+// SetConversationMuted mutes or unmutes the new messages notifications of a conversation for the user
+func (s *unpaperServiceServer) SetConversationMuted(ctx context.Context, req *v1API.SetConversationMutedRequest) (*v1API.SetConversationMutedResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	if req.ConversationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing conversation id")
+	}
+
+	conv, err := s.chat.SetConversationMuted(ctx, userID, req.ConversationId, req.Muted)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set conversation muted: %v", err)
+	}
+
+	return &v1API.SetConversationMutedResponse{
+		Conversation: conv,
+	}, nil
+}
+
+// exportChunkSize is the maximum size of each streamed chunk of a conversation export
+const exportChunkSize = 64 * 1024
+
+// ExportConversation streams a zip archive of every message the user can see in the conversation
+func (s *unpaperServiceServer) ExportConversation(req *v1API.ExportConversationRequest, stream v1API.UnpaperService_ExportConversationServer) error {
+	ctx := stream.Context()
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	if req.ConversationId == "" {
+		return status.Error(codes.InvalidArgument, "missing conversation id")
+	}
+
+	// Check access before streaming anything
+	if _, err := s.chat.GetConversation(ctx, userID, req.ConversationId); err != nil {
+		return status.Errorf(codes.NotFound, "failed to retrieve conversation: %v", err)
+	}
+
+	err := stream.Send(&v1API.ExportConversationChunk{
+		Filename:    archive.Filename(req.ConversationId, time.Now()),
+		ContentType: archive.ContentType,
+	})
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriterSize(&exportChunkWriter{stream: stream}, exportChunkSize)
+	if err := s.chat.ExportConversation(ctx, userID, req.ConversationId, w); err != nil {
+		return status.Errorf(codes.Internal, "failed to export conversation: %v", err)
+	}
+	if err := w.Flush(); err != nil {
+		return status.Errorf(codes.Internal, "failed to export conversation: %v", err)
+	}
+
+	return nil
+}
+
+// exportChunkWriter sends every write as an export chunk
+type exportChunkWriter struct {
+	stream v1API.UnpaperService_ExportConversationServer
+}
+
+func (w *exportChunkWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&v1API.ExportConversationChunk{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// ListScheduledMessages returns the user's pending scheduled messages, optionally filtered by channel
+func (s *unpaperServiceServer) ListScheduledMessages(ctx context.Context, req *v1API.ListScheduledMessagesRequest) (*v1API.ListScheduledMessagesResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+
+	res, err := s.chat.GetScheduledMessages(ctx, userID, req.Channel)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve scheduled messages: %v", err)
+	}
+
+	return &v1API.ListScheduledMessagesResponse{
+		ScheduledMessages: res,
+	}, nil
+}
+
+// CancelScheduledMessage cancels a pending scheduled message
+func (s *unpaperServiceServer) CancelScheduledMessage(ctx context.Context, req *v1API.CancelScheduledMessageRequest) (*empty.Empty, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return new(empty.Empty), status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	if req.Id == "" {
+		return new(empty.Empty), status.Error(codes.InvalidArgument, "missing scheduled message id")
+	}
+
+	if err := s.chat.CancelScheduledMessage(ctx, userID, req.Id); err != nil {
+		if err == chat.ErrScheduledMessageNotFound {
+			return new(empty.Empty), status.Error(codes.NotFound, err.Error())
+		}
+		return new(empty.Empty), status.Errorf(codes.Internal, "failed to cancel scheduled message: %v", err)
+	}
+
+	return new(empty.Empty), nil
+}
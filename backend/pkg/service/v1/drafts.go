@@ -0,0 +1,288 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/logger"
+	"github.com/DagDigg/unpaper/backend/pkg/mdutils"
+	"github.com/DagDigg/unpaper/backend/pkg/pagination"
+	"github.com/DagDigg/unpaper/backend/posts"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// publishBatchSize is the maximum number of scheduled posts published by a single worker run
+const publishBatchSize = 100
+
+// requestedPostStatus returns the status and publish time of a post being created
+func requestedPostStatus(req *v1API.CreatePostRequest) (posts.Status, sql.NullTime, error) {
+	if req.PublishAt == nil {
+		if req.Draft {
+			return posts.StatusDraft, sql.NullTime{}, nil
+		}
+		return posts.StatusPublished, sql.NullTime{}, nil
+	}
+	if req.Draft {
+		return "", sql.NullTime{}, status.Error(codes.InvalidArgument, "a post cannot be both a draft and scheduled")
+	}
+	publishAt, err := futurePublishTime(req.PublishAt)
+	if err != nil {
+		return "", sql.NullTime{}, err
+	}
+
+	return posts.StatusScheduled, sql.NullTime{Time: publishAt, Valid: true}, nil
+}
+
+// futurePublishTime validates a requested publish time, which must be in the future
+func futurePublishTime(t *timestamppb.Timestamp) (time.Time, error) {
+	if t == nil || !t.IsValid() {
+		return time.Time{}, status.Error(codes.InvalidArgument, "invalid publish_at")
+	}
+	publishAt := t.AsTime().UTC()
+	if !publishAt.After(time.Now()) {
+		return time.Time{}, status.Error(codes.InvalidArgument, "publish_at must be in the future")
+	}
+
+	return publishAt, nil
+}
+
+// onPostPublished delivers a just published post to the author followers, records its hashtags and notifies the mentioned users
+func (s *unpaperServiceServer) onPostPublished(ctx context.Context, p *v1API.Post) {
+	s.setHashtags(ctx, p.Id, p.Id, p.Message)
+	if err := s.fanOutPost(ctx, p); err != nil {
+		// Followers timelines are rebuilt when missing, do not fail the publication
+		logger.Log.Error(err.Error())
+	}
+
+	s.notifyMentions(notifyMentionsParams{
+		ctx:          ctx,
+		senderUserID: p.Author,
+		triggerID:    p.Id,
+		postID:       p.Id,
+		content:      p.Message,
+		mentions:     p.Mentions,
+	})
+}
+
+// GetDrafts RPC retrieves a page of the requesting user drafts, from the newest
+func (s *unpaperServiceServer) GetDrafts(ctx context.Context, req *v1API.GetDraftsRequest) (*v1API.GetDraftsResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	cursor, err := pagination.Decode(req.Cursor)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to decode cursor: %v", err)
+	}
+	pageSize := pagination.PageSize(req.PageSize)
+	postsDir := posts.NewDirectory(s.db)
+
+	params := posts.GetDraftsParams{
+		Author:   userID,
+		PageSize: pageSize,
+	}
+	if cursor != nil {
+		params.HasCursor = true
+		params.CursorCreatedAt = cursor.CreatedAt
+		params.CursorID = cursor.ID
+	}
+	drafts, err := postsDir.GetDrafts(ctx, params)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve drafts: %v", err)
+	}
+
+	res := &v1API.GetDraftsResponse{
+		Posts: drafts,
+	}
+	if len(drafts) > 0 {
+		last := drafts[len(drafts)-1]
+		res.NextCursor = pagination.NextCursor(len(drafts), pageSize, pagination.Cursor{
+			CreatedAt: last.CreatedAt.AsTime(),
+			ID:        last.Id,
+		})
+	}
+
+	return res, nil
+}
+
+// GetScheduledPosts RPC retrieves a page of the requesting user scheduled posts, from the earliest to be published
+func (s *unpaperServiceServer) GetScheduledPosts(ctx context.Context, req *v1API.GetScheduledPostsRequest) (*v1API.GetScheduledPostsResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	cursor, err := pagination.Decode(req.Cursor)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to decode cursor: %v", err)
+	}
+	pageSize := pagination.PageSize(req.PageSize)
+	postsDir := posts.NewDirectory(s.db)
+
+	params := posts.GetScheduledPostsParams{
+		Author:   userID,
+		PageSize: pageSize,
+	}
+	if cursor != nil {
+		params.HasCursor = true
+		params.CursorPublishAt = cursor.CreatedAt
+		params.CursorID = cursor.ID
+	}
+	scheduled, err := postsDir.GetScheduledPosts(ctx, params)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve scheduled posts: %v", err)
+	}
+
+	res := &v1API.GetScheduledPostsResponse{
+		Posts: scheduled,
+	}
+	if len(scheduled) > 0 {
+		last := scheduled[len(scheduled)-1]
+		// Scheduled posts are paginated by publish time
+		res.NextCursor = pagination.NextCursor(len(scheduled), pageSize, pagination.Cursor{
+			CreatedAt: last.PublishAt.AsTime(),
+			ID:        last.Id,
+		})
+	}
+
+	return res, nil
+}
+
+// SchedulePost RPC schedules a draft to be published at a future time. Scheduled posts can be rescheduled
+func (s *unpaperServiceServer) SchedulePost(ctx context.Context, req *v1API.SchedulePostRequest) (*v1API.SchedulePostResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	if err := checkNotSuspended(ctx, s.db, userID); err != nil {
+		return nil, err
+	}
+	publishAt, err := futurePublishTime(req.PublishAt)
+	if err != nil {
+		return nil, err
+	}
+	postsDir := posts.NewDirectory(s.db)
+
+	p, err := postsDir.SchedulePost(ctx, posts.SchedulePostParams{
+		ID:        req.PostId,
+		Author:    userID,
+		PublishAt: publishAt,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.FailedPrecondition, "only unpublished posts of the user can be scheduled")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to schedule post: %v", err)
+	}
+
+	return &v1API.SchedulePostResponse{
+		Post: p,
+	}, nil
+}
+
+// CancelScheduledPost RPC moves a scheduled post back to the requesting user drafts
+func (s *unpaperServiceServer) CancelScheduledPost(ctx context.Context, req *v1API.CancelScheduledPostRequest) (*v1API.CancelScheduledPostResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	postsDir := posts.NewDirectory(s.db)
+
+	p, err := postsDir.UnschedulePost(ctx, posts.UnschedulePostParams{
+		ID:     req.PostId,
+		Author: userID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.FailedPrecondition, "post is not scheduled")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to cancel scheduled post: %v", err)
+	}
+
+	return &v1API.CancelScheduledPostResponse{
+		Post: p,
+	}, nil
+}
+
+// PublishPost RPC immediately publishes a draft or a scheduled post of the requesting user
+func (s *unpaperServiceServer) PublishPost(ctx context.Context, req *v1API.PublishPostRequest) (*v1API.PublishPostResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	if err := checkNotSuspended(ctx, s.db, userID); err != nil {
+		return nil, err
+	}
+	postsDir := posts.NewDirectory(s.db)
+
+	p, err := postsDir.PublishPost(ctx, posts.PublishPostParams{
+		ID:     req.PostId,
+		Author: userID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.FailedPrecondition, "only unpublished posts of the user can be published")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to publish post: %v", err)
+	}
+
+	s.onPostPublished(ctx, p)
+
+	if err := addPostsDetails(ctx, s.db, userID, []*v1API.Post{p}); err != nil {
+		return nil, err
+	}
+
+	return &v1API.PublishPostResponse{
+		Post: p,
+	}, nil
+}
+
+// PublishDuePosts publishes the scheduled posts whose publish time is past.
+// It is safe to run concurrently on every replica: each post is claimed, and delivered, by a single one
+func (s *unpaperServiceServer) PublishDuePosts(ctx context.Context) error {
+	postsDir := posts.NewDirectory(s.db)
+
+	for {
+		published, err := postsDir.PublishDuePosts(ctx, publishBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, p := range published {
+			s.onPostPublished(ctx, p)
+		}
+		if len(published) < publishBatchSize {
+			return nil
+		}
+	}
+}
+
+// RunWorkers starts the background publisher of scheduled posts, the notifier of closed polls
+// and the refresher of trending posts.
+// It runs every `interval` until the context is done
+func (s *unpaperServiceServer) RunWorkers(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := s.PublishDuePosts(ctx); err != nil {
+					logger.Log.Error("failed to publish scheduled posts", zap.Error(err))
+				}
+				if err := s.NotifyClosedPolls(ctx); err != nil {
+					logger.Log.Error("failed to notify closed polls", zap.Error(err))
+				}
+				if err := s.RefreshTrendingPosts(ctx); err != nil {
+					logger.Log.Error("failed to refresh trending posts", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,275 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"unicode/utf8"
+
+	"github.com/DagDigg/unpaper/backend/comments"
+	"github.com/DagDigg/unpaper/backend/edits"
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/dbentities"
+	"github.com/DagDigg/unpaper/backend/pkg/mdutils"
+	"github.com/DagDigg/unpaper/backend/posts"
+	"github.com/DagDigg/unpaper/backend/users"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxMessageLength is the length of the posts and comments message columns.
+// Longer messages are rejected, rather than truncated
+const maxMessageLength = 100
+
+// UpdatePost RPC updates the message of a post. Only the author can update the post
+func (s *unpaperServiceServer) UpdatePost(ctx context.Context, req *v1API.UpdatePostRequest) (*v1API.UpdatePostResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	if err := checkNotSuspended(ctx, s.db, userID); err != nil {
+		return nil, err
+	}
+	if req.Message == "" {
+		return nil, status.Error(codes.InvalidArgument, "post message cannot be empty")
+	}
+	if utf8.RuneCountInString(req.Message) > maxMessageLength {
+		return nil, status.Errorf(codes.InvalidArgument, "post message cannot be longer than %d characters", maxMessageLength)
+	}
+	postsDir := posts.NewDirectory(s.db)
+
+	post, err := getOwnPost(ctx, postsDir, userID, req.PostId)
+	if err != nil {
+		return nil, err
+	}
+
+	mentions, err := s.resolveMentions(ctx, userID, req.Message)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resolve mentions: %v", err)
+	}
+	rawMentions, err := dbentities.NewMentionsRawJSON(mentions)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not convert mentions to raw json: %v", err)
+	}
+
+	updated, err := postsDir.UpdatePostMessage(ctx, posts.UpdatePostMessageParams{
+		ID:       req.PostId,
+		Author:   userID,
+		Message:  req.Message,
+		Mentions: rawMentions,
+		EditID:   uuid.NewString(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.FailedPrecondition, "post has been deleted")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update post: %v", err)
+	}
+
+	// Mentions and hashtags of unpublished posts are recorded on publication
+	if updated.Status == v1API.PostStatus_PUBLISHED {
+		s.setHashtags(ctx, updated.Id, updated.Id, updated.Message)
+		s.notifyMentions(notifyMentionsParams{
+			ctx:          ctx,
+			senderUserID: userID,
+			triggerID:    updated.Id,
+			postID:       updated.Id,
+			content:      updated.Message,
+			mentions:     addedMentions(post.Mentions, updated.Mentions),
+		})
+	}
+
+	if err := addPostsDetails(ctx, s.db, userID, []*v1API.Post{updated}); err != nil {
+		return nil, err
+	}
+
+	return &v1API.UpdatePostResponse{
+		Post: updated,
+	}, nil
+}
+
+// DeletePost RPC soft deletes a post, replacing its content with a placeholder. Only the author can delete the post
+func (s *unpaperServiceServer) DeletePost(ctx context.Context, req *v1API.DeletePostRequest) (*v1API.DeletePostResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	postsDir := posts.NewDirectory(s.db)
+
+	if _, err := getOwnPost(ctx, postsDir, userID, req.PostId); err != nil {
+		return nil, err
+	}
+
+	deleted, err := postsDir.SoftDeletePost(ctx, posts.SoftDeletePostParams{
+		ID:     req.PostId,
+		Author: userID,
+		EditID: uuid.NewString(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.FailedPrecondition, "post has already been deleted")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete post: %v", err)
+	}
+
+	return &v1API.DeletePostResponse{
+		Post: deleted,
+	}, nil
+}
+
+// getOwnPost returns the post, checking that it has been written by the user and has not been deleted
+func getOwnPost(ctx context.Context, postsDir *posts.Directory, userID, postID string) (*v1API.Post, error) {
+	post, err := postsDir.GetPost(ctx, postID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "post %q not found", postID)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to retrieve post: %v", err)
+	}
+	if post.Author != userID {
+		return nil, status.Error(codes.PermissionDenied, "only the author can edit or delete the post")
+	}
+	if post.Deleted {
+		return nil, status.Error(codes.FailedPrecondition, "post has been deleted")
+	}
+
+	return post, nil
+}
+
+// UpdateComment RPC updates the message of a comment. Only the author can update the comment
+func (s *unpaperServiceServer) UpdateComment(ctx context.Context, req *v1API.UpdateCommentRequest) (*v1API.UpdateCommentResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	if err := checkNotSuspended(ctx, s.db, userID); err != nil {
+		return nil, err
+	}
+	if req.Message == "" {
+		return nil, status.Error(codes.InvalidArgument, "comment message cannot be empty")
+	}
+	if utf8.RuneCountInString(req.Message) > maxMessageLength {
+		return nil, status.Errorf(codes.InvalidArgument, "comment message cannot be longer than %d characters", maxMessageLength)
+	}
+	commentsDir := comments.NewDirectory(s.db)
+
+	c, err := getOwnComment(ctx, commentsDir, userID, req.CommentId)
+	if err != nil {
+		return nil, err
+	}
+
+	mentions, err := s.resolveMentions(ctx, userID, req.Message)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resolve mentions: %v", err)
+	}
+	rawMentions, err := dbentities.NewMentionsRawJSON(mentions)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not convert mentions to raw json: %v", err)
+	}
+
+	updated, err := commentsDir.UpdateCommentMessage(ctx, comments.UpdateCommentMessageParams{
+		ID:       req.CommentId,
+		Author:   userID,
+		Message:  req.Message,
+		Mentions: rawMentions,
+		EditID:   uuid.NewString(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.FailedPrecondition, "comment has been deleted")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update comment: %v", err)
+	}
+
+	s.setHashtags(ctx, updated.PostId, updated.Id, updated.Message)
+	s.notifyMentions(notifyMentionsParams{
+		ctx:          ctx,
+		senderUserID: userID,
+		triggerID:    updated.Id,
+		postID:       updated.PostId,
+		content:      updated.Message,
+		mentions:     addedMentions(c.Mentions, updated.Mentions),
+	})
+
+	return &v1API.UpdateCommentResponse{
+		Comment: updated,
+	}, nil
+}
+
+// DeleteComment RPC soft deletes a comment, replacing its content with a placeholder.
+// Replies to the comment are kept. Only the author can delete the comment
+func (s *unpaperServiceServer) DeleteComment(ctx context.Context, req *v1API.DeleteCommentRequest) (*v1API.DeleteCommentResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	commentsDir := comments.NewDirectory(s.db)
+
+	if _, err := getOwnComment(ctx, commentsDir, userID, req.CommentId); err != nil {
+		return nil, err
+	}
+
+	deleted, err := commentsDir.SoftDeleteComment(ctx, comments.SoftDeleteCommentParams{
+		ID:     req.CommentId,
+		Author: userID,
+		EditID: uuid.NewString(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.FailedPrecondition, "comment has already been deleted")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete comment: %v", err)
+	}
+
+	return &v1API.DeleteCommentResponse{
+		Comment: deleted,
+	}, nil
+}
+
+// getOwnComment returns the comment, checking that it has been written by the user and has not been deleted
+func getOwnComment(ctx context.Context, commentsDir *comments.Directory, userID, commentID string) (*v1API.Comment, error) {
+	c, err := commentsDir.GetComment(ctx, userID, commentID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "comment %q not found", commentID)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to retrieve comment: %v", err)
+	}
+	if c.Author != userID {
+		return nil, status.Error(codes.PermissionDenied, "only the author can edit or delete the comment")
+	}
+	if c.Deleted {
+		return nil, status.Error(codes.FailedPrecondition, "comment has been deleted")
+	}
+
+	return c, nil
+}
+
+// GetEditHistory RPC returns the edits and deletions of a post or a comment. Only moderators can inspect the history
+func (s *unpaperServiceServer) GetEditHistory(ctx context.Context, req *v1API.GetEditHistoryRequest) (*v1API.GetEditHistoryResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+
+	isModerator, err := users.NewDirectory(s.db).IsModerator(ctx, userID)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve user role: %v", err)
+	}
+	if !isModerator {
+		return nil, status.Error(codes.PermissionDenied, "only moderators can inspect the edit history")
+	}
+
+	targetType, err := edits.PBTargetTypeToPG(req.TargetType)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	history, err := edits.NewDirectory(s.db).GetEdits(ctx, targetType, req.TargetId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve edit history: %v", err)
+	}
+
+	return &v1API.GetEditHistoryResponse{
+		Edits: history,
+	}, nil
+}
@@ -0,0 +1,104 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/DagDigg/unpaper/backend/hashtags"
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/logger"
+	"github.com/DagDigg/unpaper/backend/pkg/mdutils"
+	"github.com/DagDigg/unpaper/backend/pkg/pagination"
+	"github.com/DagDigg/unpaper/backend/posts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultTrendingHashtags is the number of trending hashtags returned when no limit is requested
+	defaultTrendingHashtags = 10
+	// maxTrendingHashtags is the maximum number of trending hashtags a client can request
+	maxTrendingHashtags = 50
+)
+
+// setHashtags records the hashtags used in the message of a post or comment, identified by sourceID
+func (s *unpaperServiceServer) setHashtags(ctx context.Context, postID, sourceID, message string) {
+	err := hashtags.NewDirectory(s.db).SetHashtags(ctx, postID, sourceID, hashtags.Parse(message))
+	if err != nil {
+		// Do not throw error, hashtags only affect discovery
+		logger.Log.Error(err.Error())
+	}
+}
+
+// GetPostsByHashtag RPC retrieves a page of the posts using the hashtag, in the message or in comments, from the newest
+func (s *unpaperServiceServer) GetPostsByHashtag(ctx context.Context, req *v1API.GetPostsByHashtagRequest) (*v1API.GetPostsByHashtagResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	tags := hashtags.Parse("#" + posts.NormalizeTag(req.Hashtag))
+	if len(tags) != 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hashtag %q", req.Hashtag)
+	}
+	cursor, err := pagination.Decode(req.Cursor)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to decode cursor: %v", err)
+	}
+	pageSize := pagination.PageSize(req.PageSize)
+	postsDir := posts.NewDirectory(s.db)
+
+	params := posts.GetPostsByHashtagParams{
+		ViewerID: userID,
+		Hashtag:  tags[0],
+		PageSize: pageSize,
+	}
+	if cursor != nil {
+		params.HasCursor = true
+		params.CursorCreatedAt = cursor.CreatedAt
+		params.CursorID = cursor.ID
+	}
+	postsList, err := postsDir.GetPostsByHashtag(ctx, params)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve posts: %v", err)
+	}
+
+	if err := addPostsDetails(ctx, s.db, userID, postsList); err != nil {
+		return nil, err
+	}
+
+	res := &v1API.GetPostsByHashtagResponse{
+		Posts: postsList,
+	}
+	if len(postsList) > 0 {
+		last := postsList[len(postsList)-1]
+		res.NextCursor = pagination.NextCursor(len(postsList), pageSize, pagination.Cursor{
+			CreatedAt: last.CreatedAt.AsTime(),
+			ID:        last.Id,
+		})
+	}
+
+	return res, nil
+}
+
+// GetTrendingHashtags RPC retrieves the hottest hashtags within the requested window
+func (s *unpaperServiceServer) GetTrendingHashtags(ctx context.Context, req *v1API.GetTrendingHashtagsRequest) (*v1API.GetTrendingHashtagsResponse, error) {
+	window, err := hashtags.PBTrendWindowToDuration(req.Window)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultTrendingHashtags
+	}
+	if limit > maxTrendingHashtags {
+		limit = maxTrendingHashtags
+	}
+
+	trending, err := hashtags.NewDirectory(s.db).GetTrendingHashtags(ctx, window, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve trending hashtags: %v", err)
+	}
+
+	return &v1API.GetTrendingHashtagsResponse{
+		Hashtags: trending,
+	}, nil
+}
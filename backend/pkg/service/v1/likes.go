@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/DagDigg/unpaper/backend/comments"
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/mdutils"
+	"github.com/DagDigg/unpaper/backend/pkg/pagination"
+	"github.com/DagDigg/unpaper/backend/posts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetPostLikers RPC retrieves a page of the users who liked a post, from the latest like
+func (s *unpaperServiceServer) GetPostLikers(ctx context.Context, req *v1API.GetPostLikersRequest) (*v1API.GetPostLikersResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	if err := checkPostVisible(ctx, s.db, req.PostId, userID); err != nil {
+		return nil, err
+	}
+	cursor, err := pagination.Decode(req.Cursor)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to decode cursor: %v", err)
+	}
+	pageSize := pagination.PageSize(req.PageSize)
+
+	params := posts.GetPostLikersParams{
+		PostID:   req.PostId,
+		PageSize: pageSize,
+	}
+	if cursor != nil {
+		params.HasCursor = true
+		params.CursorCreatedAt = cursor.CreatedAt
+		params.CursorID = cursor.ID
+	}
+	likers, err := posts.NewDirectory(s.db).GetPostLikers(ctx, params)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve likers for post id %q: %v", req.PostId, err)
+	}
+
+	return &v1API.GetPostLikersResponse{
+		Likers:     likers,
+		NextCursor: likersNextCursor(likers, pageSize),
+	}, nil
+}
+
+// GetCommentLikers RPC retrieves a page of the users who liked a comment, from the latest like
+func (s *unpaperServiceServer) GetCommentLikers(ctx context.Context, req *v1API.GetCommentLikersRequest) (*v1API.GetCommentLikersResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	if _, err := getVisibleComment(ctx, s.db, req.CommentId, userID); err != nil {
+		return nil, err
+	}
+	cursor, err := pagination.Decode(req.Cursor)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to decode cursor: %v", err)
+	}
+	pageSize := pagination.PageSize(req.PageSize)
+
+	params := comments.GetCommentLikersParams{
+		CommentID: req.CommentId,
+		PageSize:  pageSize,
+	}
+	if cursor != nil {
+		params.HasCursor = true
+		params.CursorCreatedAt = cursor.CreatedAt
+		params.CursorID = cursor.ID
+	}
+	likers, err := comments.NewDirectory(s.db).GetCommentLikers(ctx, params)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve likers for comment id %q: %v", req.CommentId, err)
+	}
+
+	return &v1API.GetCommentLikersResponse{
+		Likers:     likers,
+		NextCursor: likersNextCursor(likers, pageSize),
+	}, nil
+}
+
+// likersNextCursor returns the cursor of the page following likers, keyed by like time and user id
+func likersNextCursor(likers []*v1API.Liker, pageSize int32) string {
+	if len(likers) == 0 {
+		return ""
+	}
+	last := likers[len(likers)-1]
+
+	return pagination.NextCursor(len(likers), pageSize, pagination.Cursor{
+		CreatedAt: last.LikedAt.AsTime(),
+		ID:        last.UserId,
+	})
+}
@@ -0,0 +1,127 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/DagDigg/unpaper/backend/blocks"
+	dbNotifications "github.com/DagDigg/unpaper/backend/notifications"
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/logger"
+	"github.com/DagDigg/unpaper/backend/pkg/mentions"
+	"github.com/DagDigg/unpaper/backend/pkg/notifications"
+	"github.com/DagDigg/unpaper/backend/posts"
+	"github.com/DagDigg/unpaper/backend/users"
+)
+
+// resolveMentions parses the `@username` tokens in text, resolving them to existing users.
+// Unknown usernames, and users who blocked or have been blocked by the author are skipped
+func (s *unpaperServiceServer) resolveMentions(ctx context.Context, authorID, text string) ([]*v1API.Mention, error) {
+	res := []*v1API.Mention{}
+	spans := mentions.Parse(text)
+	if len(spans) == 0 {
+		return res, nil
+	}
+
+	usersDir := users.NewDirectory(s.db)
+	blocksDir := blocks.NewDirectory(s.db)
+	// Username lookups, nil if the user cannot be mentioned
+	resolved := map[string]*v1API.User{}
+
+	for _, span := range spans {
+		usr, ok := resolved[span.Username]
+		if !ok {
+			u, err := usersDir.GetUserByUsername(ctx, span.Username)
+			if err != nil && err != sql.ErrNoRows {
+				return nil, err
+			}
+			if u != nil {
+				blocked, err := blocksDir.IsBlockedBetween(ctx, authorID, u.Id)
+				if err != nil {
+					return nil, err
+				}
+				if blocked {
+					u = nil
+				}
+			}
+			resolved[span.Username] = u
+			usr = u
+		}
+		if usr == nil {
+			continue
+		}
+
+		res = append(res, &v1API.Mention{
+			UserId:   usr.Id,
+			Username: usr.Username,
+			Start:    span.Start,
+			End:      span.End,
+		})
+	}
+
+	return res, nil
+}
+
+type notifyMentionsParams struct {
+	ctx          context.Context
+	senderUserID string
+	triggerID    string
+	// postID is the post the mentions belong to, either directly or through a comment.
+	// Users who cannot see the post are not notified
+	postID   string
+	content  string
+	mentions []*v1API.Mention
+}
+
+// notifyMentions sends a mention notification to every mentioned user, once per user.
+// Failures are logged and never returned
+func (s *unpaperServiceServer) notifyMentions(params notifyMentionsParams) {
+	notified := map[string]bool{}
+	for _, m := range params.mentions {
+		if notified[m.UserId] {
+			continue
+		}
+		notified[m.UserId] = true
+
+		if params.postID != "" {
+			ok, err := posts.NewDirectory(s.db).CanViewPost(params.ctx, params.postID, m.UserId)
+			if err != nil {
+				logger.Log.Error(err.Error())
+				continue
+			}
+			if !ok {
+				continue
+			}
+		}
+
+		_, err := s.nm.Send(notifications.SendNotificationParams{
+			Ctx:            params.ctx,
+			SenderUserID:   params.senderUserID,
+			ReceiverUserID: m.UserId,
+			TriggerID:      params.triggerID,
+			EventID:        string(dbNotifications.EventIDMention),
+			Content:        params.content,
+		})
+		if err != nil {
+			// Do not throw error on notification send failure
+			logger.Log.Error(err.Error())
+		}
+	}
+}
+
+// addedMentions returns the mentions of curr whose user was not mentioned in prev
+func addedMentions(prev, curr []*v1API.Mention) []*v1API.Mention {
+	mentioned := map[string]bool{}
+	for _, m := range prev {
+		mentioned[m.UserId] = true
+	}
+
+	res := []*v1API.Mention{}
+	for _, m := range curr {
+		if !mentioned[m.UserId] {
+			res = append(res, m)
+		}
+	}
+
+	return res
+}
@@ -6,6 +6,7 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/DagDigg/unpaper/backend/hashtags"
 	"github.com/DagDigg/unpaper/backend/mixes"
 	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
 	"github.com/DagDigg/unpaper/backend/pkg/dbentities"
@@ -76,24 +77,34 @@ func createAndReturnGetMixesRes(ctx context.Context, db *sql.DB, userID string)
 	}, nil
 }
 
+// mixHashtagsCount is the number of today trending hashtags used as mixes topics
+const mixHashtagsCount = 5
+
+// mixSeed is the topic of a mix along with its posts
+type mixSeed struct {
+	title    string
+	category string
+	postIDs  []string
+}
+
 func createMixes(ctx context.Context, db *sql.DB, userID string) ([]*v1API.Mix, error) {
-	categories := []string{"Mindfulness", "Art", "Sports"}
-	postsDir := posts.NewDirectory(db)
 	mixesDir := mixes.NewDirectory(db)
 
-	res := []*v1API.Mix{}
-
-	// Iterate for each category, then create a mix from the trending today topic for that category
-	for _, c := range categories {
-		// TODO: Posts should have categories (?) and query trending posts should be made with category
-		postIDs, err := postsDir.GetTrendingTodayPostIDs(ctx)
+	seeds, err := hashtagMixSeeds(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	// Fall back to the categories when nothing is trending
+	if len(seeds) == 0 {
+		seeds, err = categoryMixSeeds(ctx, db)
 		if err != nil {
 			return nil, err
 		}
-		if len(postIDs) == 0 {
-			// If no posts are found, no mixes can be created
-			continue
-		}
+	}
+
+	res := []*v1API.Mix{}
+
+	for _, seed := range seeds {
 		JSONBackground, err := dbentities.NewBackgroundRawJSON(&v1API.Background{
 			Fallback:        "#ffeb99",
 			BackgroundImage: FakeBackgroundImages[rand.Intn(len(FakeBackgroundImages))],
@@ -103,12 +114,12 @@ func createMixes(ctx context.Context, db *sql.DB, userID string) ([]*v1API.Mix,
 		}
 		mix, err := mixesDir.CreateUserMix(ctx, mixes.CreateUserMixParams{
 			ID:          uuid.NewString(),
-			Title:       c, // TODO: use something smarter
+			Title:       seed.title,
 			UserID:      userID,
-			PostIds:     postIDs,
+			PostIds:     seed.postIDs,
 			Background:  JSONBackground,
 			RequestedAt: time.Now().UTC(),
-			Category:    c,
+			Category:    seed.category,
 		})
 		if err != nil {
 			return nil, err
@@ -119,3 +130,58 @@ func createMixes(ctx context.Context, db *sql.DB, userID string) ([]*v1API.Mix,
 
 	return res, nil
 }
+
+// hashtagMixSeeds returns a mix topic for each of today trending hashtags, with their trending posts
+func hashtagMixSeeds(ctx context.Context, db *sql.DB) ([]mixSeed, error) {
+	postsDir := posts.NewDirectory(db)
+
+	trending, err := hashtags.NewDirectory(db).GetTrendingHashtags(ctx, 24*time.Hour, mixHashtagsCount)
+	if err != nil {
+		return nil, err
+	}
+
+	seeds := []mixSeed{}
+	for _, h := range trending {
+		postIDs, err := postsDir.GetTrendingPostIDsByHashtag(ctx, h.Name)
+		if err != nil {
+			return nil, err
+		}
+		if len(postIDs) == 0 {
+			// Hashtags used only in comments of older posts cannot make a mix
+			continue
+		}
+		seeds = append(seeds, mixSeed{
+			title:   "#" + h.Name,
+			postIDs: postIDs,
+		})
+	}
+
+	return seeds, nil
+}
+
+// categoryMixSeeds returns a mix topic for each category, with the trending posts of that category
+func categoryMixSeeds(ctx context.Context, db *sql.DB) ([]mixSeed, error) {
+	postsDir := posts.NewDirectory(db)
+
+	seeds := []mixSeed{}
+	for _, c := range posts.Categories {
+		if c.ID == posts.CategoryOther {
+			continue
+		}
+		postIDs, err := postsDir.GetTrendingPostIDsByCategory(ctx, c.ID)
+		if err != nil {
+			return nil, err
+		}
+		if len(postIDs) == 0 {
+			// If no posts are found, no mixes can be created
+			continue
+		}
+		seeds = append(seeds, mixSeed{
+			title:    c.Name,
+			category: c.ID,
+			postIDs:  postIDs,
+		})
+	}
+
+	return seeds, nil
+}
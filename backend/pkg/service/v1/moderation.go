@@ -0,0 +1,411 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/DagDigg/unpaper/backend/comments"
+	"github.com/DagDigg/unpaper/backend/moderation"
+	dbNotifications "github.com/DagDigg/unpaper/backend/notifications"
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/chat"
+	"github.com/DagDigg/unpaper/backend/pkg/mdutils"
+	"github.com/DagDigg/unpaper/backend/pkg/notifications"
+	"github.com/DagDigg/unpaper/backend/pkg/pagination"
+	"github.com/DagDigg/unpaper/backend/posts"
+	"github.com/DagDigg/unpaper/backend/users"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxSuspensionDays is the maximum length of a suspension
+const maxSuspensionDays = 365
+
+// reportTarget is the reported content, as seen by the reporter
+type reportTarget struct {
+	authorID       string
+	content        string
+	conversationID string
+}
+
+// Report RPC reports a post, a comment, a chat message or a user to the moderators.
+// Reports of the same target are aggregated
+func (s *unpaperServiceServer) Report(ctx context.Context, req *v1API.ReportRequest) (*empty.Empty, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	targetType, err := moderation.PBTargetTypeToPG(req.TargetType)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	reason, err := moderation.PBReasonToPG(req.Reason)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.TargetId == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing target_id")
+	}
+	if len(req.Details) > moderation.MaxDetailsLength {
+		return nil, status.Errorf(codes.InvalidArgument, "details cannot exceed %d characters", moderation.MaxDetailsLength)
+	}
+
+	target, err := s.getReportTarget(ctx, userID, targetType, req)
+	if err != nil {
+		return nil, err
+	}
+	if target.authorID == userID {
+		return nil, status.Error(codes.InvalidArgument, "cannot report your own content")
+	}
+
+	_, err = moderation.NewDirectory(s.db).CreateReport(ctx, moderation.CreateReportParams{
+		ID:             uuid.NewString(),
+		TargetType:     string(targetType),
+		TargetID:       req.TargetId,
+		ConversationID: target.conversationID,
+		TargetAuthorID: target.authorID,
+		Content:        target.content,
+		ReporterID:     userID,
+		Reason:         string(reason),
+		Details:        req.Details,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create report: %v", err)
+	}
+
+	return new(empty.Empty), nil
+}
+
+// getReportTarget retrieves the reported content, checking that the reporter can see it
+func (s *unpaperServiceServer) getReportTarget(ctx context.Context, userID string, targetType moderation.TargetType, req *v1API.ReportRequest) (*reportTarget, error) {
+	switch targetType {
+	case moderation.TargetTypePost:
+		post, err := getAvailablePost(ctx, s.db, req.TargetId, userID)
+		if err != nil {
+			return nil, err
+		}
+		return &reportTarget{authorID: post.Author, content: post.Message}, nil
+
+	case moderation.TargetTypeComment:
+		c, err := comments.NewDirectory(s.db).GetComment(ctx, userID, req.TargetId)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, status.Errorf(codes.NotFound, "comment %q not found", req.TargetId)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to retrieve comment: %v", err)
+		}
+		if err := checkPostVisible(ctx, s.db, c.PostId, userID); err != nil {
+			return nil, err
+		}
+		if c.Deleted {
+			return nil, status.Error(codes.FailedPrecondition, "comment has been deleted")
+		}
+		return &reportTarget{authorID: c.Author, content: c.Message}, nil
+
+	case moderation.TargetTypeChatMessage:
+		if req.ConversationId == "" {
+			return nil, status.Error(codes.InvalidArgument, "missing conversation_id")
+		}
+		msg, err := s.chat.GetMessage(ctx, userID, req.ConversationId, req.TargetId)
+		if err != nil {
+			if err == chat.ErrMessageNotFound {
+				return nil, status.Errorf(codes.NotFound, "message %q not found", req.TargetId)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to retrieve message: %v", err)
+		}
+		return &reportTarget{authorID: msg.UserID, content: msg.Text.Content, conversationID: req.ConversationId}, nil
+
+	default:
+		user, err := users.NewDirectory(s.db).GetUser(ctx, req.TargetId)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, status.Errorf(codes.NotFound, "user %q not found", req.TargetId)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to retrieve user: %v", err)
+		}
+		return &reportTarget{authorID: user.Id, content: user.Username}, nil
+	}
+}
+
+// ListReports RPC retrieves a page of the reports with the requested status, from the oldest opened.
+// Only moderators can list reports
+func (s *unpaperServiceServer) ListReports(ctx context.Context, req *v1API.ListReportsRequest) (*v1API.ListReportsResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	if err := checkModerator(ctx, s.db, userID); err != nil {
+		return nil, err
+	}
+	reportStatus, err := moderation.PBStatusToPG(req.Status)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cursor, err := pagination.Decode(req.Cursor)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to decode cursor: %v", err)
+	}
+	pageSize := pagination.PageSize(req.PageSize)
+
+	params := moderation.ListReportsParams{
+		Status:   string(reportStatus),
+		PageSize: pageSize,
+	}
+	if cursor != nil {
+		params.HasCursor = true
+		params.CursorOpenedAt = cursor.CreatedAt
+		params.CursorID = cursor.ID
+	}
+	reports, err := moderation.NewDirectory(s.db).ListReports(ctx, params)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve reports: %v", err)
+	}
+
+	res := &v1API.ListReportsResponse{
+		Reports: reports,
+	}
+	if len(reports) > 0 {
+		last := reports[len(reports)-1]
+		res.NextCursor = pagination.NextCursor(len(reports), pageSize, pagination.Cursor{
+			CreatedAt: last.OpenedAt.AsTime(),
+			ID:        last.Id,
+		})
+	}
+
+	return res, nil
+}
+
+// ClaimReport RPC assigns an open report to the requesting moderator for review.
+// A claim not resolved within moderation.ClaimTTL can be taken over by another moderator
+func (s *unpaperServiceServer) ClaimReport(ctx context.Context, req *v1API.ClaimReportRequest) (*v1API.ClaimReportResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	if err := checkModerator(ctx, s.db, userID); err != nil {
+		return nil, err
+	}
+	moderationDir := moderation.NewDirectory(s.db)
+
+	report, err := moderationDir.ClaimReport(ctx, moderation.ClaimReportParams{
+		ID:          req.ReportId,
+		ModeratorID: userID,
+		ActionID:    uuid.NewString(),
+	})
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return nil, status.Errorf(codes.Internal, "failed to claim report: %v", err)
+		}
+		if _, err := getReport(ctx, moderationDir, req.ReportId); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.FailedPrecondition, "report has been resolved or claimed by another moderator")
+	}
+
+	return &v1API.ClaimReportResponse{
+		Report: report,
+	}, nil
+}
+
+// ResolveReport RPC applies the moderator decision to the target of a report claimed by the requesting moderator
+func (s *unpaperServiceServer) ResolveReport(ctx context.Context, req *v1API.ResolveReportRequest) (*v1API.ResolveReportResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	if err := checkModerator(ctx, s.db, userID); err != nil {
+		return nil, err
+	}
+	action, err := moderation.PBResolutionToPG(req.Action)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if action == moderation.ActionSuspend && (req.SuspensionDays <= 0 || req.SuspensionDays > maxSuspensionDays) {
+		return nil, status.Errorf(codes.InvalidArgument, "suspension_days must be between 1 and %d", maxSuspensionDays)
+	}
+	moderationDir := moderation.NewDirectory(s.db)
+
+	report, err := getReport(ctx, moderationDir, req.ReportId)
+	if err != nil {
+		return nil, err
+	}
+	if report.Status != v1API.ReportStatus_CLAIMED || report.ClaimedBy != userID {
+		return nil, status.Error(codes.FailedPrecondition, "report must be claimed before being resolved")
+	}
+
+	// The action is applied within the resolution, so that a claim taken over meanwhile does not apply it twice
+	resolved, err := moderationDir.ResolveReport(ctx, moderation.ResolveReportParams{
+		ID:          req.ReportId,
+		ModeratorID: userID,
+		Action:      string(action),
+		Note:        req.Note,
+		ActionID:    uuid.NewString(),
+	}, func(r *v1API.Report) error {
+		return s.applyModerationAction(ctx, userID, action, r, req)
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.FailedPrecondition, "report claim has been taken over by another moderator")
+		}
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to resolve report: %v", err)
+	}
+
+	return &v1API.ResolveReportResponse{
+		Report: resolved,
+	}, nil
+}
+
+// applyModerationAction applies the resolution to the target of the report, or to its author
+func (s *unpaperServiceServer) applyModerationAction(ctx context.Context, moderatorID string, action moderation.Action, report *v1API.Report, req *v1API.ResolveReportRequest) error {
+	switch action {
+	case moderation.ActionRemoveContent:
+		return s.removeReportedContent(ctx, moderatorID, report)
+
+	case moderation.ActionWarn:
+		_, err := s.nm.Send(notifications.SendNotificationParams{
+			Ctx:             ctx,
+			SenderUserID:    moderatorID,
+			ReceiverUserID:  report.TargetAuthorId,
+			TriggerID:       report.TargetId,
+			EventID:         string(dbNotifications.EventIDModerationWarning),
+			ResendCondition: notifications.ResendConditionAfter(0),
+			Content:         req.Note,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to warn user: %v", err)
+		}
+
+	case moderation.ActionSuspend:
+		until := time.Now().UTC().AddDate(0, 0, int(req.SuspensionDays))
+		if err := users.NewDirectory(s.db).SuspendUser(ctx, report.TargetAuthorId, until); err != nil {
+			return status.Errorf(codes.Internal, "failed to suspend user: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// removeReportedContent removes the reported content on behalf of its author.
+// Content already removed is left untouched
+func (s *unpaperServiceServer) removeReportedContent(ctx context.Context, moderatorID string, report *v1API.Report) error {
+	switch report.TargetType {
+	case v1API.ReportTargetType_POST:
+		_, err := posts.NewDirectory(s.db).SoftDeletePost(ctx, posts.SoftDeletePostParams{
+			ID:       report.TargetId,
+			Author:   report.TargetAuthorId,
+			EditorID: moderatorID,
+			EditID:   uuid.NewString(),
+		})
+		if err != nil && err != sql.ErrNoRows {
+			return status.Errorf(codes.Internal, "failed to remove post: %v", err)
+		}
+
+	case v1API.ReportTargetType_COMMENT:
+		_, err := comments.NewDirectory(s.db).SoftDeleteComment(ctx, comments.SoftDeleteCommentParams{
+			ID:       report.TargetId,
+			Author:   report.TargetAuthorId,
+			EditorID: moderatorID,
+			EditID:   uuid.NewString(),
+		})
+		if err != nil && err != sql.ErrNoRows {
+			return status.Errorf(codes.Internal, "failed to remove comment: %v", err)
+		}
+
+	case v1API.ReportTargetType_CHAT_MESSAGE:
+		err := s.chat.DeleteMessage(ctx, report.ConversationId, report.TargetId)
+		if err != nil && err != chat.ErrMessageNotFound {
+			return status.Errorf(codes.Internal, "failed to remove message: %v", err)
+		}
+
+	default:
+		return status.Error(codes.InvalidArgument, "users have no content to remove")
+	}
+
+	return nil
+}
+
+// GetModerationLog RPC returns the audit trail of a report. Only moderators can inspect it
+func (s *unpaperServiceServer) GetModerationLog(ctx context.Context, req *v1API.GetModerationLogRequest) (*v1API.GetModerationLogResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	if err := checkModerator(ctx, s.db, userID); err != nil {
+		return nil, err
+	}
+	moderationDir := moderation.NewDirectory(s.db)
+
+	if _, err := getReport(ctx, moderationDir, req.ReportId); err != nil {
+		return nil, err
+	}
+	entries, err := moderationDir.GetModerationLog(ctx, req.ReportId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve moderation log: %v", err)
+	}
+
+	return &v1API.GetModerationLogResponse{
+		Entries: entries,
+	}, nil
+}
+
+func getReport(ctx context.Context, moderationDir *moderation.Directory, reportID string) (*v1API.Report, error) {
+	report, err := moderationDir.GetReport(ctx, reportID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "report %q not found", reportID)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to retrieve report: %v", err)
+	}
+
+	return report, nil
+}
+
+// checkModerator returns PermissionDenied if the user is not a moderator
+func checkModerator(ctx context.Context, db *sql.DB, userID string) error {
+	isModerator, err := users.NewDirectory(db).IsModerator(ctx, userID)
+	if err != nil && err != sql.ErrNoRows {
+		return status.Errorf(codes.Internal, "failed to retrieve user role: %v", err)
+	}
+	if !isModerator {
+		return status.Error(codes.PermissionDenied, "only moderators can review reports")
+	}
+
+	return nil
+}
+
+// checkNotSuspended returns PermissionDenied if the user is suspended from publishing content.
+// Every RPC publishing content or interacting with other users must check it, while
+// reading and removing the user's own content is still allowed
+func checkNotSuspended(ctx context.Context, db *sql.DB, userID string) error {
+	until, err := users.NewDirectory(db).GetSuspendedUntil(ctx, userID)
+	if err != nil && err != sql.ErrNoRows {
+		return status.Errorf(codes.Internal, "failed to retrieve user suspension: %v", err)
+	}
+	if until.After(time.Now()) {
+		return status.Errorf(codes.PermissionDenied, "account suspended until %s", until.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// suspendedSenderCheck returns the check of the scheduled chat messages senders,
+// so that the messages of users suspended after scheduling them are dropped
+func suspendedSenderCheck(db *sql.DB) chat.SenderCheck {
+	return func(ctx context.Context, userID string) error {
+		until, err := users.NewDirectory(db).GetSuspendedUntil(ctx, userID)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		if until.After(time.Now()) {
+			return chat.ErrSenderSuspended
+		}
+
+		return nil
+	}
+}
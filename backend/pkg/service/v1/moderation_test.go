@@ -0,0 +1,198 @@
+package v1_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	v1Testing "github.com/DagDigg/unpaper/backend/pkg/service/v1/testing"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestReports(t *testing.T) {
+	t.Parallel()
+	ws := v1Testing.GetWrappedServer(t)
+	assert := assert.New(t)
+
+	t.Run("When reporting a post and moderating it", func(t *testing.T) {
+		author, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		reporter, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		otherReporter, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		moderator, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		_, err = ws.Server.GetDB().Exec("UPDATE users SET is_moderator = true WHERE id = $1", moderator.Id)
+		assert.Nil(err)
+		authorCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", author.Id))
+		reporterCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", reporter.Id))
+		otherReporterCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", otherReporter.Id))
+		moderatorCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", moderator.Id))
+
+		created, err := ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{Message: "abusive"})
+		assert.Nil(err)
+
+		// Own content cannot be reported
+		_, err = ws.Server.Report(authorCtx, &v1API.ReportRequest{TargetType: v1API.ReportTargetType_POST, TargetId: created.Post.Id})
+		assert.Equal(codes.InvalidArgument, status.Code(err))
+
+		// Repeated reports are aggregated, and a reporter counts once
+		for _, ctx := range []context.Context{reporterCtx, reporterCtx, otherReporterCtx} {
+			_, err = ws.Server.Report(ctx, &v1API.ReportRequest{
+				TargetType: v1API.ReportTargetType_POST,
+				TargetId:   created.Post.Id,
+				Reason:     v1API.ReportReason_HARASSMENT,
+			})
+			assert.Nil(err)
+		}
+
+		// Only moderators can review reports
+		_, err = ws.Server.ListReports(reporterCtx, &v1API.ListReportsRequest{})
+		assert.Equal(codes.PermissionDenied, status.Code(err))
+
+		var report *v1API.Report
+		listed, err := ws.Server.ListReports(moderatorCtx, &v1API.ListReportsRequest{Status: v1API.ReportStatus_OPEN, PageSize: 100})
+		assert.Nil(err)
+		for _, r := range listed.Reports {
+			if r.TargetId == created.Post.Id {
+				report = r
+			}
+		}
+		assert.NotNil(report)
+		assert.Equal(int32(2), report.ReportsCount)
+		assert.Len(report.Entries, 2)
+		assert.Equal(author.Id, report.TargetAuthorId)
+
+		// Reports must be claimed before being resolved
+		_, err = ws.Server.ResolveReport(moderatorCtx, &v1API.ResolveReportRequest{ReportId: report.Id, Action: v1API.ModerationAction_DISMISS})
+		assert.Equal(codes.FailedPrecondition, status.Code(err))
+		claimed, err := ws.Server.ClaimReport(moderatorCtx, &v1API.ClaimReportRequest{ReportId: report.Id})
+		assert.Nil(err)
+		assert.Equal(v1API.ReportStatus_CLAIMED, claimed.Report.Status)
+
+		resolved, err := ws.Server.ResolveReport(moderatorCtx, &v1API.ResolveReportRequest{
+			ReportId: report.Id,
+			Action:   v1API.ModerationAction_REMOVE_CONTENT,
+			Note:     "harassment",
+		})
+		assert.Nil(err)
+		assert.Equal(v1API.ReportStatus_RESOLVED, resolved.Report.Status)
+		assert.Equal(v1API.ModerationAction_REMOVE_CONTENT, resolved.Report.Resolution)
+
+		post, err := ws.Server.GetPost(authorCtx, &v1API.GetPostRequest{PostId: created.Post.Id})
+		assert.Nil(err)
+		assert.True(post.Post.Deleted)
+
+		log, err := ws.Server.GetModerationLog(moderatorCtx, &v1API.GetModerationLogRequest{ReportId: report.Id})
+		assert.Nil(err)
+		assert.Len(log.Entries, 2)
+		assert.Equal(v1API.ModerationAction_CLAIM, log.Entries[0].Action)
+		assert.Equal(v1API.ModerationAction_REMOVE_CONTENT, log.Entries[1].Action)
+	})
+
+	t.Run("When suspending a user", func(t *testing.T) {
+		author, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		reporter, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		moderator, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		_, err = ws.Server.GetDB().Exec("UPDATE users SET is_moderator = true WHERE id = $1", moderator.Id)
+		assert.Nil(err)
+		authorCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", author.Id))
+		reporterCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", reporter.Id))
+		moderatorCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", moderator.Id))
+		own, err := ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{Message: "before"})
+		assert.Nil(err)
+		other, err := ws.Server.CreatePost(reporterCtx, &v1API.CreatePostRequest{Message: "other"})
+		assert.Nil(err)
+		scheduled, err := ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{
+			Message:   "later",
+			PublishAt: timestamppb.New(time.Now().Add(time.Hour)),
+		})
+		assert.Nil(err)
+
+		_, err = ws.Server.Report(reporterCtx, &v1API.ReportRequest{
+			TargetType: v1API.ReportTargetType_USER,
+			TargetId:   author.Id,
+			Reason:     v1API.ReportReason_SPAM,
+		})
+		assert.Nil(err)
+		listed, err := ws.Server.ListReports(moderatorCtx, &v1API.ListReportsRequest{Status: v1API.ReportStatus_OPEN, PageSize: 100})
+		assert.Nil(err)
+		reportID := ""
+		for _, r := range listed.Reports {
+			if r.TargetId == author.Id {
+				reportID = r.Id
+			}
+		}
+		_, err = ws.Server.ClaimReport(moderatorCtx, &v1API.ClaimReportRequest{ReportId: reportID})
+		assert.Nil(err)
+
+		// Users have no content to remove
+		_, err = ws.Server.ResolveReport(moderatorCtx, &v1API.ResolveReportRequest{ReportId: reportID, Action: v1API.ModerationAction_REMOVE_CONTENT})
+		assert.Equal(codes.InvalidArgument, status.Code(err))
+		log, err := ws.Server.GetModerationLog(moderatorCtx, &v1API.GetModerationLogRequest{ReportId: reportID})
+		assert.Nil(err)
+		assert.Len(log.Entries, 1)
+
+		// Concurrent resolutions apply the action once
+		errs := make(chan error, 2)
+		for i := 0; i < 2; i++ {
+			go func() {
+				_, err := ws.Server.ResolveReport(moderatorCtx, &v1API.ResolveReportRequest{ReportId: reportID, Action: v1API.ModerationAction_SUSPEND, SuspensionDays: 7})
+				errs <- err
+			}()
+		}
+		failed := []codes.Code{}
+		for i := 0; i < 2; i++ {
+			if err := <-errs; err != nil {
+				failed = append(failed, status.Code(err))
+			}
+		}
+		assert.Equal([]codes.Code{codes.FailedPrecondition}, failed)
+		log, err = ws.Server.GetModerationLog(moderatorCtx, &v1API.GetModerationLogRequest{ReportId: reportID})
+		assert.Nil(err)
+		assert.Len(log.Entries, 2)
+
+		_, err = ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{Message: "still here"})
+		assert.Equal(codes.PermissionDenied, status.Code(err))
+		_, err = ws.Server.UpdatePost(authorCtx, &v1API.UpdatePostRequest{PostId: own.Post.Id, Message: "edited"})
+		assert.Equal(codes.PermissionDenied, status.Code(err))
+		_, err = ws.Server.Repost(authorCtx, &v1API.RepostRequest{PostId: other.Post.Id})
+		assert.Equal(codes.PermissionDenied, status.Code(err))
+		_, err = ws.Server.LikePost(authorCtx, &v1API.LikePostRequest{PostId: other.Post.Id})
+		assert.Equal(codes.PermissionDenied, status.Code(err))
+		_, err = ws.Server.FollowUser(authorCtx, &v1API.FollowUserRequest{UserIdToFollow: reporter.Id})
+		assert.Equal(codes.PermissionDenied, status.Code(err))
+		// Authors can still remove their content
+		_, err = ws.Server.DeletePost(authorCtx, &v1API.DeletePostRequest{PostId: own.Post.Id})
+		assert.Nil(err)
+
+		// Scheduled posts are held until the suspension ends
+		_, err = ws.Server.GetDB().Exec("UPDATE posts SET publish_at = now() WHERE id = $1", scheduled.Post.Id)
+		assert.Nil(err)
+		assert.Nil(ws.Server.PublishDuePosts(context.Background()))
+		pending, err := ws.Server.GetScheduledPosts(authorCtx, &v1API.GetScheduledPostsRequest{})
+		assert.Nil(err)
+		assert.Len(pending.Posts, 1)
+
+		// Reporting a resolved target opens a new round
+		_, err = ws.Server.Report(reporterCtx, &v1API.ReportRequest{
+			TargetType: v1API.ReportTargetType_USER,
+			TargetId:   author.Id,
+			Reason:     v1API.ReportReason_SPAM,
+		})
+		assert.Nil(err)
+		reopened, err := ws.Server.ClaimReport(moderatorCtx, &v1API.ClaimReportRequest{ReportId: reportID})
+		assert.Nil(err)
+		assert.Equal(int32(1), reopened.Report.ReportsCount)
+		assert.Empty(reopened.Report.ResolvedBy)
+	})
+}
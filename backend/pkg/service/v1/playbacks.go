@@ -0,0 +1,113 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/mdutils"
+	"github.com/DagDigg/unpaper/backend/playbacks"
+	"github.com/DagDigg/unpaper/backend/posts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultAnalyticsRange is the analytics range used when no beginning is requested
+	defaultAnalyticsRange = 30 * 24 * time.Hour
+	// maxAnalyticsDailyRange is the maximum analytics range grouped by day
+	maxAnalyticsDailyRange = 366 * 24 * time.Hour
+	// maxAnalyticsHourlyRange is the maximum analytics range grouped by hour
+	maxAnalyticsHourlyRange = 31 * 24 * time.Hour
+)
+
+// RecordPlayback RPC records that the requesting user listened to the audio of a post
+func (s *unpaperServiceServer) RecordPlayback(ctx context.Context, req *v1API.RecordPlaybackRequest) (*v1API.RecordPlaybackResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	if req.ListenedMs < 0 {
+		return nil, status.Error(codes.InvalidArgument, "listened_ms cannot be negative")
+	}
+	post, err := getAvailablePost(ctx, s.db, req.PostId, userID)
+	if err != nil {
+		return nil, err
+	}
+	if post.Audio.GetDurationMs() == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "post has no audio")
+	}
+	if err := lockPosts(ctx, s.db, userID, []*v1API.Post{post}); err != nil {
+		return nil, err
+	}
+	if post.Locked {
+		return nil, status.Error(codes.FailedPrecondition, "post is reserved to supporters")
+	}
+	listenedMs := req.ListenedMs
+	if listenedMs > post.Audio.DurationMs {
+		listenedMs = post.Audio.DurationMs
+	}
+
+	res, err := playbacks.NewDirectory(s.db).RecordPlayback(ctx, req.PostId, userID, listenedMs, post.Audio.DurationMs)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "post %q not found", req.PostId)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to record playback: %v", err)
+	}
+
+	return &v1API.RecordPlaybackResponse{
+		Counted:    res.Counted,
+		PlaysCount: res.Plays,
+	}, nil
+}
+
+// GetPostAnalytics RPC retrieves the playback stats of a post, in total and by period.
+// Only the author can see the analytics of a post
+func (s *unpaperServiceServer) GetPostAnalytics(ctx context.Context, req *v1API.GetPostAnalyticsRequest) (*v1API.GetPostAnalyticsResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	granularity, err := playbacks.PBGranularityToPG(req.Granularity)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	until := time.Now().UTC()
+	if req.To != nil {
+		until = req.To.AsTime()
+	}
+	since := until.Add(-defaultAnalyticsRange)
+	if req.From != nil {
+		since = req.From.AsTime()
+	}
+	maxRange := maxAnalyticsDailyRange
+	if granularity == playbacks.GranularityHour {
+		maxRange = maxAnalyticsHourlyRange
+	}
+	if !since.Before(until) || until.Sub(since) > maxRange {
+		return nil, status.Errorf(codes.InvalidArgument, "range must be positive and at most %v", maxRange)
+	}
+
+	post, err := getOwnPost(ctx, posts.NewDirectory(s.db), userID, req.PostId)
+	if err != nil {
+		return nil, err
+	}
+
+	totals, periods, err := playbacks.NewDirectory(s.db).GetStats(ctx, playbacks.GetStatsParams{
+		PostID:      post.Id,
+		DurationMs:  post.Audio.GetDurationMs(),
+		Granularity: granularity,
+		Since:       since,
+		Until:       until,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve analytics: %v", err)
+	}
+
+	return &v1API.GetPostAnalyticsResponse{
+		Totals:  totals,
+		Periods: periods,
+	}, nil
+}
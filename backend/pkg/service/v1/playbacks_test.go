@@ -0,0 +1,74 @@
+package v1_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	v1Testing "github.com/DagDigg/unpaper/backend/pkg/service/v1/testing"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPlaybacks(t *testing.T) {
+	t.Parallel()
+	ws := v1Testing.GetWrappedServer(t)
+	assert := assert.New(t)
+
+	t.Run("When listening to an audio post", func(t *testing.T) {
+		author, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		listener, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		authorCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", author.Id))
+		listenerCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", listener.Id))
+
+		textPost, err := ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{Message: "text only"})
+		assert.Nil(err)
+		_, err = ws.Server.RecordPlayback(listenerCtx, &v1API.RecordPlaybackRequest{PostId: textPost.Post.Id})
+		assert.Equal(codes.FailedPrecondition, status.Code(err))
+
+		created, err := ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{Message: "audio", AudioBytes: testWAV()})
+		assert.Nil(err)
+		assert.Equal(int32(1000), created.Post.Audio.DurationMs)
+
+		// Repeated plays within the dedup window count once
+		res, err := ws.Server.RecordPlayback(listenerCtx, &v1API.RecordPlaybackRequest{PostId: created.Post.Id, ListenedMs: 5000})
+		assert.Nil(err)
+		assert.True(res.Counted)
+		assert.Equal(int32(1), res.PlaysCount)
+		res, err = ws.Server.RecordPlayback(listenerCtx, &v1API.RecordPlaybackRequest{PostId: created.Post.Id, ListenedMs: 500})
+		assert.Nil(err)
+		assert.False(res.Counted)
+		assert.Equal(int32(1), res.PlaysCount)
+
+		// Author plays are not counted
+		res, err = ws.Server.RecordPlayback(authorCtx, &v1API.RecordPlaybackRequest{PostId: created.Post.Id, ListenedMs: 1000})
+		assert.Nil(err)
+		assert.False(res.Counted)
+
+		// Only the author can see the analytics
+		_, err = ws.Server.GetPostAnalytics(listenerCtx, &v1API.GetPostAnalyticsRequest{PostId: created.Post.Id})
+		assert.Equal(codes.PermissionDenied, status.Code(err))
+		_, err = ws.Server.GetPostAnalytics(authorCtx, &v1API.GetPostAnalyticsRequest{
+			PostId:      created.Post.Id,
+			Granularity: v1API.AnalyticsGranularity_HOUR,
+			From:        timestamppb.New(time.Now().Add(-365 * 24 * time.Hour)),
+		})
+		assert.Equal(codes.InvalidArgument, status.Code(err))
+
+		analytics, err := ws.Server.GetPostAnalytics(authorCtx, &v1API.GetPostAnalyticsRequest{PostId: created.Post.Id})
+		assert.Nil(err)
+		assert.Equal(int32(1), analytics.Totals.Plays)
+		assert.Equal(int32(1), analytics.Totals.UniqueListeners)
+		// Listening past the end completes the playback, and the author playback is left out
+		assert.Equal(0.5, analytics.Totals.CompletionRate)
+		assert.Equal(0.75, analytics.Totals.AvgListenThrough)
+		assert.Len(analytics.Periods, 1)
+		assert.Equal(int32(1), analytics.Periods[0].UniqueListeners)
+	})
+}
@@ -0,0 +1,161 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	dbNotifications "github.com/DagDigg/unpaper/backend/notifications"
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/logger"
+	"github.com/DagDigg/unpaper/backend/pkg/mdutils"
+	"github.com/DagDigg/unpaper/backend/pkg/notifications"
+	"github.com/DagDigg/unpaper/backend/polls"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pollsBatchSize is the maximum number of closed polls notified by a single worker run
+const pollsBatchSize = 100
+
+// pbPollToPG validates the poll of a new post, returning the params to store it.
+// Polls of scheduled posts must stay open for a while after the publication
+func pbPollToPG(req *v1API.PollRequest, publishAt sql.NullTime) (*polls.CreatePollParams, error) {
+	if req == nil {
+		return nil, nil
+	}
+	options, err := polls.NormalizeOptions(req.Options)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid poll: %v", err)
+	}
+	if req.ClosesAt == nil || !req.ClosesAt.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "invalid poll closes_at")
+	}
+	closesAt := req.ClosesAt.AsTime().UTC()
+	opensAt := time.Now()
+	if publishAt.Valid {
+		opensAt = publishAt.Time
+	}
+	if d := closesAt.Sub(opensAt); d < polls.MinDuration || d > polls.MaxDuration {
+		return nil, status.Errorf(codes.InvalidArgument, "a poll must stay open between %v and %v", polls.MinDuration, polls.MaxDuration)
+	}
+
+	return &polls.CreatePollParams{
+		MultipleChoice: req.MultipleChoice,
+		ClosesAt:       closesAt,
+		Options:        options,
+	}, nil
+}
+
+// VotePoll RPC records the vote of the requesting user on the poll of a post.
+// Users vote once, and closed polls do not accept votes anymore
+func (s *unpaperServiceServer) VotePoll(ctx context.Context, req *v1API.VotePollRequest) (*v1API.VotePollResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	if err := checkNotSuspended(ctx, s.db, userID); err != nil {
+		return nil, err
+	}
+	post, err := getAvailablePost(ctx, s.db, req.PostId, userID)
+	if err != nil {
+		return nil, err
+	}
+	if post.Status != v1API.PostStatus_PUBLISHED {
+		return nil, status.Error(codes.FailedPrecondition, "post has not been published")
+	}
+	if err := lockPosts(ctx, s.db, userID, []*v1API.Post{post}); err != nil {
+		return nil, err
+	}
+	if post.Locked {
+		return nil, status.Error(codes.FailedPrecondition, "post is reserved to supporters")
+	}
+
+	pollsDir := polls.NewDirectory(s.db)
+	poll, err := getPoll(ctx, pollsDir, userID, req.PostId)
+	if err != nil {
+		return nil, err
+	}
+	if poll.Closed {
+		return nil, status.Error(codes.FailedPrecondition, "poll is closed")
+	}
+	if len(poll.OwnVotes) > 0 {
+		return nil, status.Error(codes.AlreadyExists, "poll already voted")
+	}
+	options, err := polls.NormalizeVote(poll, req.Options)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid vote: %v", err)
+	}
+
+	voted, err := pollsDir.VotePoll(ctx, req.PostId, userID, options)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to vote poll: %v", err)
+	}
+	if !voted {
+		// The poll closed, or the user voted, after it was retrieved
+		return nil, status.Error(codes.FailedPrecondition, "poll cannot be voted anymore")
+	}
+
+	poll, err = getPoll(ctx, pollsDir, userID, req.PostId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1API.VotePollResponse{
+		Poll: poll,
+	}, nil
+}
+
+// getPoll returns the poll of the post with its tallies and the votes of the user
+func getPoll(ctx context.Context, pollsDir *polls.Directory, userID, postID string) (*v1API.Poll, error) {
+	res, err := pollsDir.GetPollsByPostIDs(ctx, userID, []string{postID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve poll: %v", err)
+	}
+	poll, ok := res[postID]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "post %q has no poll", postID)
+	}
+
+	return poll, nil
+}
+
+// NotifyClosedPolls notifies the authors of the closed polls with their results.
+// It is safe to run concurrently on every replica: each poll is claimed, and notified, by a single one
+func (s *unpaperServiceServer) NotifyClosedPolls(ctx context.Context) error {
+	pollsDir := polls.NewDirectory(s.db)
+
+	for {
+		closed, err := pollsDir.ClaimClosedPolls(ctx, pollsBatchSize)
+		if err != nil {
+			return err
+		}
+		postIDs := []string{}
+		for _, p := range closed {
+			postIDs = append(postIDs, p.PostID)
+		}
+		results, err := pollsDir.GetPollsByPostIDs(ctx, "", postIDs)
+		if err != nil {
+			return err
+		}
+		for _, p := range closed {
+			_, err := s.nm.Send(notifications.SendNotificationParams{
+				Ctx:             ctx,
+				SenderUserID:    p.Author,
+				ReceiverUserID:  p.Author,
+				TriggerID:       p.PostID,
+				EventID:         string(dbNotifications.EventIDPollClosed),
+				ResendCondition: notifications.ResendConditionAfter(0),
+				Content:         polls.FormatResults(results[p.PostID]),
+				AllowSelf:       true,
+			})
+			if err != nil {
+				// Do not throw error, the poll results are still available on the post
+				logger.Log.Error(err.Error())
+			}
+		}
+		if len(closed) < pollsBatchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package v1_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dbNotifications "github.com/DagDigg/unpaper/backend/notifications"
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	v1Testing "github.com/DagDigg/unpaper/backend/pkg/service/v1/testing"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPolls(t *testing.T) {
+	t.Parallel()
+	ws := v1Testing.GetWrappedServer(t)
+	assert := assert.New(t)
+
+	t.Run("When voting a poll until it closes", func(t *testing.T) {
+		author, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		voter, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		authorCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", author.Id))
+		voterCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", voter.Id))
+		closesAt := timestamppb.New(time.Now().Add(time.Hour))
+
+		_, err = ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{
+			Message: "one option",
+			Poll:    &v1API.PollRequest{Options: []string{"yes"}, ClosesAt: closesAt},
+		})
+		assert.Equal(codes.InvalidArgument, status.Code(err))
+
+		created, err := ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{
+			Message: "which one?",
+			Poll:    &v1API.PollRequest{Options: []string{"yes", "no"}, ClosesAt: closesAt},
+		})
+		assert.Nil(err)
+		assert.Len(created.Post.Poll.Options, 2)
+		assert.False(created.Post.Poll.Closed)
+
+		// Single choice polls accept one option
+		_, err = ws.Server.VotePoll(voterCtx, &v1API.VotePollRequest{PostId: created.Post.Id, Options: []int32{0, 1}})
+		assert.Equal(codes.InvalidArgument, status.Code(err))
+		voted, err := ws.Server.VotePoll(voterCtx, &v1API.VotePollRequest{PostId: created.Post.Id, Options: []int32{1}})
+		assert.Nil(err)
+		assert.Equal(int32(1), voted.Poll.VotersCount)
+		assert.Equal(int32(1), voted.Poll.Options[1].Votes)
+		assert.Equal([]int32{1}, voted.Poll.OwnVotes)
+		_, err = ws.Server.VotePoll(voterCtx, &v1API.VotePollRequest{PostId: created.Post.Id, Options: []int32{0}})
+		assert.Equal(codes.AlreadyExists, status.Code(err))
+
+		res, err := ws.Server.GetPost(authorCtx, &v1API.GetPostRequest{PostId: created.Post.Id})
+		assert.Nil(err)
+		assert.Equal(int32(1), res.Post.Poll.Options[1].Votes)
+		assert.Empty(res.Post.Poll.OwnVotes)
+
+		// Closed polls are immutable, and the author is notified with the results
+		_, err = ws.Server.GetDB().Exec("UPDATE polls SET closes_at = $1 WHERE post_id = $2", time.Now().Add(-time.Minute), created.Post.Id)
+		assert.Nil(err)
+		_, err = ws.Server.VotePoll(authorCtx, &v1API.VotePollRequest{PostId: created.Post.Id, Options: []int32{0}})
+		assert.Equal(codes.FailedPrecondition, status.Code(err))
+
+		assert.Nil(ws.Server.NotifyClosedPolls(context.Background()))
+		notifications, err := dbNotifications.NewDirectory(ws.Server.GetDB()).GetAllNotifications(context.Background(), author.Id)
+		assert.Nil(err)
+		found := false
+		for _, n := range notifications {
+			if n.TriggerId == created.Post.Id && n.Event.Id == v1API.EventID_POLL_CLOSED {
+				found = true
+				assert.Equal("no: 1, yes: 0", n.Content)
+			}
+		}
+		assert.True(found)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"time"
 
+	"github.com/DagDigg/unpaper/backend/bookmarks"
 	"github.com/DagDigg/unpaper/backend/comments"
 	dbNotifications "github.com/DagDigg/unpaper/backend/notifications"
 	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
@@ -12,10 +13,15 @@ import (
 	"github.com/DagDigg/unpaper/backend/pkg/logger"
 	"github.com/DagDigg/unpaper/backend/pkg/mdutils"
 	"github.com/DagDigg/unpaper/backend/pkg/notifications"
+	"github.com/DagDigg/unpaper/backend/pkg/pagination"
+	"github.com/DagDigg/unpaper/backend/polls"
 	"github.com/DagDigg/unpaper/backend/posts"
+	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // CreatePost RPC for inserting a post in the datastore
@@ -24,81 +30,468 @@ func (s *unpaperServiceServer) CreatePost(ctx context.Context, req *v1API.Create
 	if !ok {
 		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
 	}
+	if err := checkNotSuspended(ctx, s.db, userID); err != nil {
+		return nil, err
+	}
+	category := req.Category
+	if category == "" {
+		category = posts.CategoryOther
+	}
+	if !posts.IsValidCategory(category) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid category %q", req.Category)
+	}
+	tags, err := posts.NormalizeTags(req.Tags)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tags: %v", err)
+	}
+	visibility, listIDs, err := pbVisibilityToPG(ctx, s.db, userID, req.Visibility, req.VisibilityListIds)
+	if err != nil {
+		return nil, err
+	}
+	postStatus, publishAt, err := requestedPostStatus(req)
+	if err != nil {
+		return nil, err
+	}
+	pollParams, err := pbPollToPG(req.Poll, publishAt)
+	if err != nil {
+		return nil, err
+	}
 	postsDir := posts.NewDirectory(s.db)
-	rawAudio, err := dbentities.NewAudioRawJSON(&v1API.Audio{
-		Id:         uuid.NewString(),
-		Bytes:      req.AudioBytes,
-		Format:     req.AudioFormat,
-		DurationMs: req.AudioDurationMs,
-	})
+	a, err := newAudio(req.AudioBytes)
+	if err != nil {
+		return nil, err
+	}
+	rawAudio, err := dbentities.NewAudioRawJSON(a)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "could not convert request audio to raw json: %v", err)
 	}
+	mentions, err := s.resolveMentions(ctx, userID, req.Message)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resolve mentions: %v", err)
+	}
+	rawMentions, err := dbentities.NewMentionsRawJSON(mentions)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not convert mentions to raw json: %v", err)
+	}
+	supportersRoomID, supportersPurchaseType, err := pbSupportersOnlyToPG(ctx, s.db, userID, req.SupportersOnly)
+	if err != nil {
+		return nil, err
+	}
+	var quoted *v1API.Post
+	if req.QuotedPostId != "" {
+		quoted, err = getAvailablePost(ctx, s.db, req.QuotedPostId, userID)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	p, err := postsDir.CreatePost(ctx, posts.CreatePostParams{
 		ID:        uuid.NewString(),
 		Author:    userID,
 		Message:   req.Message,
 		Audio:     rawAudio,
-		CreatedAt: sql.NullTime{Time: time.Now().UTC(), Valid: true},
+		CreatedAt: time.Now().UTC(),
+		Mentions:  rawMentions,
+		Category:  category,
+		Tags:      tags,
+		QuotedPostID: sql.NullString{
+			String: req.QuotedPostId,
+			Valid:  req.QuotedPostId != "",
+		},
+		Visibility:        string(visibility),
+		VisibilityListIds: listIDs,
+		Status:            string(postStatus),
+		PublishAt:         publishAt,
+
+		SupportersRoomID:       supportersRoomID,
+		SupportersPurchaseType: supportersPurchaseType,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "error inserting post on db: %v", err)
 	}
+	if pollParams != nil {
+		pollParams.PostID = p.Id
+		p.Poll, err = polls.NewDirectory(s.db).CreatePoll(ctx, *pollParams)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error inserting poll on db: %v", err)
+		}
+	}
+	if quoted != nil {
+		p.QuotedPost = quoted
+		if err := lockPosts(ctx, s.db, userID, []*v1API.Post{p}); err != nil {
+			return nil, err
+		}
+	}
+
+	// Drafts and scheduled posts reach followers and mentioned users once published
+	if p.Status == v1API.PostStatus_PUBLISHED {
+		s.onPostPublished(ctx, p)
+	}
 
 	return &v1API.CreatePostResponse{
 		Post: p,
 	}, nil
 }
 
-// GetPosts RPC retrieves posts
+// topCommentsPerPost is the number of comments embedded in every post of a listing.
+// Further comments are fetched on demand with the GetComments RPC
+const topCommentsPerPost = 3
+
+// GetPosts RPC retrieves a page of posts, from the newest
 func (s *unpaperServiceServer) GetPosts(ctx context.Context, req *v1API.GetPostsRequest) (*v1API.GetPostsResponse, error) {
 	userID, ok := mdutils.GetUserIDFromMD(ctx)
 	if !ok {
 		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
 	}
+	cursor, err := pagination.Decode(req.Cursor)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to decode cursor: %v", err)
+	}
+	pageSize := pagination.PageSize(req.PageSize)
 	postsDir := posts.NewDirectory(s.db)
-	commentsDir := comments.NewDirectory(s.db)
 
-	postsList, err := postsDir.GetPosts(ctx)
+	params := posts.GetPostsParams{
+		ViewerID: userID,
+		Category: req.Category,
+		Tag:      posts.NormalizeTag(req.Tag),
+		PageSize: pageSize,
+	}
+	if cursor != nil {
+		params.HasCursor = true
+		params.CursorCreatedAt = cursor.CreatedAt
+		params.CursorID = cursor.ID
+	}
+	postsList, err := postsDir.GetPosts(ctx, params)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to retrieve posts: %v", err)
 	}
 
-	// Add comments to posts
-	for i, p := range postsList {
-		comments, err := commentsDir.GetComments(ctx, userID, p.Id)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to retrieve comments for post id %q: %v", p.Id, err)
-		}
-		hasUserLiked, err := postsDir.HasUserLikedPost(ctx, posts.HasUserLikedPostParams{
-			ID:      p.Id,
-			Column2: userID,
-		})
-		postsList[i].HasAlreadyLiked = hasUserLiked
-		postsList[i].Comments = comments
+	if err := addPostsDetails(ctx, s.db, userID, postsList); err != nil {
+		return nil, err
 	}
 
-	return &v1API.GetPostsResponse{
+	res := &v1API.GetPostsResponse{
 		Posts: postsList,
+	}
+	if len(postsList) > 0 {
+		last := postsList[len(postsList)-1]
+		res.NextCursor = pagination.NextCursor(len(postsList), pageSize, pagination.Cursor{
+			CreatedAt: last.CreatedAt.AsTime(),
+			ID:        last.Id,
+		})
+	}
+
+	return res, nil
+}
+
+// addPostsDetails sets likes, bookmarks, reposts, quotes, comments and polls details on every post,
+// locking the supporters only posts the user cannot access,
+// running a fixed number of queries regardless of the number of posts
+func addPostsDetails(ctx context.Context, db *sql.DB, userID string, postsList []*v1API.Post) error {
+	if len(postsList) == 0 {
+		return nil
+	}
+	postsDir := posts.NewDirectory(db)
+	commentsDir := comments.NewDirectory(db)
+
+	postIDs := []string{}
+	for _, p := range postsList {
+		postIDs = append(postIDs, p.Id)
+	}
+
+	liked, err := postsDir.GetLikedPostIDs(ctx, userID, postIDs)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to retrieve liked posts: %v", err)
+	}
+	bookmarked, err := bookmarks.NewDirectory(db).GetBookmarkedPostIDs(ctx, userID, postIDs)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to retrieve bookmarked posts: %v", err)
+	}
+	reposted, err := postsDir.GetRepostedPostIDs(ctx, userID, postIDs)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to retrieve reposted posts: %v", err)
+	}
+	repostsCounts, err := postsDir.CountRepostsByPostIDs(ctx, postIDs)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to count posts reposts: %v", err)
+	}
+	quotesCounts, err := postsDir.CountQuotesByPostIDs(ctx, postIDs)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to count posts quotes: %v", err)
+	}
+	if err := setQuotedPosts(ctx, postsDir, userID, postsList); err != nil {
+		return status.Errorf(codes.Internal, "failed to retrieve quoted posts: %v", err)
+	}
+	if err := lockPosts(ctx, db, userID, postsList); err != nil {
+		return err
+	}
+	counts, err := commentsDir.CountCommentsByPostIDs(ctx, postIDs)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to count posts comments: %v", err)
+	}
+	topComments, err := commentsDir.GetTopCommentsByPostIDs(ctx, userID, postIDs, topCommentsPerPost)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to retrieve posts comments: %v", err)
+	}
+	postsPolls, err := polls.NewDirectory(db).GetPollsByPostIDs(ctx, userID, postIDs)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to retrieve posts polls: %v", err)
+	}
+
+	for _, p := range postsList {
+		p.HasAlreadyLiked = liked[p.Id]
+		p.IsBookmarked = bookmarked[p.Id]
+		p.HasAlreadyReposted = reposted[p.Id]
+		p.RepostsCount = repostsCounts[p.Id]
+		p.QuotesCount = quotesCounts[p.Id]
+		p.CommentsCount = counts[p.Id]
+		p.Comments = topComments[p.Id]
+		p.Poll = postsPolls[p.Id]
+		if p.Comments == nil || p.Locked {
+			p.Comments = []*v1API.Comment{}
+		}
+		// The poll is part of the locked content
+		if p.Locked {
+			p.Poll = nil
+		}
+	}
+
+	return nil
+}
+
+// GetHomeFeed RPC retrieves a page of the posts written by the followed users, from the newest
+func (s *unpaperServiceServer) GetHomeFeed(ctx context.Context, req *v1API.GetHomeFeedRequest) (*v1API.GetHomeFeedResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	cursor, err := pagination.Decode(req.Cursor)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to decode cursor: %v", err)
+	}
+	pageSize := pagination.PageSize(req.PageSize)
+
+	entries, err := s.timeline.GetPage(ctx, userID, cursor, pageSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve home timeline: %v", err)
+	}
+	if len(entries) == 0 {
+		return &v1API.GetHomeFeedResponse{Posts: []*v1API.Post{}}, nil
+	}
+
+	postIDs := []string{}
+	for _, e := range entries {
+		postIDs = append(postIDs, e.PostID)
+	}
+	postsList, err := posts.NewDirectory(s.db).GetPostsByIDs(ctx, userID, postIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve posts: %v", err)
+	}
+	byID := map[string]*v1API.Post{}
+	for _, p := range postsList {
+		byID[p.Id] = p
+	}
+	repostKeys := []posts.RepostKey{}
+	for _, e := range entries {
+		if e.RepostedBy != "" {
+			repostKeys = append(repostKeys, posts.RepostKey{PostID: e.PostID, UserID: e.RepostedBy})
+		}
+	}
+	reposts, err := posts.NewDirectory(s.db).GetExistingReposts(ctx, repostKeys)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve reposts: %v", err)
+	}
+	// Keep the timeline order, skipping entries whose post or repost does not exist anymore
+	feed := []*v1API.Post{}
+	for _, e := range entries {
+		p, ok := byID[e.PostID]
+		if !ok {
+			continue
+		}
+		if e.RepostedBy != "" {
+			if !reposts[posts.RepostKey{PostID: e.PostID, UserID: e.RepostedBy}] {
+				continue
+			}
+			// The same post can be both written and reposted by followed users
+			p = proto.Clone(p).(*v1API.Post)
+			p.RepostedBy = e.RepostedBy
+			p.RepostedAt = timestamppb.New(e.CreatedAt)
+		}
+		feed = append(feed, p)
+	}
+
+	if err := addPostsDetails(ctx, s.db, userID, feed); err != nil {
+		return nil, err
+	}
+
+	last := entries[len(entries)-1]
+	return &v1API.GetHomeFeedResponse{
+		Posts: feed,
+		NextCursor: pagination.NextCursor(len(entries), pageSize, pagination.Cursor{
+			CreatedAt: last.CreatedAt,
+			ID:        last.Key(),
+		}),
 	}, nil
 }
 
-// GetPosts RPC retrieves a single posts
-func (s *unpaperServiceServer) GetPost(ctx context.Context, req *v1API.GetPostRequest) (*v1API.GetPostResponse, error) {
+// GetComments RPC retrieves a page of the post comments in the requested order, from the oldest by default
+func (s *unpaperServiceServer) GetComments(ctx context.Context, req *v1API.GetCommentsRequest) (*v1API.GetCommentsResponse, error) {
 	userID, ok := mdutils.GetUserIDFromMD(ctx)
 	if !ok {
 		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
 	}
-	postsDir := posts.NewDirectory(s.db)
+	cursor, err := pagination.Decode(req.Cursor)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to decode cursor: %v", err)
+	}
+	sort, err := pbCommentSortToPG(req.Sort)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := pagination.PageSize(req.PageSize)
 	commentsDir := comments.NewDirectory(s.db)
+	if err := checkPostVisible(ctx, s.db, req.PostId, userID); err != nil {
+		return nil, err
+	}
+	if err := checkPostUnlocked(ctx, s.db, req.PostId, userID); err != nil {
+		return nil, err
+	}
+
+	params := comments.GetCommentsPageParams{
+		Sort:     string(sort),
+		PostID:   req.PostId,
+		PageSize: pageSize,
+	}
+	if cursor != nil {
+		params.HasCursor = true
+		params.CursorScore = cursor.Score
+		params.CursorID = cursor.ID
+	}
+	cmts, nextCursor, err := commentsDir.GetCommentsPage(ctx, userID, params)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve comments for post id %q: %v", req.PostId, err)
+	}
+
+	ids := []string{}
+	for _, c := range cmts {
+		ids = append(ids, c.Id)
+	}
+	s.recordCommentViews(ctx, userID, ids)
+
+	return &v1API.GetCommentsResponse{
+		Comments:   cmts,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// recordCommentViews records the comments served to the user, which rank them by likes over views
+func (s *unpaperServiceServer) recordCommentViews(ctx context.Context, userID string, ids []string) {
+	if err := comments.NewDirectory(s.db).RecordCommentViews(ctx, userID, ids); err != nil {
+		// Do not throw error, views only affect the ranking of the comments
+		logger.Log.Error(err.Error())
+	}
+}
+
+// commentNodesIDs returns the ids of the comments of the trees
+func commentNodesIDs(nodes []*v1API.CommentNode) []string {
+	ids := []string{}
+	for _, n := range nodes {
+		ids = append(ids, n.Comment.Id)
+		ids = append(ids, commentNodesIDs(n.Replies)...)
+	}
+
+	return ids
+}
+
+const (
+	// defaultCommentTreeDepth is the number of comment tree levels returned when not specified
+	defaultCommentTreeDepth = 3
+	// maxCommentTreeDepth is the maximum number of comment tree levels a client can request
+	maxCommentTreeDepth = 10
+)
+
+// GetCommentTree RPC retrieves a page of comments as a tree of nested replies
+func (s *unpaperServiceServer) GetCommentTree(ctx context.Context, req *v1API.GetCommentTreeRequest) (*v1API.GetCommentTreeResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	cursor, err := pagination.Decode(req.Cursor)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to decode cursor: %v", err)
+	}
+	sort, err := pbCommentSortToPG(req.Sort)
+	if err != nil {
+		return nil, err
+	}
+	maxDepth := req.MaxDepth
+	if maxDepth <= 0 {
+		maxDepth = defaultCommentTreeDepth
+	}
+	if maxDepth > maxCommentTreeDepth {
+		maxDepth = maxCommentTreeDepth
+	}
+	if err := checkPostVisible(ctx, s.db, req.PostId, userID); err != nil {
+		return nil, err
+	}
+	if err := checkPostUnlocked(ctx, s.db, req.PostId, userID); err != nil {
+		return nil, err
+	}
+
+	nodes, nextCursor, err := comments.NewDirectory(s.db).GetCommentTree(ctx, userID, comments.GetCommentTreeParams{
+		PostID:   req.PostId,
+		ParentID: req.ParentId,
+		Sort:     sort,
+		MaxDepth: maxDepth,
+		PageSize: pagination.PageSize(req.PageSize),
+		Cursor:   cursor,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve comments tree for post id %q: %v", req.PostId, err)
+	}
+	s.recordCommentViews(ctx, userID, commentNodesIDs(nodes))
 
+	return &v1API.GetCommentTreeResponse{
+		Nodes:      nodes,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func pbCommentSortToPG(s v1API.CommentSort_Enum) (comments.Sort, error) {
+	switch s {
+	case v1API.CommentSort_OLDEST:
+		return comments.SortOldest, nil
+	case v1API.CommentSort_NEWEST:
+		return comments.SortNewest, nil
+	case v1API.CommentSort_TOP:
+		return comments.SortTop, nil
+	case v1API.CommentSort_HOT:
+		return comments.SortHot, nil
+	case v1API.CommentSort_CONTROVERSIAL:
+		return comments.SortControversial, nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "invalid comments sort: %v", s)
+	}
+}
+
+// GetPostCategories RPC returns the posts categories taxonomy
+func (s *unpaperServiceServer) GetPostCategories(ctx context.Context, req *empty.Empty) (*v1API.GetPostCategoriesResponse, error) {
+	return &v1API.GetPostCategoriesResponse{
+		Categories: posts.CategoriesToPB(),
+	}, nil
+}
+
+// GetPosts RPC retrieves a single posts
+func (s *unpaperServiceServer) GetPost(ctx context.Context, req *v1API.GetPostRequest) (*v1API.GetPostResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
 	post, err := fetchPost(fetchPostParams{
-		ctx:         ctx,
-		postsDir:    postsDir,
-		commentsDir: commentsDir,
-		postID:      req.PostId,
-		userID:      userID,
+		ctx:    ctx,
+		db:     s.db,
+		postID: req.PostId,
+		userID: userID,
 	})
 	if err != nil {
 		return nil, err
@@ -115,11 +508,23 @@ func (s *unpaperServiceServer) CreateComment(ctx context.Context, req *v1API.Cre
 	if !ok {
 		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
 	}
+	if err := checkNotSuspended(ctx, s.db, userID); err != nil {
+		return nil, err
+	}
+	if err := checkPostVisible(ctx, s.db, req.PostId, userID); err != nil {
+		return nil, err
+	}
+	if err := checkPostUnlocked(ctx, s.db, req.PostId, userID); err != nil {
+		return nil, err
+	}
 	postsDir := posts.NewDirectory(s.db)
 	post, err := postsDir.GetPost(ctx, req.PostId)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to retrieve post for comment: %v", err)
 	}
+	if post.Deleted {
+		return nil, status.Error(codes.FailedPrecondition, "cannot comment on a deleted post")
+	}
 
 	if req.Thread == nil && req.Message == "" { // TODO: assert minimum length
 		return nil, status.Error(codes.Internal, "non threaded post must have a message")
@@ -132,14 +537,22 @@ func (s *unpaperServiceServer) CreateComment(ctx context.Context, req *v1API.Cre
 		}
 	}
 	commentsDir := comments.NewDirectory(s.db)
-	rawAudio, err := dbentities.NewAudioRawJSON(&v1API.Audio{
-		Id:         uuid.NewString(),
-		Bytes:      req.AudioBytes,
-		DurationMs: req.AudioDurationMs,
-	})
+	a, err := newAudio(req.AudioBytes)
+	if err != nil {
+		return nil, err
+	}
+	rawAudio, err := dbentities.NewAudioRawJSON(a)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "could not convert request audio to raw json: %v", err)
 	}
+	mentions, err := s.resolveMentions(ctx, userID, req.Message)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resolve mentions: %v", err)
+	}
+	rawMentions, err := dbentities.NewMentionsRawJSON(mentions)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not convert mentions to raw json: %v", err)
+	}
 	params := comments.CreateCommentParams{
 		ID:         uuid.NewString(),
 		Message:    sql.NullString{String: req.Message, Valid: req.Message != ""},
@@ -148,6 +561,7 @@ func (s *unpaperServiceServer) CreateComment(ctx context.Context, req *v1API.Cre
 		ParentID:   sql.NullString{String: req.ParentId, Valid: req.ParentId != ""},
 		PostID:     req.PostId,
 		ThreadType: string(comments.ThreadTypeNone), // Default to 'none'
+		Mentions:   rawMentions,
 	}
 	if req.Thread != nil {
 		params.ThreadTargetID = sql.NullString{String: req.Thread.TargetId, Valid: req.Thread.TargetId != ""}
@@ -162,6 +576,8 @@ func (s *unpaperServiceServer) CreateComment(ctx context.Context, req *v1API.Cre
 		return nil, status.Errorf(codes.Internal, "failed to create comment: %v", err)
 	}
 
+	s.setHashtags(ctx, c.PostId, c.Id, c.Message)
+
 	_, err = s.nm.Send(notifications.SendNotificationParams{
 		Ctx:            ctx,
 		SenderUserID:   userID,
@@ -175,6 +591,19 @@ func (s *unpaperServiceServer) CreateComment(ctx context.Context, req *v1API.Cre
 		logger.Log.Error(err.Error())
 	}
 
+	s.notifyMentions(notifyMentionsParams{
+		ctx:          ctx,
+		senderUserID: userID,
+		triggerID:    c.Id,
+		postID:       c.PostId,
+		content:      c.Message,
+		mentions:     c.Mentions,
+	})
+
+	if params.ThreadType == string(comments.ThreadTypePost) {
+		s.notifyThreadSubscribers(ctx, post, c)
+	}
+
 	return &v1API.CreateCommentResponse{
 		Comment: c,
 	}, nil
@@ -199,7 +628,13 @@ func (s *unpaperServiceServer) LikeComment(ctx context.Context, req *v1API.LikeC
 	if !ok {
 		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
 	}
+	if err := checkNotSuspended(ctx, s.db, userID); err != nil {
+		return nil, err
+	}
 	commentsDir := comments.NewDirectory(s.db)
+	if _, err := getVisibleComment(ctx, s.db, req.CommentId, userID); err != nil {
+		return nil, err
+	}
 
 	comment, err := commentsDir.LikeComment(ctx, comments.LikeCommentParams{
 		ID:     req.CommentId,
@@ -234,12 +669,17 @@ func (s *unpaperServiceServer) LikePost(ctx context.Context, req *v1API.LikePost
 	if !ok {
 		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
 	}
+	if err := checkNotSuspended(ctx, s.db, userID); err != nil {
+		return nil, err
+	}
 	postsDir := posts.NewDirectory(s.db)
-	commentsDir := comments.NewDirectory(s.db)
+	if err := checkPostVisible(ctx, s.db, req.PostId, userID); err != nil {
+		return nil, err
+	}
 
 	hasAlreadyLiked, err := postsDir.HasUserLikedPost(ctx, posts.HasUserLikedPostParams{
-		ID:      req.PostId,
-		Column2: userID,
+		PostID: req.PostId,
+		UserID: userID,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "could not retrieve 'user has already liked post': %v", err)
@@ -257,12 +697,14 @@ func (s *unpaperServiceServer) LikePost(ctx context.Context, req *v1API.LikePost
 	}
 
 	post, err := fetchPost(fetchPostParams{
-		ctx:         ctx,
-		postsDir:    postsDir,
-		commentsDir: commentsDir,
-		postID:      req.PostId,
-		userID:      userID,
+		ctx:    ctx,
+		db:     s.db,
+		postID: req.PostId,
+		userID: userID,
 	})
+	if err != nil {
+		return nil, err
+	}
 
 	_, err = s.nm.Send(notifications.SendNotificationParams{
 		Ctx:             ctx,
@@ -295,42 +737,36 @@ func likeOrDislikePost(params likeDislikePostParams) (*v1API.Post, error) {
 	postsDir := posts.NewDirectory(params.db)
 	if params.hasAlreadyLiked {
 		return postsDir.RemoveLikeFromPost(params.ctx, posts.RemoveLikeFromPostParams{
-			ID:      params.postID,
-			Column1: params.userID,
+			ID:     params.postID,
+			UserID: params.userID,
 		})
 	}
 
 	return postsDir.LikePost(params.ctx, posts.LikePostParams{
-		ID:      params.postID,
-		Column1: params.userID,
+		ID:     params.postID,
+		UserID: params.userID,
 	})
 }
 
 type fetchPostParams struct {
-	ctx         context.Context
-	postsDir    *posts.Directory
-	commentsDir *comments.Directory
-	postID      string
-	userID      string
+	ctx    context.Context
+	db     *sql.DB
+	postID string
+	userID string
 }
 
 func fetchPost(params fetchPostParams) (*v1API.Post, error) {
-	post, err := params.postsDir.GetPost(params.ctx, params.postID)
+	if err := checkPostVisible(params.ctx, params.db, params.postID, params.userID); err != nil {
+		return nil, err
+	}
+	post, err := posts.NewDirectory(params.db).GetPost(params.ctx, params.postID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to retrieve post: %v", err)
 	}
 
-	// Add comments to post
-	comments, err := params.commentsDir.GetComments(params.ctx, params.userID, post.Id)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to retrieve comments for post id %q: %v", post.Id, err)
+	if err := addPostsDetails(params.ctx, params.db, params.userID, []*v1API.Post{post}); err != nil {
+		return nil, err
 	}
-	post.Comments = comments
-	hasUserLiked, err := params.postsDir.HasUserLikedPost(params.ctx, posts.HasUserLikedPostParams{
-		ID:      post.Id,
-		Column2: params.userID,
-	})
-	post.HasAlreadyLiked = hasUserLiked
 
 	return post, nil
 }
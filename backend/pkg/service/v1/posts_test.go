@@ -2,13 +2,21 @@ package v1_test
 
 import (
 	"context"
+	"encoding/binary"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/DagDigg/unpaper/backend/customers"
 	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/audio"
 	v1Testing "github.com/DagDigg/unpaper/backend/pkg/service/v1/testing"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestGetPost(t *testing.T) {
@@ -27,13 +35,17 @@ func TestGetPost(t *testing.T) {
 		)
 
 		// Create a post
+		tag := uuid.NewString()[:8]
 		createPostReq := &v1API.CreatePostRequest{
 			Message:    "msg",
-			AudioBytes: []byte("foo"),
+			AudioBytes: testWAV(),
+			Tags:       []string{tag},
 		}
 		createPostRes, err := ws.Server.CreatePost(ctx, createPostReq)
 		assert.Nil(err)
 		assert.Equal(createPostReq.AudioBytes, createPostRes.Post.Audio.Bytes)
+		assert.Equal(string(audio.FormatWAV), createPostRes.Post.Audio.Format)
+		assert.Equal(int32(1000), createPostRes.Post.Audio.DurationMs)
 		assert.NotEmpty(createPostRes.Post.Audio.Id)
 		assert.Equal(createPostReq.Message, createPostRes.Post.Message)
 		assert.Equal(userID, createPostRes.Post.Author)
@@ -41,14 +53,256 @@ func TestGetPost(t *testing.T) {
 
 		// Get posts
 		getPostsReq := &v1API.GetPostsRequest{
-			Category: "foo",
+			Tag: tag,
 		}
 		getPostsRes, err := ws.Server.GetPosts(ctx, getPostsReq)
 		assert.Nil(err)
+		assert.Len(getPostsRes.Posts, 1)
 		assert.Equal(getPostsRes.Posts[0].Id, createPostRes.Post.Id)
 	})
 }
 
+func TestGetHomeFeed(t *testing.T) {
+	t.Parallel()
+	ws := v1Testing.GetWrappedServer(t)
+	assert := assert.New(t)
+
+	t.Run("When reading the timeline of followed users posts", func(t *testing.T) {
+		follower, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		author, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		followerCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", follower.Id))
+		authorCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", author.Id))
+
+		// Posts written before following are added when the timeline is rebuilt
+		first, err := ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{Message: "first"})
+		assert.Nil(err)
+		_, err = ws.Server.FollowUser(followerCtx, &v1API.FollowUserRequest{UserIdToFollow: author.Id})
+		assert.Nil(err)
+		// Posts written after following are pushed into the timeline
+		second, err := ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{Message: "second"})
+		assert.Nil(err)
+
+		res, err := ws.Server.GetHomeFeed(followerCtx, &v1API.GetHomeFeedRequest{PageSize: 1})
+		assert.Nil(err)
+		assert.Len(res.Posts, 1)
+		assert.Equal(second.Post.Id, res.Posts[0].Id)
+		assert.NotEmpty(res.NextCursor)
+
+		res, err = ws.Server.GetHomeFeed(followerCtx, &v1API.GetHomeFeedRequest{PageSize: 1, Cursor: res.NextCursor})
+		assert.Nil(err)
+		assert.Len(res.Posts, 1)
+		assert.Equal(first.Post.Id, res.Posts[0].Id)
+
+		// Unfollowing removes the author posts
+		_, err = ws.Server.FollowUser(followerCtx, &v1API.FollowUserRequest{UserIdToFollow: author.Id})
+		assert.Nil(err)
+		res, err = ws.Server.GetHomeFeed(followerCtx, &v1API.GetHomeFeedRequest{})
+		assert.Nil(err)
+		assert.Empty(res.Posts)
+	})
+}
+
+func TestReposts(t *testing.T) {
+	t.Parallel()
+	ws := v1Testing.GetWrappedServer(t)
+	assert := assert.New(t)
+
+	t.Run("When reposting and quoting a post", func(t *testing.T) {
+		follower, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		reposter, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		author, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		followerCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", follower.Id))
+		reposterCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", reposter.Id))
+		authorCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", author.Id))
+
+		original, err := ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{Message: "original"})
+		assert.Nil(err)
+		_, err = ws.Server.FollowUser(followerCtx, &v1API.FollowUserRequest{UserIdToFollow: reposter.Id})
+		assert.Nil(err)
+
+		reposted, err := ws.Server.Repost(reposterCtx, &v1API.RepostRequest{PostId: original.Post.Id})
+		assert.Nil(err)
+		assert.True(reposted.Post.HasAlreadyReposted)
+		assert.Equal(int32(1), reposted.Post.RepostsCount)
+		// Reposting twice is a no-op
+		reposted, err = ws.Server.Repost(reposterCtx, &v1API.RepostRequest{PostId: original.Post.Id})
+		assert.Nil(err)
+		assert.Equal(int32(1), reposted.Post.RepostsCount)
+
+		// The repost is attributed to the reposter in the followers timelines
+		feed, err := ws.Server.GetHomeFeed(followerCtx, &v1API.GetHomeFeedRequest{})
+		assert.Nil(err)
+		assert.Len(feed.Posts, 1)
+		assert.Equal(original.Post.Id, feed.Posts[0].Id)
+		assert.Equal(reposter.Id, feed.Posts[0].RepostedBy)
+
+		quote, err := ws.Server.CreatePost(reposterCtx, &v1API.CreatePostRequest{Message: "quote", QuotedPostId: original.Post.Id})
+		assert.Nil(err)
+		assert.Equal(original.Post.Id, quote.Post.QuotedPost.Id)
+		_, err = ws.Server.CreatePost(reposterCtx, &v1API.CreatePostRequest{Message: "quote", QuotedPostId: uuid.NewString()})
+		assert.Equal(codes.NotFound, status.Code(err))
+
+		orig, err := ws.Server.GetPost(authorCtx, &v1API.GetPostRequest{PostId: original.Post.Id})
+		assert.Nil(err)
+		assert.Equal(int32(1), orig.Post.QuotesCount)
+
+		// Deleting the original turns quotes into a stub, and removes the reposts from the timelines
+		_, err = ws.Server.DeletePost(authorCtx, &v1API.DeletePostRequest{PostId: original.Post.Id})
+		assert.Nil(err)
+		feed, err = ws.Server.GetHomeFeed(followerCtx, &v1API.GetHomeFeedRequest{})
+		assert.Nil(err)
+		assert.Len(feed.Posts, 1)
+		assert.Equal(quote.Post.Id, feed.Posts[0].Id)
+		assert.True(feed.Posts[0].QuotedPost.Unavailable)
+		assert.Empty(feed.Posts[0].QuotedPost.Message)
+	})
+}
+
+func TestDraftsAndScheduledPosts(t *testing.T) {
+	t.Parallel()
+	ws := v1Testing.GetWrappedServer(t)
+	assert := assert.New(t)
+
+	t.Run("When creating drafts and scheduled posts", func(t *testing.T) {
+		follower, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		author, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		followerCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", follower.Id))
+		authorCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", author.Id))
+		_, err = ws.Server.FollowUser(followerCtx, &v1API.FollowUserRequest{UserIdToFollow: author.Id})
+		assert.Nil(err)
+
+		draft, err := ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{Message: "draft", Draft: true})
+		assert.Nil(err)
+		assert.Equal(v1API.PostStatus_DRAFT, draft.Post.Status)
+		_, err = ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{Message: "past", PublishAt: timestamppb.New(time.Now().Add(-time.Hour))})
+		assert.Equal(codes.InvalidArgument, status.Code(err))
+
+		// Drafts are only visible to their author
+		_, err = ws.Server.GetPost(followerCtx, &v1API.GetPostRequest{PostId: draft.Post.Id})
+		assert.Equal(codes.NotFound, status.Code(err))
+		_, err = ws.Server.GetPost(authorCtx, &v1API.GetPostRequest{PostId: draft.Post.Id})
+		assert.Nil(err)
+		drafts, err := ws.Server.GetDrafts(authorCtx, &v1API.GetDraftsRequest{})
+		assert.Nil(err)
+		assert.Len(drafts.Posts, 1)
+
+		scheduled, err := ws.Server.SchedulePost(authorCtx, &v1API.SchedulePostRequest{
+			PostId:    draft.Post.Id,
+			PublishAt: timestamppb.New(time.Now().Add(time.Second)),
+		})
+		assert.Nil(err)
+		assert.Equal(v1API.PostStatus_SCHEDULED, scheduled.Post.Status)
+		list, err := ws.Server.GetScheduledPosts(authorCtx, &v1API.GetScheduledPostsRequest{})
+		assert.Nil(err)
+		assert.Len(list.Posts, 1)
+		feed, err := ws.Server.GetHomeFeed(followerCtx, &v1API.GetHomeFeedRequest{})
+		assert.Nil(err)
+		assert.Empty(feed.Posts)
+
+		// Due posts are published by the worker
+		time.Sleep(1100 * time.Millisecond)
+		assert.Nil(ws.Server.PublishDuePosts(context.Background()))
+		feed, err = ws.Server.GetHomeFeed(followerCtx, &v1API.GetHomeFeedRequest{})
+		assert.Nil(err)
+		assert.Len(feed.Posts, 1)
+		assert.Equal(v1API.PostStatus_PUBLISHED, feed.Posts[0].Status)
+		_, err = ws.Server.CancelScheduledPost(authorCtx, &v1API.CancelScheduledPostRequest{PostId: draft.Post.Id})
+		assert.Equal(codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func TestPostVisibility(t *testing.T) {
+	t.Parallel()
+	ws := v1Testing.GetWrappedServer(t)
+	assert := assert.New(t)
+
+	t.Run("When restricting the audience of posts", func(t *testing.T) {
+		author, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		follower, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		member, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		authorCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", author.Id))
+		followerCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", follower.Id))
+		memberCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", member.Id))
+		strangerCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", uuid.NewString()))
+
+		_, err = ws.Server.FollowUser(followerCtx, &v1API.FollowUserRequest{UserIdToFollow: author.Id})
+		assert.Nil(err)
+		list, err := ws.Server.CreateList(authorCtx, &v1API.CreateListRequest{
+			Name:         "close friends",
+			AllowedUsers: map[string]string{member.Id: member.Username},
+		})
+		assert.Nil(err)
+
+		// Lists must be owned by the author
+		_, err = ws.Server.CreatePost(followerCtx, &v1API.CreatePostRequest{
+			Message:           "msg",
+			Visibility:        v1API.PostVisibility_LISTS,
+			VisibilityListIds: []string{list.Id},
+		})
+		assert.Equal(codes.InvalidArgument, status.Code(err))
+
+		tag := uuid.NewString()[:8]
+		followersOnly, err := ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{
+			Message:    "followers",
+			Tags:       []string{tag},
+			Visibility: v1API.PostVisibility_FOLLOWERS,
+		})
+		assert.Nil(err)
+		listOnly, err := ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{
+			Message:           "list",
+			Tags:              []string{tag},
+			Visibility:        v1API.PostVisibility_LISTS,
+			VisibilityListIds: []string{list.Id},
+		})
+		assert.Nil(err)
+
+		visible := func(ctx context.Context) []string {
+			res, err := ws.Server.GetPosts(ctx, &v1API.GetPostsRequest{Tag: tag})
+			assert.Nil(err)
+			ids := []string{}
+			for _, p := range res.Posts {
+				ids = append(ids, p.Id)
+			}
+			return ids
+		}
+		assert.ElementsMatch([]string{followersOnly.Post.Id, listOnly.Post.Id}, visible(authorCtx))
+		assert.ElementsMatch([]string{followersOnly.Post.Id}, visible(followerCtx))
+		assert.ElementsMatch([]string{listOnly.Post.Id}, visible(memberCtx))
+		assert.Empty(visible(strangerCtx))
+
+		// Hidden posts cannot be reached through any other endpoint
+		_, err = ws.Server.GetPost(strangerCtx, &v1API.GetPostRequest{PostId: followersOnly.Post.Id})
+		assert.Equal(codes.NotFound, status.Code(err))
+		_, err = ws.Server.GetComments(followerCtx, &v1API.GetCommentsRequest{PostId: listOnly.Post.Id})
+		assert.Equal(codes.NotFound, status.Code(err))
+		_, err = ws.Server.CreateComment(strangerCtx, &v1API.CreateCommentRequest{PostId: listOnly.Post.Id, Message: "hi"})
+		assert.Equal(codes.NotFound, status.Code(err))
+		_, err = ws.Server.LikePost(strangerCtx, &v1API.LikePostRequest{PostId: followersOnly.Post.Id})
+		assert.Equal(codes.NotFound, status.Code(err))
+		_, err = ws.Server.GetPostLikers(strangerCtx, &v1API.GetPostLikersRequest{PostId: followersOnly.Post.Id})
+		assert.Equal(codes.NotFound, status.Code(err))
+		_, err = ws.Server.Repost(followerCtx, &v1API.RepostRequest{PostId: followersOnly.Post.Id})
+		assert.Equal(codes.FailedPrecondition, status.Code(err))
+
+		// Quotes of hidden posts are shown as unavailable
+		quote, err := ws.Server.CreatePost(memberCtx, &v1API.CreatePostRequest{Message: "quote", QuotedPostId: listOnly.Post.Id})
+		assert.Nil(err)
+		res, err := ws.Server.GetPost(strangerCtx, &v1API.GetPostRequest{PostId: quote.Post.Id})
+		assert.Nil(err)
+		assert.True(res.Post.QuotedPost.Unavailable)
+	})
+}
+
 func TestCreateComment(t *testing.T) {
 	t.Parallel()
 	ws := v1Testing.GetWrappedServer(t)
@@ -67,7 +321,7 @@ func TestCreateComment(t *testing.T) {
 		// Create a post
 		createPostReq := &v1API.CreatePostRequest{
 			Message:    "msg",
-			AudioBytes: []byte("foo"),
+			AudioBytes: testWAV(),
 		}
 		createPostRes, err := ws.Server.CreatePost(ctx, createPostReq)
 		assert.Nil(err)
@@ -80,7 +334,7 @@ func TestCreateComment(t *testing.T) {
 				in: &v1API.CreateCommentRequest{
 					PostId:     createPostRes.Post.Id,
 					Message:    "",
-					AudioBytes: []byte("foo"),
+					AudioBytes: testWAV(),
 					ParentId:   "",
 					Thread: &v1API.ThreadRequest{
 						ThreadType: v1API.ThreadType_POST,
@@ -90,7 +344,9 @@ func TestCreateComment(t *testing.T) {
 					Comment: &v1API.Comment{
 						Message: "",
 						Audio: &v1API.Audio{
-							Bytes: []byte("foo"),
+							Bytes:      testWAV(),
+							Format:     string(audio.FormatWAV),
+							DurationMs: 1000,
 						},
 						Author:   userID,
 						ParentId: "",
@@ -107,14 +363,16 @@ func TestCreateComment(t *testing.T) {
 				in: &v1API.CreateCommentRequest{
 					PostId:     createPostRes.Post.Id,
 					Message:    "foo",
-					AudioBytes: []byte("foo"),
+					AudioBytes: testWAV(),
 					ParentId:   "",
 				},
 				want: &v1API.CreateCommentResponse{
 					Comment: &v1API.Comment{
 						Message: "foo",
 						Audio: &v1API.Audio{
-							Bytes: []byte("foo"),
+							Bytes:      testWAV(),
+							Format:     string(audio.FormatWAV),
+							DurationMs: 1000,
 						},
 						Author:   userID,
 						ParentId: "",
@@ -144,7 +402,9 @@ func TestCreateComment(t *testing.T) {
 			assert.Equal(tt.in.PostId, res.Comment.PostId)
 			assert.Equal(tt.in.Message, res.Comment.Message)
 			assert.Equal(tt.in.ParentId, res.Comment.ParentId)
-			assert.Equal(tt.in.AudioBytes, res.Comment.Audio.Bytes)
+			assert.Equal(tt.want.Comment.Audio.Bytes, res.Comment.Audio.Bytes)
+			assert.Equal(tt.want.Comment.Audio.Format, res.Comment.Audio.Format)
+			assert.Equal(tt.want.Comment.Audio.DurationMs, res.Comment.Audio.DurationMs)
 		}
 	})
 }
@@ -167,7 +427,7 @@ func TestLikePost(t *testing.T) {
 		// Create a post
 		createPostReq := &v1API.CreatePostRequest{
 			Message:    "msg",
-			AudioBytes: []byte("foo"),
+			AudioBytes: testWAV(),
 		}
 		createPostRes, err := ws.Server.CreatePost(ctx, createPostReq)
 		assert.Nil(err)
@@ -177,12 +437,354 @@ func TestLikePost(t *testing.T) {
 			PostId: createPostRes.Post.Id,
 		}
 		likePostRes, err := ws.Server.LikePost(ctx, likePostReq)
+		assert.Nil(err)
 		assert.True(likePostRes.Post.HasAlreadyLiked)
 		assert.Equal(int32(1), likePostRes.Post.Likes)
 
 		// Dislike post
 		dislikePostRes, err := ws.Server.LikePost(ctx, likePostReq)
+		assert.Nil(err)
 		assert.False(dislikePostRes.Post.HasAlreadyLiked)
 		assert.Equal(int32(0), dislikePostRes.Post.Likes)
 	})
 }
+
+func TestGetLikers(t *testing.T) {
+	t.Parallel()
+	ws := v1Testing.GetWrappedServer(t)
+	assert := assert.New(t)
+
+	t.Run("When paginating the users who liked a post and a comment", func(t *testing.T) {
+		author, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		authorCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", author.Id))
+
+		created, err := ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{Message: "msg"})
+		assert.Nil(err)
+		cmt, err := ws.Server.CreateComment(authorCtx, &v1API.CreateCommentRequest{PostId: created.Post.Id, Message: "comment"})
+		assert.Nil(err)
+
+		likerIDs := map[string]bool{}
+		for i := 0; i < 3; i++ {
+			liker, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+			assert.Nil(err)
+			likerIDs[liker.Id] = true
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", liker.Id))
+			_, err = ws.Server.LikePost(ctx, &v1API.LikePostRequest{PostId: created.Post.Id})
+			assert.Nil(err)
+			_, err = ws.Server.LikeComment(ctx, &v1API.LikeCommentRequest{CommentId: cmt.Comment.Id})
+			assert.Nil(err)
+			// Liking twice must not change the counter
+			res, err := ws.Server.LikeComment(ctx, &v1API.LikeCommentRequest{CommentId: cmt.Comment.Id})
+			assert.Nil(err)
+			assert.Equal(int32(i+1), res.Comment.Likes)
+		}
+
+		first, err := ws.Server.GetPostLikers(authorCtx, &v1API.GetPostLikersRequest{PostId: created.Post.Id, PageSize: 2})
+		assert.Nil(err)
+		assert.Len(first.Likers, 2)
+		assert.NotEmpty(first.NextCursor)
+		second, err := ws.Server.GetPostLikers(authorCtx, &v1API.GetPostLikersRequest{PostId: created.Post.Id, PageSize: 2, Cursor: first.NextCursor})
+		assert.Nil(err)
+		assert.Len(second.Likers, 1)
+		assert.Empty(second.NextCursor)
+
+		seen := map[string]bool{}
+		for _, l := range append(first.Likers, second.Likers...) {
+			assert.True(likerIDs[l.UserId])
+			seen[l.UserId] = true
+		}
+		assert.Len(seen, 3)
+
+		cmtLikers, err := ws.Server.GetCommentLikers(authorCtx, &v1API.GetCommentLikersRequest{CommentId: cmt.Comment.Id})
+		assert.Nil(err)
+		assert.Len(cmtLikers.Likers, 3)
+	})
+}
+
+func TestHashtags(t *testing.T) {
+	t.Parallel()
+	ws := v1Testing.GetWrappedServer(t)
+	assert := assert.New(t)
+
+	t.Run("When using hashtags in posts and comments", func(t *testing.T) {
+		author, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		authorCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", author.Id))
+		tag := "t" + strings.ReplaceAll(uuid.NewString(), "-", "")[:20]
+		commentTag := "c" + strings.ReplaceAll(uuid.NewString(), "-", "")[:20]
+
+		post, err := ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{Message: "new beat #" + tag})
+		assert.Nil(err)
+		_, err = ws.Server.CreateComment(authorCtx, &v1API.CreateCommentRequest{PostId: post.Post.Id, Message: "#" + commentTag})
+		assert.Nil(err)
+		_, err = ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{Message: "draft #" + tag, Draft: true})
+		assert.Nil(err)
+
+		for _, h := range []string{tag, "#" + strings.ToUpper(tag), commentTag} {
+			res, err := ws.Server.GetPostsByHashtag(authorCtx, &v1API.GetPostsByHashtagRequest{Hashtag: h})
+			assert.Nil(err)
+			assert.Len(res.Posts, 1)
+			assert.Equal(post.Post.Id, res.Posts[0].Id)
+		}
+		_, err = ws.Server.GetPostsByHashtag(authorCtx, &v1API.GetPostsByHashtagRequest{Hashtag: "not a tag"})
+		assert.Equal(codes.InvalidArgument, status.Code(err))
+
+		trending, err := ws.Server.GetTrendingHashtags(authorCtx, &v1API.GetTrendingHashtagsRequest{Limit: 50})
+		assert.Nil(err)
+		found := false
+		for _, h := range trending.Hashtags {
+			if h.Name == tag {
+				found = true
+				// The draft is not counted
+				assert.Equal(int32(1), h.UsesCount)
+			}
+		}
+		assert.True(found)
+
+		// Deleted posts do not use hashtags anymore
+		_, err = ws.Server.DeletePost(authorCtx, &v1API.DeletePostRequest{PostId: post.Post.Id})
+		assert.Nil(err)
+		res, err := ws.Server.GetPostsByHashtag(authorCtx, &v1API.GetPostsByHashtagRequest{Hashtag: tag})
+		assert.Nil(err)
+		assert.Empty(res.Posts)
+	})
+}
+
+func TestCreatePostAudio(t *testing.T) {
+	t.Parallel()
+	ws := v1Testing.GetWrappedServer(t)
+	assert := assert.New(t)
+
+	t.Run("When uploading audio", func(t *testing.T) {
+		author, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		authorCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", author.Id))
+
+		// Client provided duration and format are ignored
+		_, err = ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{
+			Message:         "not audio",
+			AudioBytes:      []byte("definitely not audio"),
+			AudioFormat:     "mp3",
+			AudioDurationMs: 1000,
+		})
+		assert.Equal(codes.InvalidArgument, status.Code(err))
+		_, err = ws.Server.CreateComment(authorCtx, &v1API.CreateCommentRequest{PostId: uuid.NewString(), AudioBytes: []byte("not audio")})
+		assert.NotNil(err)
+
+		// Text only posts have no audio
+		res, err := ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{Message: "text only"})
+		assert.Nil(err)
+		assert.Empty(res.Post.Audio.Format)
+		assert.Empty(res.Post.Audio.Peaks)
+	})
+}
+
+// testWAV returns a mono 8 bit WAV file of one second
+func testWAV() []byte {
+	const sampleRate = 8000
+	b := make([]byte, 44+sampleRate)
+	copy(b[0:4], "RIFF")
+	binary.LittleEndian.PutUint32(b[4:8], uint32(len(b)-8))
+	copy(b[8:16], "WAVEfmt ")
+	binary.LittleEndian.PutUint32(b[16:20], 16)
+	binary.LittleEndian.PutUint16(b[20:22], 1)
+	binary.LittleEndian.PutUint16(b[22:24], 1)
+	binary.LittleEndian.PutUint32(b[24:28], sampleRate)
+	binary.LittleEndian.PutUint32(b[28:32], sampleRate)
+	binary.LittleEndian.PutUint16(b[32:34], 1)
+	binary.LittleEndian.PutUint16(b[34:36], 8)
+	copy(b[36:40], "data")
+	binary.LittleEndian.PutUint32(b[40:44], sampleRate)
+	for i := 44; i < len(b); i++ {
+		b[i] = byte(128 + i%64)
+	}
+	return b
+}
+
+func TestEditAndDeletePost(t *testing.T) {
+	t.Parallel()
+	ws := v1Testing.GetWrappedServer(t)
+	assert := assert.New(t)
+
+	t.Run("When editing and deleting a post and its comments", func(t *testing.T) {
+		author, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		moderator, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		_, err = ws.Server.GetDB().Exec("UPDATE users SET is_moderator = true WHERE id = $1", moderator.Id)
+		assert.Nil(err)
+		authorCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", author.Id))
+		moderatorCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", moderator.Id))
+
+		created, err := ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{Message: "typo"})
+		assert.Nil(err)
+		cmt, err := ws.Server.CreateComment(authorCtx, &v1API.CreateCommentRequest{PostId: created.Post.Id, Message: "comment"})
+		assert.Nil(err)
+
+		// Only the author can edit
+		_, err = ws.Server.UpdatePost(moderatorCtx, &v1API.UpdatePostRequest{PostId: created.Post.Id, Message: "fixed"})
+		assert.Equal(codes.PermissionDenied, status.Code(err))
+
+		// Messages longer than the column are rejected instead of truncated
+		tooLong := strings.Repeat("é", 101)
+		_, err = ws.Server.UpdatePost(authorCtx, &v1API.UpdatePostRequest{PostId: created.Post.Id, Message: tooLong})
+		assert.Equal(codes.InvalidArgument, status.Code(err))
+		_, err = ws.Server.UpdateComment(authorCtx, &v1API.UpdateCommentRequest{CommentId: cmt.Comment.Id, Message: tooLong})
+		assert.Equal(codes.InvalidArgument, status.Code(err))
+
+		updated, err := ws.Server.UpdatePost(authorCtx, &v1API.UpdatePostRequest{PostId: created.Post.Id, Message: "fixed"})
+		assert.Nil(err)
+		assert.Equal("fixed", updated.Post.Message)
+		assert.NotNil(updated.Post.EditedAt)
+
+		deleted, err := ws.Server.DeletePost(authorCtx, &v1API.DeletePostRequest{PostId: created.Post.Id})
+		assert.Nil(err)
+		assert.True(deleted.Post.Deleted)
+		assert.Equal("[deleted]", deleted.Post.Message)
+
+		deletedCmt, err := ws.Server.DeleteComment(authorCtx, &v1API.DeleteCommentRequest{CommentId: cmt.Comment.Id})
+		assert.Nil(err)
+		assert.True(deletedCmt.Comment.Deleted)
+
+		// Only moderators can inspect the history
+		_, err = ws.Server.GetEditHistory(authorCtx, &v1API.GetEditHistoryRequest{TargetType: v1API.EditTarget_POST, TargetId: created.Post.Id})
+		assert.Equal(codes.PermissionDenied, status.Code(err))
+
+		history, err := ws.Server.GetEditHistory(moderatorCtx, &v1API.GetEditHistoryRequest{TargetType: v1API.EditTarget_POST, TargetId: created.Post.Id})
+		assert.Nil(err)
+		assert.Len(history.Edits, 2)
+		assert.Equal(v1API.EditAction_DELETE, history.Edits[0].Action)
+		assert.Equal("fixed", history.Edits[0].PreviousMessage)
+		assert.Equal(v1API.EditAction_EDIT, history.Edits[1].Action)
+		assert.Equal("typo", history.Edits[1].PreviousMessage)
+	})
+}
+
+func TestSupportersOnlyPosts(t *testing.T) {
+	t.Parallel()
+	ws := v1Testing.GetWrappedServer(t)
+	assert := assert.New(t)
+
+	t.Run("When creating a supporters only post", func(t *testing.T) {
+		author, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		supporter, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		viewer, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		authorCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", author.Id))
+		supporterCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", supporter.Id))
+		viewerCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", viewer.Id))
+		roomID := uuid.NewString()
+		req := &v1API.CreatePostRequest{
+			Message:    "Episode 1\nthe full story",
+			AudioBytes: testWAV(),
+			Poll:       &v1API.PollRequest{Options: []string{"yes", "no"}, ClosesAt: timestamppb.New(time.Now().Add(time.Hour))},
+			SupportersOnly: &v1API.SupportersOnly{
+				RoomId:       roomID,
+				PurchaseType: v1API.RoomSubcriptionType_SUBSCRIPTION_MONTHLY,
+			},
+		}
+
+		// Authors must be able to receive payments
+		_, err = ws.Server.CreatePost(authorCtx, req)
+		assert.Equal(codes.FailedPrecondition, status.Code(err))
+
+		accountID := uuid.NewString()
+		customersDir := customers.NewDirectory(ws.Server.GetDB())
+		_, err = customersDir.StoreConnectedAccount(context.Background(), &customers.StoreConnectedAccountParams{
+			UserID:             author.Id,
+			CustomerID:         uuid.NewString(),
+			AccountID:          accountID,
+			CanReceivePayments: true,
+		})
+		assert.Nil(err)
+		created, err := ws.Server.CreatePost(authorCtx, req)
+		assert.Nil(err)
+		assert.False(created.Post.Locked)
+		assert.Equal(roomID, created.Post.SupportersOnly.RoomId)
+
+		_, err = customersDir.StoreRoomSubscription(context.Background(), &customers.StoreRoomSubscriptionParams{
+			ID:                   uuid.NewString(),
+			UserID:               supporter.Id,
+			CustomerID:           uuid.NewString(),
+			ConnectedCustomerID:  uuid.NewString(),
+			AccountID:            accountID,
+			Status:               string(customers.SubscriptionStatusActive),
+			RoomID:               roomID,
+			RoomSubscriptionType: string(customers.RoomSubscriptionTypeSubscriptionMonthly),
+		})
+		assert.Nil(err)
+
+		// Only the room owner can publish supporters only posts in it
+		_, err = customersDir.StoreConnectedAccount(context.Background(), &customers.StoreConnectedAccountParams{
+			UserID:             viewer.Id,
+			CustomerID:         uuid.NewString(),
+			AccountID:          uuid.NewString(),
+			CanReceivePayments: true,
+		})
+		assert.Nil(err)
+		_, err = ws.Server.CreatePost(viewerCtx, req)
+		assert.Equal(codes.InvalidArgument, status.Code(err))
+
+		_, err = ws.Server.CreateComment(supporterCtx, &v1API.CreateCommentRequest{PostId: created.Post.Id, Message: "thanks"})
+		assert.Nil(err)
+
+		res, err := ws.Server.GetPost(supporterCtx, &v1API.GetPostRequest{PostId: created.Post.Id})
+		assert.Nil(err)
+		assert.False(res.Post.Locked)
+		assert.Len(res.Post.Comments, 1)
+		assert.NotNil(res.Post.Poll)
+		assert.Equal(req.Message, res.Post.Message)
+		assert.NotEmpty(res.Post.Audio.Bytes)
+
+		// Other users only see a preview
+		res, err = ws.Server.GetPost(viewerCtx, &v1API.GetPostRequest{PostId: created.Post.Id})
+		assert.Nil(err)
+		assert.True(res.Post.Locked)
+		assert.Equal(v1API.PostLockReason_SUBSCRIPTION_REQUIRED, res.Post.LockReason)
+		assert.Equal("Episode 1", res.Post.Message)
+		assert.Empty(res.Post.Audio.Bytes)
+		assert.Equal(int32(1000), res.Post.Audio.DurationMs)
+		_, err = ws.Server.RecordPlayback(viewerCtx, &v1API.RecordPlaybackRequest{PostId: created.Post.Id, ListenedMs: 1000})
+		assert.Equal(codes.FailedPrecondition, status.Code(err))
+		assert.Empty(res.Post.Comments)
+		assert.Nil(res.Post.Poll)
+		_, err = ws.Server.GetComments(viewerCtx, &v1API.GetCommentsRequest{PostId: created.Post.Id})
+		assert.Equal(codes.FailedPrecondition, status.Code(err))
+		_, err = ws.Server.GetCommentTree(viewerCtx, &v1API.GetCommentTreeRequest{PostId: created.Post.Id})
+		assert.Equal(codes.FailedPrecondition, status.Code(err))
+		_, err = ws.Server.CreateComment(viewerCtx, &v1API.CreateCommentRequest{PostId: created.Post.Id, Message: "hi"})
+		assert.Equal(codes.FailedPrecondition, status.Code(err))
+
+		// Searches only match the locked content for users who can access it
+		// The preview term must fit in the locked post preview
+		previewTerm := strings.ReplaceAll(uuid.NewString(), "-", "")[:16]
+		lockedTerm := strings.ReplaceAll(uuid.NewString(), "-", "")
+		searchable, err := ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{
+			Message:        "teaser " + previewTerm + "\nthe " + lockedTerm + " part",
+			SupportersOnly: req.SupportersOnly,
+		})
+		assert.Nil(err)
+		for _, tt := range []struct {
+			ctx   context.Context
+			query string
+			found bool
+		}{
+			{viewerCtx, previewTerm, true},
+			{viewerCtx, lockedTerm, false},
+			{supporterCtx, lockedTerm, true},
+			{authorCtx, lockedTerm, true},
+		} {
+			found, err := ws.Server.Search(tt.ctx, &v1API.SearchRequest{Query: tt.query, Types: []v1API.SearchResultType_Enum{v1API.SearchResultType_POST}})
+			assert.Nil(err)
+			if tt.found {
+				assert.Len(found.Results, 1)
+				assert.Equal(searchable.Post.Id, found.Results[0].Post.Id)
+			} else {
+				assert.Len(found.Results, 0)
+			}
+		}
+	})
+}
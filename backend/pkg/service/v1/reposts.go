@@ -0,0 +1,159 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	dbNotifications "github.com/DagDigg/unpaper/backend/notifications"
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/logger"
+	"github.com/DagDigg/unpaper/backend/pkg/mdutils"
+	"github.com/DagDigg/unpaper/backend/pkg/notifications"
+	"github.com/DagDigg/unpaper/backend/pkg/timeline"
+	"github.com/DagDigg/unpaper/backend/posts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Repost RPC shares a post with the requesting user followers. Reposting an already reposted post is a no-op
+func (s *unpaperServiceServer) Repost(ctx context.Context, req *v1API.RepostRequest) (*v1API.RepostResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	if err := checkNotSuspended(ctx, s.db, userID); err != nil {
+		return nil, err
+	}
+	postsDir := posts.NewDirectory(s.db)
+
+	original, err := getAvailablePost(ctx, s.db, req.PostId, userID)
+	if err != nil {
+		return nil, err
+	}
+	// Reposts are shown to the reposter followers, who may not be part of the original audience
+	if original.Visibility != v1API.PostVisibility_PUBLIC {
+		return nil, status.Error(codes.FailedPrecondition, "only public posts can be reposted")
+	}
+
+	created, err := postsDir.RepostPost(ctx, req.PostId, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to repost: %v", err)
+	}
+	if created {
+		err = s.timeline.FanOut(ctx, userID, timeline.Entry{
+			PostID:     req.PostId,
+			RepostedBy: userID,
+			CreatedAt:  time.Now().UTC(),
+		})
+		if err != nil {
+			// Followers timelines are rebuilt when missing, do not fail the repost
+			logger.Log.Error(err.Error())
+		}
+
+		_, err = s.nm.Send(notifications.SendNotificationParams{
+			Ctx:             ctx,
+			SenderUserID:    userID,
+			ReceiverUserID:  original.Author,
+			TriggerID:       original.Id,
+			EventID:         string(dbNotifications.EventIDRepost),
+			ResendCondition: notifications.ResendConditionAfter(5 * time.Minute),
+			Content:         original.Message,
+		})
+		if err != nil {
+			// Do not throw error on notification send failure
+			logger.Log.Error(err.Error())
+		}
+	}
+
+	post, err := fetchPost(fetchPostParams{
+		ctx:    ctx,
+		db:     s.db,
+		postID: req.PostId,
+		userID: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1API.RepostResponse{
+		Post: post,
+	}, nil
+}
+
+// UndoRepost RPC removes the requesting user repost. The repost disappears from the followers timelines when read
+func (s *unpaperServiceServer) UndoRepost(ctx context.Context, req *v1API.UndoRepostRequest) (*v1API.UndoRepostResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+
+	if err := posts.NewDirectory(s.db).UnrepostPost(ctx, req.PostId, userID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to undo repost: %v", err)
+	}
+
+	post, err := fetchPost(fetchPostParams{
+		ctx:    ctx,
+		db:     s.db,
+		postID: req.PostId,
+		userID: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1API.UndoRepostResponse{
+		Post: post,
+	}, nil
+}
+
+// getAvailablePost returns the post, checking that it exists, is visible to the user and has not been deleted
+func getAvailablePost(ctx context.Context, db *sql.DB, postID, userID string) (*v1API.Post, error) {
+	if err := checkPostVisible(ctx, db, postID, userID); err != nil {
+		return nil, err
+	}
+	post, err := posts.NewDirectory(db).GetPost(ctx, postID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "post %q not found", postID)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to retrieve post: %v", err)
+	}
+	if post.Deleted {
+		return nil, status.Errorf(codes.NotFound, "post %q has been deleted", postID)
+	}
+
+	return post, nil
+}
+
+// setQuotedPosts replaces the quoted posts ids with the quoted posts.
+// Quotes of deleted posts, or of posts hidden to the user, are turned into an unavailable stub
+func setQuotedPosts(ctx context.Context, postsDir *posts.Directory, userID string, postsList []*v1API.Post) error {
+	quotedIDs := []string{}
+	for _, p := range postsList {
+		if p.QuotedPost != nil {
+			quotedIDs = append(quotedIDs, p.QuotedPost.Id)
+		}
+	}
+	if len(quotedIDs) == 0 {
+		return nil
+	}
+
+	quoted, err := postsDir.GetQuotedPosts(ctx, userID, quotedIDs)
+	if err != nil {
+		return err
+	}
+	for _, p := range postsList {
+		if p.QuotedPost == nil {
+			continue
+		}
+		q, ok := quoted[p.QuotedPost.Id]
+		if !ok || q.Deleted {
+			p.QuotedPost = &v1API.Post{Id: p.QuotedPost.Id, Unavailable: true}
+			continue
+		}
+		p.QuotedPost = q
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package v1
+
+import (
+	"context"
+	"strings"
+
+	"github.com/DagDigg/unpaper/backend/comments"
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/mdutils"
+	"github.com/DagDigg/unpaper/backend/pkg/pagination"
+	"github.com/DagDigg/unpaper/backend/posts"
+	"github.com/DagDigg/unpaper/backend/search"
+	"github.com/DagDigg/unpaper/backend/users"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Search RPC retrieves a page of posts, comments and users matching the query, from the most relevant
+func (s *unpaperServiceServer) Search(ctx context.Context, req *v1API.SearchRequest) (*v1API.SearchResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	query := strings.TrimSpace(req.Query)
+	if query == "" {
+		// Return nothing on empty query
+		return &v1API.SearchResponse{Results: []*v1API.SearchResult{}}, nil
+	}
+	if len(query) > search.MaxQueryLength {
+		return nil, status.Errorf(codes.InvalidArgument, "query exceeds %d characters", search.MaxQueryLength)
+	}
+	if req.Category != "" && !posts.IsValidCategory(req.Category) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid category %q", req.Category)
+	}
+	cursor, err := pagination.Decode(req.Cursor)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to decode cursor: %v", err)
+	}
+	pageSize := pagination.PageSize(req.PageSize)
+	searchDir := search.NewDirectory(s.db)
+
+	params := search.SearchParams{
+		Query:    query,
+		ViewerID: userID,
+		Category: req.Category,
+		PageSize: pageSize,
+	}
+	if len(req.Types) == 0 {
+		params.IncludePosts, params.IncludeComments, params.IncludeUsers = true, true, true
+	}
+	for _, t := range req.Types {
+		switch t {
+		case v1API.SearchResultType_POST:
+			params.IncludePosts = true
+		case v1API.SearchResultType_COMMENT:
+			params.IncludeComments = true
+		case v1API.SearchResultType_USER:
+			params.IncludeUsers = true
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid search result type: %v", t)
+		}
+	}
+	// Users do not belong to a category
+	if req.Category != "" {
+		params.IncludeUsers = false
+	}
+	if cursor != nil {
+		params.HasCursor = true
+		params.CursorRank = cursor.Score
+		params.CursorID = cursor.ID
+	}
+	hits, err := searchDir.Search(ctx, params)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search: %v", err)
+	}
+
+	results, err := s.searchHitsToPB(ctx, userID, hits)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &v1API.SearchResponse{
+		Results: results,
+	}
+	// Hits are paginated before being resolved, as some of them may be gone in the meantime
+	if len(hits) > 0 {
+		last := hits[len(hits)-1]
+		res.NextCursor = pagination.NextCursor(len(hits), pageSize, pagination.Cursor{
+			Score: last.Rank,
+			ID:    last.ID,
+		})
+	}
+
+	return res, nil
+}
+
+// searchHitsToPB resolves the search hits into posts, comments and users, keeping the hits order.
+// Hits no longer available are skipped
+func (s *unpaperServiceServer) searchHitsToPB(ctx context.Context, userID string, hits []search.SearchRow) ([]*v1API.SearchResult, error) {
+	ids := map[search.ResultType][]string{}
+	for _, h := range hits {
+		t := search.ResultType(h.ResultType)
+		ids[t] = append(ids[t], h.ID)
+	}
+
+	postsByID := map[string]*v1API.Post{}
+	if len(ids[search.ResultTypePost]) > 0 {
+		postsList, err := posts.NewDirectory(s.db).GetPostsByIDs(ctx, userID, ids[search.ResultTypePost])
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to retrieve posts: %v", err)
+		}
+		if err := addPostsDetails(ctx, s.db, userID, postsList); err != nil {
+			return nil, err
+		}
+		for _, p := range postsList {
+			postsByID[p.Id] = p
+		}
+	}
+
+	commentsByID := map[string]*v1API.Comment{}
+	if len(ids[search.ResultTypeComment]) > 0 {
+		cmts, err := comments.NewDirectory(s.db).GetCommentsByIDs(ctx, userID, ids[search.ResultTypeComment])
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to retrieve comments: %v", err)
+		}
+		for _, c := range cmts {
+			commentsByID[c.Id] = c
+		}
+	}
+
+	usersByID := map[string]*v1API.UserSuggestion{}
+	if len(ids[search.ResultTypeUser]) > 0 {
+		var err error
+		usersByID, err = users.NewDirectory(s.db).GetUserSuggestionsByIDs(ctx, ids[search.ResultTypeUser])
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to retrieve users: %v", err)
+		}
+	}
+
+	results := []*v1API.SearchResult{}
+	for _, h := range hits {
+		resultType, err := search.PGResultTypeToPB(search.ResultType(h.ResultType))
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		r := &v1API.SearchResult{
+			Type: resultType,
+			Rank: h.Rank,
+		}
+		var found bool
+		switch resultType {
+		case v1API.SearchResultType_POST:
+			r.Post, found = postsByID[h.ID]
+		case v1API.SearchResultType_COMMENT:
+			r.Comment, found = commentsByID[h.ID]
+		case v1API.SearchResultType_USER:
+			r.User, found = usersByID[h.ID]
+		}
+		if !found {
+			continue
+		}
+		results = append(results, r)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,64 @@
+package v1_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	v1Testing "github.com/DagDigg/unpaper/backend/pkg/service/v1/testing"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestSearch(t *testing.T) {
+	t.Parallel()
+	ws := v1Testing.GetWrappedServer(t)
+	assert := assert.New(t)
+
+	t.Run("When searching posts and comments", func(t *testing.T) {
+		searcher, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		author, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		blocked, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		searcherCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", searcher.Id))
+		authorCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", author.Id))
+		blockedCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", blocked.Id))
+		term := strings.ReplaceAll(uuid.NewString(), "-", "")
+
+		post, err := ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{Message: "listening to " + term})
+		assert.Nil(err)
+		comment, err := ws.Server.CreateComment(authorCtx, &v1API.CreateCommentRequest{PostId: post.Post.Id, Message: term + " " + term})
+		assert.Nil(err)
+		_, err = ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{Message: "private " + term, Visibility: v1API.PostVisibility_FOLLOWERS})
+		assert.Nil(err)
+		_, err = ws.Server.CreatePost(blockedCtx, &v1API.CreatePostRequest{Message: "blocked " + term})
+		assert.Nil(err)
+		_, err = ws.Server.BlockUser(searcherCtx, &v1API.BlockUserRequest{UserId: blocked.Id})
+		assert.Nil(err)
+
+		// Hidden posts and blocked authors are left out
+		res, err := ws.Server.Search(searcherCtx, &v1API.SearchRequest{Query: term})
+		assert.Nil(err)
+		assert.Len(res.Results, 2)
+		// The comment repeats the term, so it ranks first
+		assert.Equal(v1API.SearchResultType_COMMENT, res.Results[0].Type)
+		assert.Equal(comment.Comment.Id, res.Results[0].Comment.Id)
+		assert.Equal(post.Post.Id, res.Results[1].Post.Id)
+
+		page, err := ws.Server.Search(searcherCtx, &v1API.SearchRequest{Query: term, PageSize: 1})
+		assert.Nil(err)
+		assert.Len(page.Results, 1)
+		page, err = ws.Server.Search(searcherCtx, &v1API.SearchRequest{Query: term, PageSize: 1, Cursor: page.NextCursor})
+		assert.Nil(err)
+		assert.Len(page.Results, 1)
+		assert.Equal(post.Post.Id, page.Results[0].Post.Id)
+
+		res, err = ws.Server.Search(searcherCtx, &v1API.SearchRequest{Query: term, Types: []v1API.SearchResultType_Enum{v1API.SearchResultType_POST}})
+		assert.Nil(err)
+		assert.Len(res.Results, 1)
+	})
+}
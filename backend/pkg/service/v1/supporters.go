@@ -0,0 +1,130 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/DagDigg/unpaper/backend/customers"
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/posts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pbSupportersOnlyToPG validates the supporters only settings of a new post, returning the room id
+// and purchase type to be stored. The author must be able to receive payments, and own the room:
+// a room whose subscriptions are paid to another user's connected account is rejected
+func pbSupportersOnlyToPG(ctx context.Context, db *sql.DB, userID string, so *v1API.SupportersOnly) (sql.NullString, sql.NullString, error) {
+	if so == nil {
+		return sql.NullString{}, sql.NullString{}, nil
+	}
+	if so.RoomId == "" {
+		return sql.NullString{}, sql.NullString{}, status.Error(codes.InvalidArgument, "room id is required for supporters only posts")
+	}
+	purchaseType, err := posts.PBPurchaseTypeToPG(so.PurchaseType)
+	if err != nil {
+		return sql.NullString{}, sql.NullString{}, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	connectedAcct, err := customers.NewDirectory(db).GetConnectedAccountByUserID(ctx, userID)
+	if err != nil && err != sql.ErrNoRows {
+		return sql.NullString{}, sql.NullString{}, status.Errorf(codes.Internal, "error retrieving connected account: %v", err)
+	}
+	if err == sql.ErrNoRows || !connectedAcct.CanReceivePayments {
+		return sql.NullString{}, sql.NullString{}, status.Error(codes.FailedPrecondition, "connected account cannot receive payments")
+	}
+	ownerIDs, err := customers.NewDirectory(db).GetRoomOwnerIDs(ctx, so.RoomId)
+	if err != nil {
+		return sql.NullString{}, sql.NullString{}, status.Errorf(codes.Internal, "error retrieving room owner: %v", err)
+	}
+	for _, id := range ownerIDs {
+		if id != userID {
+			return sql.NullString{}, sql.NullString{}, status.Error(codes.InvalidArgument, "room is not owned by the user")
+		}
+	}
+
+	return sql.NullString{String: so.RoomId, Valid: true}, sql.NullString{String: string(purchaseType), Valid: true}, nil
+}
+
+// roomOwnerKey identifies a room of a given owner
+type roomOwnerKey struct {
+	roomID  string
+	ownerID string
+}
+
+// lockPosts locks the supporters only posts, along with their quoted posts, which the user cannot access.
+// Authors can always access their own posts
+func lockPosts(ctx context.Context, db *sql.DB, userID string, postsList []*v1API.Post) error {
+	toCheck := []*v1API.Post{}
+	roomIDs := []string{}
+	for _, p := range postsList {
+		for _, candidate := range []*v1API.Post{p, p.QuotedPost} {
+			if candidate == nil || candidate.SupportersOnly == nil || candidate.Author == userID {
+				continue
+			}
+			toCheck = append(toCheck, candidate)
+			roomIDs = append(roomIDs, candidate.SupportersOnly.RoomId)
+		}
+	}
+	if len(toCheck) == 0 {
+		return nil
+	}
+
+	subscriptions, err := customers.NewDirectory(db).GetRoomSubscriptionsForUserIDByRoomIDs(ctx, userID, roomIDs)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to retrieve room subscriptions: %v", err)
+	}
+	// A user may have several subscriptions to the same room, any granting access is enough
+	statuses := map[roomOwnerKey]customers.SubscriptionStatus{}
+	for _, s := range subscriptions {
+		key := roomOwnerKey{roomID: s.RoomID, ownerID: s.OwnerUserID}
+		if current, ok := statuses[key]; !ok || !current.GrantsAccess() {
+			statuses[key] = customers.SubscriptionStatus(s.Status)
+		}
+	}
+
+	for _, p := range toCheck {
+		subscriptionStatus, subscribed := statuses[roomOwnerKey{roomID: p.SupportersOnly.RoomId, ownerID: p.Author}]
+		switch {
+		case subscribed && subscriptionStatus.GrantsAccess():
+			continue
+		case subscribed && p.SupportersOnly.PurchaseType == v1API.RoomSubcriptionType_SUBSCRIPTION_MONTHLY:
+			posts.LockPost(p, v1API.PostLockReason_SUBSCRIPTION_INACTIVE)
+		case p.SupportersOnly.PurchaseType == v1API.RoomSubcriptionType_SUBSCRIPTION_MONTHLY:
+			posts.LockPost(p, v1API.PostLockReason_SUBSCRIPTION_REQUIRED)
+		default:
+			posts.LockPost(p, v1API.PostLockReason_PURCHASE_REQUIRED)
+		}
+	}
+
+	return nil
+}
+
+// isPostLocked reports whether the post is locked for the user, leaving the post untouched
+func isPostLocked(ctx context.Context, db *sql.DB, userID string, p *v1API.Post) (bool, error) {
+	probe := &v1API.Post{Id: p.Id, Author: p.Author, SupportersOnly: p.SupportersOnly}
+	if err := lockPosts(ctx, db, userID, []*v1API.Post{probe}); err != nil {
+		return false, err
+	}
+
+	return probe.Locked, nil
+}
+
+// checkPostUnlocked returns a FailedPrecondition error if the post is reserved to supporters and
+// the user cannot access it. Its comments are part of the locked content
+func checkPostUnlocked(ctx context.Context, db *sql.DB, postID, userID string) error {
+	post, err := posts.NewDirectory(db).GetPost(ctx, postID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return status.Errorf(codes.NotFound, "post %q not found", postID)
+		}
+		return status.Errorf(codes.Internal, "failed to retrieve post: %v", err)
+	}
+	if err := lockPosts(ctx, db, userID, []*v1API.Post{post}); err != nil {
+		return err
+	}
+	if post.Locked {
+		return status.Error(codes.FailedPrecondition, "post is reserved to supporters")
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/DagDigg/unpaper/backend/comments"
+	dbNotifications "github.com/DagDigg/unpaper/backend/notifications"
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/logger"
+	"github.com/DagDigg/unpaper/backend/pkg/mdutils"
+	"github.com/DagDigg/unpaper/backend/pkg/notifications"
+	"github.com/DagDigg/unpaper/backend/pkg/pagination"
+	"github.com/DagDigg/unpaper/backend/posts"
+	"github.com/DagDigg/unpaper/backend/threads"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetThread RPC retrieves the post along with the segments continuing it, written by its author, from the oldest.
+// Every segment comes with its first replies
+func (s *unpaperServiceServer) GetThread(ctx context.Context, req *v1API.GetThreadRequest) (*v1API.GetThreadResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	sort, err := pbCommentSortToPG(req.RepliesSort)
+	if err != nil {
+		return nil, err
+	}
+	post, err := fetchPost(fetchPostParams{
+		ctx:    ctx,
+		db:     s.db,
+		postID: req.PostId,
+		userID: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	segments := []*v1API.CommentNode{}
+	// Segments continue the post content, which is not visible when locked
+	if !post.Locked {
+		segments, err = comments.NewDirectory(s.db).GetThread(ctx, userID, comments.GetThreadParams{
+			PostID:            post.Id,
+			Author:            post.Author,
+			RepliesSort:       sort,
+			RepliesPerSegment: pagination.PageSize(req.RepliesPageSize),
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to retrieve thread for post id %q: %v", req.PostId, err)
+		}
+	}
+	s.recordCommentViews(ctx, userID, commentNodesIDs(segments))
+
+	subscribed, err := threads.NewDirectory(s.db).IsSubscribedToThread(ctx, post.Id, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve thread subscription: %v", err)
+	}
+
+	return &v1API.GetThreadResponse{
+		Post:       post,
+		Segments:   segments,
+		Subscribed: subscribed,
+	}, nil
+}
+
+// SubscribeToThread RPC subscribes the user to the post thread, notifying them when the author adds a segment
+func (s *unpaperServiceServer) SubscribeToThread(ctx context.Context, req *v1API.SubscribeToThreadRequest) (*v1API.SubscribeToThreadResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	if err := checkPostVisible(ctx, s.db, req.PostId, userID); err != nil {
+		return nil, err
+	}
+	post, err := posts.NewDirectory(s.db).GetPost(ctx, req.PostId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve post: %v", err)
+	}
+	if post.Deleted {
+		return nil, status.Error(codes.FailedPrecondition, "cannot subscribe to the thread of a deleted post")
+	}
+	if post.Author == userID {
+		return nil, status.Error(codes.InvalidArgument, "cannot subscribe to your own thread")
+	}
+
+	err = threads.NewDirectory(s.db).SubscribeToThread(ctx, threads.SubscribeToThreadParams{
+		PostID: req.PostId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to subscribe to thread: %v", err)
+	}
+
+	return &v1API.SubscribeToThreadResponse{
+		Subscribed: true,
+	}, nil
+}
+
+// UnsubscribeFromThread RPC removes the user subscription to the post thread
+func (s *unpaperServiceServer) UnsubscribeFromThread(ctx context.Context, req *v1API.UnsubscribeFromThreadRequest) (*v1API.UnsubscribeFromThreadResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+
+	if err := threads.NewDirectory(s.db).UnsubscribeFromThread(ctx, req.PostId, userID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unsubscribe from thread: %v", err)
+	}
+
+	return &v1API.UnsubscribeFromThreadResponse{
+		Subscribed: false,
+	}, nil
+}
+
+// notifyThreadSubscribers notifies the subscribers who can still view the post of the new segment.
+// Failures are logged, since the segment has already been created
+func (s *unpaperServiceServer) notifyThreadSubscribers(ctx context.Context, post *v1API.Post, segment *v1API.Comment) {
+	subscribers, err := threads.NewDirectory(s.db).GetThreadSubscribers(ctx, post.Id)
+	if err != nil {
+		logger.Log.Error(err.Error())
+		return
+	}
+	postsDir := posts.NewDirectory(s.db)
+	for _, subscriberID := range subscribers {
+		ok, err := postsDir.CanViewPost(ctx, post.Id, subscriberID)
+		if err != nil {
+			logger.Log.Error(err.Error())
+			continue
+		}
+		if !ok {
+			continue
+		}
+		// Do not leak the content of supporters only posts to subscribers who cannot access them
+		locked, err := isPostLocked(ctx, s.db, subscriberID, post)
+		if err != nil {
+			logger.Log.Error(err.Error())
+			continue
+		}
+		content := segment.Message
+		if locked {
+			content = ""
+		}
+		_, err = s.nm.Send(notifications.SendNotificationParams{
+			Ctx:            ctx,
+			SenderUserID:   post.Author,
+			ReceiverUserID: subscriberID,
+			TriggerID:      segment.Id,
+			EventID:        string(dbNotifications.EventIDThreadSegment),
+			Content:        content,
+		})
+		if err != nil {
+			// Do not throw error on notification send failure
+			logger.Log.Error(err.Error())
+		}
+	}
+}
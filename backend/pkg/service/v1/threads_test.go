@@ -0,0 +1,173 @@
+package v1_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DagDigg/unpaper/backend/customers"
+	dbNotifications "github.com/DagDigg/unpaper/backend/notifications"
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	v1Testing "github.com/DagDigg/unpaper/backend/pkg/service/v1/testing"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestThreads(t *testing.T) {
+	t.Parallel()
+	ws := v1Testing.GetWrappedServer(t)
+	assert := assert.New(t)
+
+	t.Run("When the author continues a subscribed thread", func(t *testing.T) {
+		author, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		reader, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		authorCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", author.Id))
+		readerCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", reader.Id))
+
+		created, err := ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{Message: "1/"})
+		assert.Nil(err)
+		postID := created.Post.Id
+
+		_, err = ws.Server.SubscribeToThread(authorCtx, &v1API.SubscribeToThreadRequest{PostId: postID})
+		assert.Equal(codes.InvalidArgument, status.Code(err))
+		subscribed, err := ws.Server.SubscribeToThread(readerCtx, &v1API.SubscribeToThreadRequest{PostId: postID})
+		assert.Nil(err)
+		assert.True(subscribed.Subscribed)
+
+		// Only the author can continue the thread
+		_, err = ws.Server.CreateComment(readerCtx, &v1API.CreateCommentRequest{
+			PostId:  postID,
+			Message: "2/",
+			Thread:  &v1API.ThreadRequest{ThreadType: v1API.ThreadType_POST},
+		})
+		assert.NotNil(err)
+		first, err := ws.Server.CreateComment(authorCtx, &v1API.CreateCommentRequest{
+			PostId:  postID,
+			Message: "2/",
+			Thread:  &v1API.ThreadRequest{ThreadType: v1API.ThreadType_POST},
+		})
+		assert.Nil(err)
+		second, err := ws.Server.CreateComment(authorCtx, &v1API.CreateCommentRequest{
+			PostId:  postID,
+			Message: "3/",
+			Thread:  &v1API.ThreadRequest{ThreadType: v1API.ThreadType_POST},
+		})
+		assert.Nil(err)
+		reply, err := ws.Server.CreateComment(readerCtx, &v1API.CreateCommentRequest{
+			PostId:   postID,
+			Message:  "nice",
+			ParentId: first.Comment.Id,
+		})
+		assert.Nil(err)
+		_, err = ws.Server.CreateComment(readerCtx, &v1API.CreateCommentRequest{PostId: postID, Message: "not a segment"})
+		assert.Nil(err)
+
+		thread, err := ws.Server.GetThread(readerCtx, &v1API.GetThreadRequest{PostId: postID})
+		assert.Nil(err)
+		assert.Equal(postID, thread.Post.Id)
+		assert.True(thread.Subscribed)
+		assert.Len(thread.Segments, 2)
+		assert.Equal(first.Comment.Id, thread.Segments[0].Comment.Id)
+		assert.Equal(second.Comment.Id, thread.Segments[1].Comment.Id)
+		assert.Equal(int32(1), thread.Segments[0].RepliesCount)
+		assert.Len(thread.Segments[0].Replies, 1)
+		assert.Equal(reply.Comment.Id, thread.Segments[0].Replies[0].Comment.Id)
+		assert.Empty(thread.Segments[1].Replies)
+
+		notifications, err := dbNotifications.NewDirectory(ws.Server.GetDB()).GetAllNotifications(context.Background(), reader.Id)
+		assert.Nil(err)
+		segments := map[string]string{}
+		for _, n := range notifications {
+			if n.Event.Id == v1API.EventID_THREAD_SEGMENT {
+				segments[n.TriggerId] = n.Content
+			}
+		}
+		assert.Equal(map[string]string{first.Comment.Id: "2/", second.Comment.Id: "3/"}, segments)
+
+		// Unsubscribed users are no longer notified
+		unsubscribed, err := ws.Server.UnsubscribeFromThread(readerCtx, &v1API.UnsubscribeFromThreadRequest{PostId: postID})
+		assert.Nil(err)
+		assert.False(unsubscribed.Subscribed)
+		third, err := ws.Server.CreateComment(authorCtx, &v1API.CreateCommentRequest{
+			PostId:  postID,
+			Message: "4/",
+			Thread:  &v1API.ThreadRequest{ThreadType: v1API.ThreadType_POST},
+		})
+		assert.Nil(err)
+		notifications, err = dbNotifications.NewDirectory(ws.Server.GetDB()).GetAllNotifications(context.Background(), reader.Id)
+		assert.Nil(err)
+		for _, n := range notifications {
+			assert.NotEqual(third.Comment.Id, n.TriggerId)
+		}
+	})
+
+	t.Run("When the author continues a supporters only thread", func(t *testing.T) {
+		author, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		supporter, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		reader, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		authorCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", author.Id))
+		supporterCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", supporter.Id))
+		readerCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", reader.Id))
+
+		accountID := uuid.NewString()
+		roomID := uuid.NewString()
+		customersDir := customers.NewDirectory(ws.Server.GetDB())
+		_, err = customersDir.StoreConnectedAccount(context.Background(), &customers.StoreConnectedAccountParams{
+			UserID:             author.Id,
+			CustomerID:         uuid.NewString(),
+			AccountID:          accountID,
+			CanReceivePayments: true,
+		})
+		assert.Nil(err)
+		_, err = customersDir.StoreRoomSubscription(context.Background(), &customers.StoreRoomSubscriptionParams{
+			ID:                   uuid.NewString(),
+			UserID:               supporter.Id,
+			CustomerID:           uuid.NewString(),
+			ConnectedCustomerID:  uuid.NewString(),
+			AccountID:            accountID,
+			Status:               string(customers.SubscriptionStatusActive),
+			RoomID:               roomID,
+			RoomSubscriptionType: string(customers.RoomSubscriptionTypeSubscriptionMonthly),
+		})
+		assert.Nil(err)
+		created, err := ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{
+			Message: "1/",
+			SupportersOnly: &v1API.SupportersOnly{
+				RoomId:       roomID,
+				PurchaseType: v1API.RoomSubcriptionType_SUBSCRIPTION_MONTHLY,
+			},
+		})
+		assert.Nil(err)
+		for _, ctx := range []context.Context{supporterCtx, readerCtx} {
+			_, err = ws.Server.SubscribeToThread(ctx, &v1API.SubscribeToThreadRequest{PostId: created.Post.Id})
+			assert.Nil(err)
+		}
+		segment, err := ws.Server.CreateComment(authorCtx, &v1API.CreateCommentRequest{
+			PostId:  created.Post.Id,
+			Message: "2/",
+			Thread:  &v1API.ThreadRequest{ThreadType: v1API.ThreadType_POST},
+		})
+		assert.Nil(err)
+
+		// Only the subscribers who can access the post receive the segment content
+		for userID, content := range map[string]string{supporter.Id: "2/", reader.Id: ""} {
+			notifications, err := dbNotifications.NewDirectory(ws.Server.GetDB()).GetAllNotifications(context.Background(), userID)
+			assert.Nil(err)
+			found := false
+			for _, n := range notifications {
+				if n.TriggerId == segment.Comment.Id {
+					found = true
+					assert.Equal(content, n.Content)
+				}
+			}
+			assert.True(found)
+		}
+	})
+}
@@ -0,0 +1,102 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/mdutils"
+	"github.com/DagDigg/unpaper/backend/pkg/pagination"
+	"github.com/DagDigg/unpaper/backend/posts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetTrendingPosts RPC retrieves a page of the trending posts, globally or of a category, from the highest score.
+// The generation of the ranking is kept in the cursor, so that refreshes do not shift the following pages
+func (s *unpaperServiceServer) GetTrendingPosts(ctx context.Context, req *v1API.GetTrendingPostsRequest) (*v1API.GetTrendingPostsResponse, error) {
+	userID, ok := mdutils.GetUserIDFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "failed to retrieve userID from metadata")
+	}
+	if req.Category != "" && !posts.IsValidCategory(req.Category) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid category: %q", req.Category)
+	}
+	cursor, err := pagination.Decode(req.Cursor)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to decode cursor: %v", err)
+	}
+	pageSize := pagination.PageSize(req.PageSize)
+	postsDir := posts.NewDirectory(s.db)
+
+	params := posts.GetTrendingPostIDsParams{
+		Category: req.Category,
+		PageSize: pageSize,
+	}
+	if cursor != nil {
+		params.Generation = cursor.CreatedAt
+		params.HasCursor = true
+		params.CursorScore = cursor.Score
+		params.CursorID = cursor.ID
+	} else {
+		params.Generation, err = postsDir.GetLatestTrendingGeneration(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to retrieve trending generation: %v", err)
+		}
+		if params.Generation.IsZero() {
+			return &v1API.GetTrendingPostsResponse{Posts: []*v1API.Post{}}, nil
+		}
+	}
+
+	ranking, err := postsDir.GetTrendingPostIDs(ctx, params)
+	if errors.Is(err, posts.ErrTrendingGenerationExpired) {
+		return nil, status.Error(codes.InvalidArgument, "trending posts have been refreshed, request the first page again")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve trending posts: %v", err)
+	}
+	if len(ranking) == 0 {
+		return &v1API.GetTrendingPostsResponse{Posts: []*v1API.Post{}}, nil
+	}
+
+	postIDs := []string{}
+	for _, r := range ranking {
+		postIDs = append(postIDs, r.PostID)
+	}
+	postsList, err := postsDir.GetPostsByIDs(ctx, userID, postIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve posts: %v", err)
+	}
+	byID := map[string]*v1API.Post{}
+	for _, p := range postsList {
+		byID[p.Id] = p
+	}
+	// Keep the ranking order, skipping posts which have been deleted or hidden since the refresh
+	trending := []*v1API.Post{}
+	for _, r := range ranking {
+		if p, ok := byID[r.PostID]; ok {
+			trending = append(trending, p)
+		}
+	}
+
+	if err := addPostsDetails(ctx, s.db, userID, trending); err != nil {
+		return nil, err
+	}
+
+	last := ranking[len(ranking)-1]
+	return &v1API.GetTrendingPostsResponse{
+		Posts: trending,
+		NextCursor: pagination.NextCursor(len(ranking), pageSize, pagination.Cursor{
+			CreatedAt: params.Generation,
+			Score:     last.Score,
+			ID:        last.PostID,
+		}),
+	}, nil
+}
+
+// RefreshTrendingPosts computes a new ranking of trending posts when the latest one is older than posts.TrendingRefreshInterval
+func (s *unpaperServiceServer) RefreshTrendingPosts(ctx context.Context) error {
+	_, err := posts.NewDirectory(s.db).RefreshTrendingPosts(ctx, time.Now().UTC())
+	return err
+}
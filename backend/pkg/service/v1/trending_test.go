@@ -0,0 +1,70 @@
+package v1_test
+
+import (
+	"context"
+	"testing"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	v1Testing "github.com/DagDigg/unpaper/backend/pkg/service/v1/testing"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestTrendingPosts(t *testing.T) {
+	t.Parallel()
+	ws := v1Testing.GetWrappedServer(t)
+	assert := assert.New(t)
+
+	t.Run("When paginating trending posts across refreshes", func(t *testing.T) {
+		author, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		fan, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		authorCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", author.Id))
+		fanCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", fan.Id))
+
+		liked, err := ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{Message: "liked", Category: "music"})
+		assert.Nil(err)
+		ignored, err := ws.Server.CreatePost(authorCtx, &v1API.CreatePostRequest{Message: "ignored", Category: "music"})
+		assert.Nil(err)
+		_, err = ws.Server.LikePost(fanCtx, &v1API.LikePostRequest{PostId: liked.Post.Id})
+		assert.Nil(err)
+
+		_, err = ws.Server.GetDB().Exec("DELETE FROM trending_posts")
+		assert.Nil(err)
+		assert.Nil(ws.Server.RefreshTrendingPosts(context.Background()))
+
+		// Posts without engagement do not trend, and every post shows up once
+		seen := map[string]bool{}
+		req := &v1API.GetTrendingPostsRequest{Category: "music", PageSize: 1}
+		for {
+			res, err := ws.Server.GetTrendingPosts(fanCtx, req)
+			assert.Nil(err)
+			for _, p := range res.Posts {
+				assert.Equal("music", p.Category)
+				assert.False(seen[p.Id])
+				seen[p.Id] = true
+			}
+			if res.NextCursor == "" {
+				break
+			}
+			req.Cursor = res.NextCursor
+		}
+		assert.True(seen[liked.Post.Id])
+		assert.False(seen[ignored.Post.Id])
+
+		_, err = ws.Server.GetTrendingPosts(fanCtx, &v1API.GetTrendingPostsRequest{Category: "unknown"})
+		assert.Equal(codes.InvalidArgument, status.Code(err))
+
+		// Cursors of a generation which has been deleted cannot be paginated
+		first, err := ws.Server.GetTrendingPosts(fanCtx, &v1API.GetTrendingPostsRequest{PageSize: 1})
+		assert.Nil(err)
+		assert.NotEmpty(first.NextCursor)
+		_, err = ws.Server.GetDB().Exec("DELETE FROM trending_posts")
+		assert.Nil(err)
+		_, err = ws.Server.GetTrendingPosts(fanCtx, &v1API.GetTrendingPostsRequest{PageSize: 1, Cursor: first.NextCursor})
+		assert.Equal(codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -1,12 +1,16 @@
 package v1
 
 import (
+	"context"
 	"database/sql"
+	"time"
 
 	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
 	"github.com/DagDigg/unpaper/backend/pkg/chat"
 	chatService "github.com/DagDigg/unpaper/backend/pkg/chat/service"
+	"github.com/DagDigg/unpaper/backend/pkg/dbschema"
 	"github.com/DagDigg/unpaper/backend/pkg/notifications"
+	"github.com/DagDigg/unpaper/backend/pkg/timeline"
 	"github.com/DagDigg/unpaper/backend/pkg/usersession"
 	"github.com/DagDigg/unpaper/core/config"
 	"github.com/DagDigg/unpaper/core/session"
@@ -34,6 +38,7 @@ type unpaperServiceServer struct {
 	chat        chat.Controller
 	nm          notifications.SendListenReceiver
 	usersession usersession.Sessioner
+	timeline    timeline.Timeliner
 }
 
 // Server defines the grpc server
@@ -45,6 +50,15 @@ type Server interface {
 	GetSM() *session.Manager
 	GetNM() notifications.SendListenReceiver
 	GetChat() chat.Controller
+	// PublishDuePosts publishes the scheduled posts whose publish time is past
+	PublishDuePosts(ctx context.Context) error
+	// NotifyClosedPolls notifies the authors of the closed polls with their results
+	NotifyClosedPolls(ctx context.Context) error
+	// RefreshTrendingPosts computes a new ranking of trending posts when the latest one is stale
+	RefreshTrendingPosts(ctx context.Context) error
+	// RunWorkers starts the background publisher of scheduled posts, the notifier of closed polls
+	// and the refresher of trending posts
+	RunWorkers(ctx context.Context, interval time.Duration)
 }
 
 // NewUnpaperServiceServer creates Unpaper service
@@ -53,6 +67,10 @@ func NewUnpaperServiceServer(cfg *config.Config) (Server, error) {
 	if err != nil {
 		return nil, err
 	}
+	// Apply the functions and indexes which are not part of the SchemaHero tables
+	if err := dbschema.Ensure(context.Background(), db); err != nil {
+		return nil, err
+	}
 	sb := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar).RunWith(db)
 
 	opt, err := redis.ParseURL(cfg.GetRDBConnURL().String())
@@ -62,9 +80,10 @@ func NewUnpaperServiceServer(cfg *config.Config) (Server, error) {
 	rdb := redis.NewClient(opt)
 
 	nm := notifications.NewManager(db, rdb)
-	ch := chatService.New(rdb)
+	ch := chatService.New(rdb, nm, suspendedSenderCheck(db))
 	sm := session.NewManager(rdb)
 	usrsession := usersession.NewManager(rdb)
+	tl := timeline.NewManager(db, rdb)
 
 	return &unpaperServiceServer{
 		db:          db,
@@ -75,6 +94,7 @@ func NewUnpaperServiceServer(cfg *config.Config) (Server, error) {
 		chat:        ch,
 		nm:          nm,
 		usersession: usrsession,
+		timeline:    tl,
 	}, nil
 }
 
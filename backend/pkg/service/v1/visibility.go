@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/DagDigg/unpaper/backend/comments"
+	"github.com/DagDigg/unpaper/backend/lists"
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/timeline"
+	"github.com/DagDigg/unpaper/backend/posts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkPostVisible returns a NotFound error if the post does not exist or is hidden to the user,
+// so that hidden posts cannot be told apart from missing ones
+func checkPostVisible(ctx context.Context, db *sql.DB, postID, userID string) error {
+	ok, err := posts.NewDirectory(db).CanViewPost(ctx, postID, userID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check post visibility: %v", err)
+	}
+	if !ok {
+		return status.Errorf(codes.NotFound, "post %q not found", postID)
+	}
+
+	return nil
+}
+
+// getVisibleComment returns the comment, checking that its post is visible to the user
+func getVisibleComment(ctx context.Context, db *sql.DB, commentID, userID string) (*v1API.Comment, error) {
+	c, err := comments.NewDirectory(db).GetComment(ctx, userID, commentID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "comment %q not found", commentID)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to retrieve comment: %v", err)
+	}
+	if err := checkPostVisible(ctx, db, c.PostId, userID); err != nil {
+		return nil, status.Errorf(codes.NotFound, "comment %q not found", commentID)
+	}
+
+	return c, nil
+}
+
+// pbVisibilityToPG converts the requested post audience, checking that the lists are owned by the author
+func pbVisibilityToPG(ctx context.Context, db *sql.DB, authorID string, v v1API.PostVisibility_Enum, listIDs []string) (posts.Visibility, []string, error) {
+	visibility, err := posts.PBVisibilityToPG(v)
+	if err != nil {
+		return "", nil, status.Errorf(codes.InvalidArgument, "invalid visibility: %v", err)
+	}
+	if visibility != posts.VisibilityLists {
+		if len(listIDs) > 0 {
+			return "", nil, status.Error(codes.InvalidArgument, "visibility lists can only be set on LISTS visibility")
+		}
+		return visibility, []string{}, nil
+	}
+	if len(listIDs) == 0 {
+		return "", nil, status.Error(codes.InvalidArgument, "LISTS visibility requires at least a list")
+	}
+
+	listsDir := lists.NewDirectory(db)
+	for _, id := range listIDs {
+		l, err := listsDir.GetListByID(ctx, id)
+		if err != nil && err != sql.ErrNoRows {
+			return "", nil, status.Errorf(codes.Internal, "failed to retrieve list: %v", err)
+		}
+		if l == nil || l.OwnerUserId != authorID {
+			return "", nil, status.Errorf(codes.InvalidArgument, "list %q not found", id)
+		}
+	}
+
+	return visibility, listIDs, nil
+}
+
+// fanOutPost pushes the post into the timelines of the followers allowed to see it
+func (s *unpaperServiceServer) fanOutPost(ctx context.Context, p *v1API.Post) error {
+	e := timeline.Entry{PostID: p.Id, CreatedAt: p.CreatedAt.AsTime()}
+	switch p.Visibility {
+	case v1API.PostVisibility_LISTS:
+		audience, err := listsMembers(ctx, s.db, p.VisibilityListIds)
+		if err != nil {
+			return err
+		}
+		return s.timeline.FanOutTo(ctx, p.Author, e, audience)
+	default:
+		return s.timeline.FanOut(ctx, p.Author, e)
+	}
+}
+
+// listsMembers returns the members of the lists, without duplicates
+func listsMembers(ctx context.Context, db *sql.DB, listIDs []string) ([]string, error) {
+	listsDir := lists.NewDirectory(db)
+	seen := map[string]bool{}
+	res := []string{}
+	for _, id := range listIDs {
+		l, err := listsDir.GetListByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		for userID := range l.AllowedUsers {
+			if !seen[userID] {
+				seen[userID] = true
+				res = append(res, userID)
+			}
+		}
+	}
+
+	return res, nil
+}
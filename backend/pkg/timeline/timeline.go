@@ -0,0 +1,395 @@
+// Package timeline maintains the users home timelines.
+// Posts and reposts are pushed into the followers redis timelines when written (fan-out-on-write),
+// except for large accounts, whose posts are merged in when the timeline is read (fan-out-on-read)
+package timeline
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DagDigg/unpaper/backend/follows"
+	"github.com/DagDigg/unpaper/backend/pkg/pagination"
+	"github.com/DagDigg/unpaper/backend/posts"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	prefixTimeline = "user:timeline:"
+	// LargeAccountFollowers is the number of followers above which
+	// an author posts are not pushed into the followers timelines
+	LargeAccountFollowers = 10000
+	// MaxLength is the maximum number of entries kept in a redis timeline.
+	// Older entries are read from the database
+	MaxLength = 800
+	// TTL is the expiration of an unread timeline
+	TTL = 7 * 24 * time.Hour
+	// fanOutBatchSize is the number of timelines updated on a single redis call
+	fanOutBatchSize = 500
+	// repostSeparator separates the post id from the reposter id in the repost entries keys
+	repostSeparator = ":"
+	// emptyMarker is the member stored in a rebuilt timeline having no entries, so that the
+	// timeline exists and is not rebuilt on every read. Its zero score sorts it after every entry
+	emptyMarker = "-"
+	// EmptyTTL is the expiration of a timeline rebuilt without entries.
+	// It is shorter than TTL, so that posts missed by the fan-out are eventually read
+	EmptyTTL = time.Hour
+)
+
+// Entry is a post of a timeline
+type Entry struct {
+	PostID string
+	// RepostedBy is the user who shared the post, empty when the entry is the post itself
+	RepostedBy string
+	// CreatedAt is the repost creation time for reposts
+	CreatedAt time.Time
+}
+
+// Key uniquely identifies the entry inside a timeline.
+// A post and its reposts are different entries
+func (e Entry) Key() string {
+	if e.RepostedBy == "" {
+		return e.PostID
+	}
+	return e.PostID + repostSeparator + e.RepostedBy
+}
+
+// entryFromKey parses an entry key, as returned by Entry.Key
+func entryFromKey(key string, createdAt time.Time) Entry {
+	parts := strings.SplitN(key, repostSeparator, 2)
+	e := Entry{PostID: parts[0], CreatedAt: createdAt}
+	if len(parts) == 2 {
+		e.RepostedBy = parts[1]
+	}
+	return e
+}
+
+// Timeliner is the interface for reading and maintaining the users home timelines
+type Timeliner interface {
+	FanOut(ctx context.Context, authorID string, e Entry) error
+	FanOutTo(ctx context.Context, authorID string, e Entry, audience []string) error
+	Rebuild(ctx context.Context, userID string) error
+	GetPage(ctx context.Context, userID string, cursor *pagination.Cursor, pageSize int32) ([]Entry, error)
+}
+
+// Manager is the Timeliner implementation backed by redis sorted sets.
+// Entries are scored by creation time in microseconds, which are
+// exactly representable as float64 and match the postgres precision
+type Manager struct {
+	db  *sql.DB
+	rdb *redis.Client
+}
+
+// NewManager returns a Manager instance
+func NewManager(db *sql.DB, rdb *redis.Client) Timeliner {
+	return &Manager{
+		db:  db,
+		rdb: rdb,
+	}
+}
+
+// fanOutScript adds the entry only to the timelines which already exist,
+// so that a missing timeline is entirely rebuilt on the next read.
+// The empty marker is removed, since the timeline is no longer empty
+var fanOutScript = redis.NewScript(`
+for _, key in ipairs(KEYS) do
+	if redis.call('EXISTS', key) == 1 then
+		redis.call('ZREM', key, ARGV[4])
+		redis.call('ZADD', key, ARGV[1], ARGV[2])
+		redis.call('ZREMRANGEBYRANK', key, 0, -(tonumber(ARGV[3]) + 1))
+	end
+end
+return 0
+`)
+
+// FanOut pushes the entry into the timelines of the author followers. For reposts, the author is the reposter.
+// Posts of large accounts are not pushed, and are read from the database instead
+func (m *Manager) FanOut(ctx context.Context, authorID string, e Entry) error {
+	return m.fanOut(ctx, authorID, e, nil)
+}
+
+// FanOutTo pushes the entry into the timelines of the author followers who are part of the audience,
+// such as the members of the lists a post is restricted to
+func (m *Manager) FanOutTo(ctx context.Context, authorID string, e Entry, audience []string) error {
+	allowed := map[string]bool{}
+	for _, id := range audience {
+		allowed[id] = true
+	}
+	return m.fanOut(ctx, authorID, e, allowed)
+}
+
+// fanOut pushes the entry into the followers timelines. Every follower is a recipient when allowed is nil
+func (m *Manager) fanOut(ctx context.Context, authorID string, e Entry, allowed map[string]bool) error {
+	followsDir := follows.NewDirectory(m.db)
+	count, err := followsDir.GetFollowersCount(ctx, authorID)
+	if err != nil {
+		return err
+	}
+	if count >= LargeAccountFollowers {
+		return nil
+	}
+
+	followerIDs, err := followsDir.GetFollowerIDs(ctx, authorID)
+	if err != nil {
+		return err
+	}
+	if allowed != nil {
+		recipients := []string{}
+		for _, id := range followerIDs {
+			if allowed[id] {
+				recipients = append(recipients, id)
+			}
+		}
+		followerIDs = recipients
+	}
+	for start := 0; start < len(followerIDs); start += fanOutBatchSize {
+		end := start + fanOutBatchSize
+		if end > len(followerIDs) {
+			end = len(followerIDs)
+		}
+		keys := []string{}
+		for _, id := range followerIDs[start:end] {
+			keys = append(keys, timelineKey(id))
+		}
+		err := fanOutScript.Run(ctx, m.rdb, keys, score(e.CreatedAt), e.Key(), MaxLength, emptyMarker).Err()
+		if err != nil && err != redis.Nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Rebuild replaces the user timeline with the latest posts of the followed users.
+// A timeline without posts keeps an empty marker until EmptyTTL, so that it receives
+// the fan-out of new posts and is not rebuilt on every read.
+// If the posts cannot be retrieved, the timeline is dropped so that it's rebuilt on the next read
+func (m *Manager) Rebuild(ctx context.Context, userID string) error {
+	key := timelineKey(userID)
+	rows, err := posts.NewDirectory(m.db).GetFollowedPostKeys(ctx, posts.GetFollowedPostKeysParams{
+		ViewerID: userID,
+		PageSize: MaxLength,
+	})
+	if err != nil {
+		if delErr := m.rdb.Del(ctx, key).Err(); delErr != nil {
+			return fmt.Errorf("failed to drop timeline: %v: %v", delErr, err)
+		}
+		return err
+	}
+
+	_, err = m.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		if len(rows) == 0 {
+			pipe.ZAdd(ctx, key, &redis.Z{Score: 0, Member: emptyMarker})
+			pipe.Expire(ctx, key, EmptyTTL)
+			return nil
+		}
+		members := []*redis.Z{}
+		for _, r := range rows {
+			e := Entry{PostID: r.PostID, RepostedBy: r.RepostedBy, CreatedAt: r.CreatedAt}
+			members = append(members, &redis.Z{Score: score(e.CreatedAt), Member: e.Key()})
+		}
+		pipe.ZAdd(ctx, key, members...)
+		pipe.Expire(ctx, key, TTL)
+		return nil
+	})
+
+	return err
+}
+
+// GetPage returns a page of the user timeline, from the newest entry after the cursor.
+// Entries pushed into the redis timeline are merged with the posts of the followed large accounts
+func (m *Manager) GetPage(ctx context.Context, userID string, cursor *pagination.Cursor, pageSize int32) ([]Entry, error) {
+	key := timelineKey(userID)
+	exists, err := m.rdb.Exists(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+	if exists == 0 {
+		if err := m.Rebuild(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	entries, err := m.readTimeline(ctx, key, cursor, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	if int32(len(entries)) < pageSize {
+		// The redis timeline is trimmed to MaxLength entries, older ones are read from the database
+		length, err := m.rdb.ZCard(ctx, key).Result()
+		if err != nil {
+			return nil, err
+		}
+		if length >= MaxLength {
+			entries, err = m.readFollowedPosts(ctx, userID, cursor, pageSize)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	largeEntries, err := m.readLargeAccountsPosts(ctx, userID, cursor, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// An empty timeline keeps expiring after EmptyTTL
+	err = m.rdb.ZScore(ctx, key, emptyMarker).Err()
+	if err == redis.Nil {
+		if err := m.rdb.Expire(ctx, key, TTL).Err(); err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	return merge(pageSize, entries, largeEntries), nil
+}
+
+// readTimeline reads the redis timeline entries after the cursor, skipping the empty marker
+func (m *Manager) readTimeline(ctx context.Context, key string, cursor *pagination.Cursor, pageSize int32) ([]Entry, error) {
+	max := "+inf"
+	if cursor != nil {
+		max = strconv.FormatFloat(score(cursor.CreatedAt), 'f', -1, 64)
+	}
+
+	res := []Entry{}
+	var offset int64
+	for int32(len(res)) < pageSize {
+		zs, err := m.rdb.ZRevRangeByScoreWithScores(ctx, key, &redis.ZRangeBy{
+			Max:    max,
+			Min:    "(0",
+			Offset: offset,
+			Count:  int64(pageSize),
+		}).Result()
+		if err != nil {
+			return nil, err
+		}
+		for _, z := range zs {
+			e := entryFromKey(z.Member.(string), fromScore(z.Score))
+			// Entries created at the same time of the cursor are ordered by id
+			if cursor != nil && !isAfter(e, cursor) {
+				continue
+			}
+			res = append(res, e)
+			if int32(len(res)) == pageSize {
+				break
+			}
+		}
+		if len(zs) < int(pageSize) {
+			break
+		}
+		offset += int64(len(zs))
+	}
+
+	return res, nil
+}
+
+func (m *Manager) readFollowedPosts(ctx context.Context, userID string, cursor *pagination.Cursor, pageSize int32) ([]Entry, error) {
+	params := posts.GetFollowedPostKeysParams{
+		ViewerID: userID,
+		PageSize: pageSize,
+	}
+	if cursor != nil {
+		params.HasCursor = true
+		params.CursorCreatedAt = cursor.CreatedAt
+		params.CursorID = cursor.ID
+	}
+	rows, err := posts.NewDirectory(m.db).GetFollowedPostKeys(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []Entry{}
+	for _, r := range rows {
+		res = append(res, Entry{PostID: r.PostID, RepostedBy: r.RepostedBy, CreatedAt: r.CreatedAt})
+	}
+
+	return res, nil
+}
+
+func (m *Manager) readLargeAccountsPosts(ctx context.Context, userID string, cursor *pagination.Cursor, pageSize int32) ([]Entry, error) {
+	authorIDs, err := follows.NewDirectory(m.db).GetLargeFollowingUserIDs(ctx, userID, LargeAccountFollowers)
+	if err != nil {
+		return nil, err
+	}
+	if len(authorIDs) == 0 {
+		return []Entry{}, nil
+	}
+
+	params := posts.GetPostKeysByAuthorsParams{
+		AuthorIds: authorIDs,
+		ViewerID:  userID,
+		PageSize:  pageSize,
+	}
+	if cursor != nil {
+		params.HasCursor = true
+		params.CursorCreatedAt = cursor.CreatedAt
+		params.CursorID = cursor.ID
+	}
+	rows, err := posts.NewDirectory(m.db).GetPostKeysByAuthors(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []Entry{}
+	for _, r := range rows {
+		res = append(res, Entry{PostID: r.PostID, RepostedBy: r.RepostedBy, CreatedAt: r.CreatedAt})
+	}
+
+	return res, nil
+}
+
+// merge returns the newest pageSize entries of the lists, without duplicates
+func merge(pageSize int32, lists ...[]Entry) []Entry {
+	res := []Entry{}
+	seen := map[string]bool{}
+	for _, l := range lists {
+		for _, e := range l {
+			if seen[e.Key()] {
+				continue
+			}
+			seen[e.Key()] = true
+			res = append(res, e)
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return isNewer(res[i], res[j])
+	})
+	if int32(len(res)) > pageSize {
+		res = res[:pageSize]
+	}
+
+	return res
+}
+
+// isNewer returns whether a comes before b in a reverse chronological timeline
+func isNewer(a, b Entry) bool {
+	if !a.CreatedAt.Equal(b.CreatedAt) {
+		return a.CreatedAt.After(b.CreatedAt)
+	}
+	return a.Key() > b.Key()
+}
+
+// isAfter returns whether the entry comes after the cursor in a reverse chronological timeline
+func isAfter(e Entry, cursor *pagination.Cursor) bool {
+	return isNewer(entryFromKey(cursor.ID, cursor.CreatedAt), e)
+}
+
+func score(t time.Time) float64 {
+	return float64(t.UnixNano() / int64(time.Microsecond))
+}
+
+func fromScore(s float64) time.Time {
+	return time.Unix(0, int64(s)*int64(time.Microsecond)).UTC()
+}
+
+func timelineKey(userID string) string {
+	return prefixTimeline + userID
+}
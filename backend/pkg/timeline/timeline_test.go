@@ -0,0 +1,179 @@
+package timeline_test
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/DagDigg/unpaper/backend/follows"
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/DagDigg/unpaper/backend/pkg/pagination"
+	v1Testing "github.com/DagDigg/unpaper/backend/pkg/service/v1/testing"
+	"github.com/DagDigg/unpaper/backend/pkg/timeline"
+	"github.com/DagDigg/unpaper/backend/posts"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeline(t *testing.T) {
+	ws := v1Testing.GetWrappedServer(t)
+	db := ws.Server.GetDB()
+	rdb := ws.Server.GetRDB()
+	tl := timeline.NewManager(db, rdb)
+	followsDir := follows.NewDirectory(db)
+	assert := assert.New(t)
+
+	addUser := func() *v1API.User {
+		usr, err := ws.AddUser(v1Testing.GetRandomPGUserParams())
+		assert.Nil(err)
+		return usr
+	}
+	follow := func(ctx context.Context, followerID, followingID string) {
+		_, err := followsDir.FollowUser(ctx, follows.FollowUserParams{
+			FollowerUserID:  followerID,
+			FollowingUserID: followingID,
+			FollowDate:      time.Now(),
+		})
+		assert.Nil(err)
+	}
+	timelineExists := func(ctx context.Context, userID string) bool {
+		n, err := rdb.Exists(ctx, "user:timeline:"+userID).Result()
+		assert.Nil(err)
+		return n == 1
+	}
+	keys := func(entries []timeline.Entry) []string {
+		res := []string{}
+		for _, e := range entries {
+			res = append(res, e.Key())
+		}
+		return res
+	}
+
+	t.Run("When rebuilding a timeline without posts", func(t *testing.T) {
+		ctx := context.Background()
+		usr := addUser()
+
+		assert.Nil(tl.Rebuild(ctx, usr.Id))
+		assert.True(timelineExists(ctx, usr.Id))
+		ttl, err := rdb.TTL(ctx, "user:timeline:"+usr.Id).Result()
+		assert.Nil(err)
+		assert.True(ttl > 0 && ttl <= timeline.EmptyTTL)
+
+		// Reading keeps the empty timeline, and its expiration
+		entries, err := tl.GetPage(ctx, usr.Id, nil, 10)
+		assert.Nil(err)
+		assert.Len(entries, 0)
+		ttl, err = rdb.TTL(ctx, "user:timeline:"+usr.Id).Result()
+		assert.Nil(err)
+		assert.True(ttl > 0 && ttl <= timeline.EmptyTTL)
+	})
+
+	t.Run("When fanning out to followers", func(t *testing.T) {
+		ctx := context.Background()
+		author := addUser()
+		withTimeline := addUser()
+		withoutTimeline := addUser()
+		follow(ctx, withTimeline.Id, author.Id)
+		follow(ctx, withoutTimeline.Id, author.Id)
+		assert.Nil(tl.Rebuild(ctx, withTimeline.Id))
+
+		e := timeline.Entry{PostID: uuid.NewString(), CreatedAt: time.Now().UTC()}
+		assert.Nil(tl.FanOut(ctx, author.Id, e))
+
+		// Only existing timelines receive the entry
+		assert.False(timelineExists(ctx, withoutTimeline.Id))
+		entries, err := tl.GetPage(ctx, withTimeline.Id, nil, 10)
+		assert.Nil(err)
+		assert.Equal([]string{e.Key()}, keys(entries))
+		// The timeline is no longer empty, and expires after TTL
+		ttl, err := rdb.TTL(ctx, "user:timeline:"+withTimeline.Id).Result()
+		assert.Nil(err)
+		assert.True(ttl > timeline.EmptyTTL)
+	})
+
+	t.Run("When paginating entries created at the same time", func(t *testing.T) {
+		ctx := context.Background()
+		author := addUser()
+		reposter := addUser()
+		follower := addUser()
+		follow(ctx, follower.Id, author.Id)
+		follow(ctx, follower.Id, reposter.Id)
+		assert.Nil(tl.Rebuild(ctx, follower.Id))
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		postID := uuid.NewString()
+		fanOuts := []struct {
+			authorID string
+			entry    timeline.Entry
+		}{
+			{author.Id, timeline.Entry{PostID: uuid.NewString(), CreatedAt: now}},
+			{author.Id, timeline.Entry{PostID: uuid.NewString(), CreatedAt: now}},
+			{author.Id, timeline.Entry{PostID: postID, CreatedAt: now.Add(-time.Minute)}},
+			{reposter.Id, timeline.Entry{PostID: postID, RepostedBy: reposter.Id, CreatedAt: now}},
+		}
+		for _, f := range fanOuts {
+			assert.Nil(tl.FanOut(ctx, f.authorID, f.entry))
+		}
+
+		first, err := tl.GetPage(ctx, follower.Id, nil, 2)
+		assert.Nil(err)
+		assert.Len(first, 2)
+		last := first[len(first)-1]
+		second, err := tl.GetPage(ctx, follower.Id, &pagination.Cursor{CreatedAt: last.CreatedAt, ID: last.Key()}, 2)
+		assert.Nil(err)
+
+		// Entries created at the same time are ordered by key, the repost
+		// being a different entry than its post, and no entry is repeated
+		expected := []string{fanOuts[0].entry.Key(), fanOuts[1].entry.Key(), fanOuts[3].entry.Key()}
+		sort.Sort(sort.Reverse(sort.StringSlice(expected)))
+		expected = append(expected, fanOuts[2].entry.Key())
+		assert.Equal(expected, append(keys(first), keys(second)...))
+	})
+
+	t.Run("When following a large account", func(t *testing.T) {
+		ctx := context.Background()
+		large := addUser()
+		small := addUser()
+		follower := addUser()
+		follow(ctx, follower.Id, small.Id)
+
+		// Add the followers making the account large, the last one being the reader
+		_, err := db.Exec(`
+			INSERT INTO users (id, email)
+			SELECT $1 || g, $1 || g || '@test.com' FROM generate_series(2, $2) g`,
+			large.Id, timeline.LargeAccountFollowers)
+		assert.Nil(err)
+		_, err = db.Exec(`
+			INSERT INTO follows (follower_user_id, following_user_id, follow_date)
+			SELECT $1 || g, $1, now() FROM generate_series(2, $2) g`,
+			large.Id, timeline.LargeAccountFollowers)
+		assert.Nil(err)
+		follow(ctx, follower.Id, large.Id)
+		assert.Nil(tl.Rebuild(ctx, follower.Id))
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		largePost, err := posts.NewDirectory(db).CreatePost(ctx, posts.CreatePostParams{
+			ID:        uuid.NewString(),
+			Author:    large.Id,
+			Message:   "large",
+			Audio:     json.RawMessage("{}"),
+			CreatedAt: now,
+		})
+		assert.Nil(err)
+		smallEntry := timeline.Entry{PostID: uuid.NewString(), CreatedAt: now.Add(-time.Minute)}
+		// Posts of large accounts are not pushed
+		assert.Nil(tl.FanOut(ctx, large.Id, timeline.Entry{PostID: largePost.Id, CreatedAt: now}))
+		assert.Nil(tl.FanOut(ctx, small.Id, smallEntry))
+
+		entries, err := tl.GetPage(ctx, follower.Id, nil, 10)
+		assert.Nil(err)
+		assert.Equal([]string{largePost.Id, smallEntry.Key()}, keys(entries))
+
+		// The page after the large account post only holds the pushed entry
+		entries, err = tl.GetPage(ctx, follower.Id, &pagination.Cursor{CreatedAt: now, ID: largePost.Id}, 10)
+		assert.Nil(err)
+		assert.Equal([]string{smallEntry.Key()}, keys(entries))
+	})
+}
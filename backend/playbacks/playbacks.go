@@ -0,0 +1,128 @@
+package playbacks
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DedupWindow is the time within which repeated playbacks of a post by the same user count as a single play
+const DedupWindow = 30 * time.Minute
+
+// Granularity is the length of the periods playback stats are grouped by
+type Granularity string
+
+const (
+	// GranularityDay groups stats by day
+	GranularityDay Granularity = "day"
+	// GranularityHour groups stats by hour
+	GranularityHour Granularity = "hour"
+)
+
+// PBGranularityToPG converts a protobuf analytics granularity to postgres
+func PBGranularityToPG(g v1API.AnalyticsGranularity_Enum) (Granularity, error) {
+	switch g {
+	case v1API.AnalyticsGranularity_DAY:
+		return GranularityDay, nil
+	case v1API.AnalyticsGranularity_HOUR:
+		return GranularityHour, nil
+	default:
+		return "", fmt.Errorf("invalid analytics granularity: %v", g)
+	}
+}
+
+// Directory is the directory which operates on db table 'playbacks'
+type Directory struct {
+	// querier is an interface containing all of the
+	// directory methods. Must be created with playbacks.NewDirectory(db)
+	querier Querier
+	db      *sql.DB
+}
+
+// NewDirectory creates a new playbacks directory
+func NewDirectory(db *sql.DB) *Directory {
+	return &Directory{db: db, querier: New(db)}
+}
+
+// Close closes Directory database connection
+func (d Directory) Close() error {
+	return d.db.Close()
+}
+
+// RecordPlayback appends a playback event, incrementing the post plays unless the user
+// already played it within DedupWindow or is the author. The playback is completed when listenedMs
+// reaches the audio durationMs. Returns sql.ErrNoRows if the post does not exist
+func (d Directory) RecordPlayback(ctx context.Context, postID, userID string, listenedMs, durationMs int32) (RecordPlaybackRow, error) {
+	return d.querier.RecordPlayback(ctx, RecordPlaybackParams{
+		ID:                 uuid.NewString(),
+		PostID:             postID,
+		UserID:             userID,
+		ListenedMs:         listenedMs,
+		Completed:          listenedMs >= durationMs,
+		CreatedAt:          time.Now().UTC(),
+		DedupWindowSeconds: DedupWindow.Seconds(),
+	})
+}
+
+// GetStatsParams are the params of GetStats
+type GetStatsParams struct {
+	PostID string
+	// DurationMs is the audio duration, which listen-through is relative to
+	DurationMs  int32
+	Granularity Granularity
+	Since       time.Time
+	Until       time.Time
+}
+
+// GetStats returns the playback stats of the post within the range, in total and by period from the oldest
+func (d Directory) GetStats(ctx context.Context, params GetStatsParams) (*v1API.PlaybackStats, []*v1API.PlaybackStats, error) {
+	totals, err := d.querier.GetPlaybackTotals(ctx, GetPlaybackTotalsParams{
+		PostID: params.PostID,
+		Since:  params.Since,
+		Until:  params.Until,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	buckets, err := d.querier.GetPlaybackBuckets(ctx, GetPlaybackBucketsParams{
+		Granularity: string(params.Granularity),
+		PostID:      params.PostID,
+		Since:       params.Since,
+		Until:       params.Until,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	periods := []*v1API.PlaybackStats{}
+	for _, b := range buckets {
+		stats := pgStatsToPB(GetPlaybackTotalsRow{
+			Plays:           b.Plays,
+			UniqueListeners: b.UniqueListeners,
+			AvgListenedMs:   b.AvgListenedMs,
+			CompletionRate:  b.CompletionRate,
+		}, params.DurationMs)
+		stats.PeriodStart = timestamppb.New(b.Bucket)
+		periods = append(periods, stats)
+	}
+
+	return pgStatsToPB(totals, params.DurationMs), periods, nil
+}
+
+func pgStatsToPB(s GetPlaybackTotalsRow, durationMs int32) *v1API.PlaybackStats {
+	stats := &v1API.PlaybackStats{
+		Plays:           int32(s.Plays),
+		UniqueListeners: int32(s.UniqueListeners),
+		CompletionRate:  s.CompletionRate,
+	}
+	if durationMs > 0 {
+		stats.AvgListenThrough = s.AvgListenedMs / float64(durationMs)
+	}
+
+	return stats
+}
@@ -0,0 +1,15 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package playbacks
+
+import (
+	"context"
+)
+
+type Querier interface {
+	GetPlaybackBuckets(ctx context.Context, arg GetPlaybackBucketsParams) ([]GetPlaybackBucketsRow, error)
+	GetPlaybackTotals(ctx context.Context, arg GetPlaybackTotalsParams) (GetPlaybackTotalsRow, error)
+	RecordPlayback(ctx context.Context, arg RecordPlaybackParams) (RecordPlaybackRow, error)
+}
+
+var _ Querier = (*Queries)(nil)
@@ -0,0 +1,45 @@
+-- name: RecordPlayback :one
+WITH pb AS (
+	INSERT INTO playbacks (id, post_id, user_id, listened_ms, completed, counted, created_at)
+	SELECT sqlc.arg(id)::VARCHAR(100), p.id, sqlc.arg(user_id)::VARCHAR(100), sqlc.arg(listened_ms)::INTEGER, sqlc.arg(completed)::BOOLEAN,
+	p.author != sqlc.arg(user_id)::VARCHAR(100) AND NOT EXISTS (
+		SELECT 1 FROM playbacks d
+		WHERE d.post_id = p.id AND d.user_id = sqlc.arg(user_id)::VARCHAR(100) AND d.counted AND
+		d.created_at > sqlc.arg(created_at)::TIMESTAMPTZ - sqlc.arg(dedup_window_seconds)::FLOAT8 * interval '1 second'
+	),
+	sqlc.arg(created_at)::TIMESTAMPTZ
+	FROM posts p
+	WHERE p.id = sqlc.arg(post_id)::VARCHAR(100)
+	RETURNING counted
+)
+UPDATE posts
+SET plays = plays + (SELECT COUNT(*) FROM pb WHERE counted)
+WHERE id = sqlc.arg(post_id)::VARCHAR(100)
+RETURNING plays, COALESCE((SELECT counted FROM pb), FALSE)::BOOLEAN AS counted;
+
+-- name: GetPlaybackTotals :one
+SELECT
+COUNT(*) FILTER (WHERE pb.counted) AS plays,
+COUNT(DISTINCT pb.user_id) AS unique_listeners,
+COALESCE(AVG(pb.listened_ms), 0)::FLOAT8 AS avg_listened_ms,
+COALESCE(AVG(pb.completed::INTEGER), 0)::FLOAT8 AS completion_rate
+FROM playbacks pb
+JOIN posts p ON p.id = pb.post_id
+-- The author playbacks are not part of the analytics
+WHERE pb.post_id = sqlc.arg(post_id)::VARCHAR(100) AND pb.user_id != p.author AND
+pb.created_at >= sqlc.arg(since)::TIMESTAMPTZ AND pb.created_at < sqlc.arg(until)::TIMESTAMPTZ;
+
+-- name: GetPlaybackBuckets :many
+SELECT
+date_trunc(sqlc.arg(granularity)::TEXT, pb.created_at)::TIMESTAMPTZ AS bucket,
+COUNT(*) FILTER (WHERE pb.counted) AS plays,
+COUNT(DISTINCT pb.user_id) AS unique_listeners,
+COALESCE(AVG(pb.listened_ms), 0)::FLOAT8 AS avg_listened_ms,
+COALESCE(AVG(pb.completed::INTEGER), 0)::FLOAT8 AS completion_rate
+FROM playbacks pb
+JOIN posts p ON p.id = pb.post_id
+-- The author playbacks are not part of the analytics
+WHERE pb.post_id = sqlc.arg(post_id)::VARCHAR(100) AND pb.user_id != p.author AND
+pb.created_at >= sqlc.arg(since)::TIMESTAMPTZ AND pb.created_at < sqlc.arg(until)::TIMESTAMPTZ
+GROUP BY bucket
+ORDER BY bucket;
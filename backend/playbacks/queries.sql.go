@@ -0,0 +1,162 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: queries.sql
+
+package playbacks
+
+import (
+	"context"
+	"time"
+)
+
+const getPlaybackBuckets = `-- name: GetPlaybackBuckets :many
+SELECT
+date_trunc($1::TEXT, pb.created_at)::TIMESTAMPTZ AS bucket,
+COUNT(*) FILTER (WHERE pb.counted) AS plays,
+COUNT(DISTINCT pb.user_id) AS unique_listeners,
+COALESCE(AVG(pb.listened_ms), 0)::FLOAT8 AS avg_listened_ms,
+COALESCE(AVG(pb.completed::INTEGER), 0)::FLOAT8 AS completion_rate
+FROM playbacks pb
+JOIN posts p ON p.id = pb.post_id
+WHERE pb.post_id = $2::VARCHAR(100) AND pb.user_id != p.author AND
+pb.created_at >= $3::TIMESTAMPTZ AND pb.created_at < $4::TIMESTAMPTZ
+GROUP BY bucket
+ORDER BY bucket
+`
+
+type GetPlaybackBucketsParams struct {
+	Granularity string
+	PostID      string
+	Since       time.Time
+	Until       time.Time
+}
+
+type GetPlaybackBucketsRow struct {
+	Bucket          time.Time
+	Plays           int64
+	UniqueListeners int64
+	AvgListenedMs   float64
+	CompletionRate  float64
+}
+
+// The author playbacks are not part of the analytics
+func (q *Queries) GetPlaybackBuckets(ctx context.Context, arg GetPlaybackBucketsParams) ([]GetPlaybackBucketsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPlaybackBuckets,
+		arg.Granularity,
+		arg.PostID,
+		arg.Since,
+		arg.Until,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPlaybackBucketsRow
+	for rows.Next() {
+		var i GetPlaybackBucketsRow
+		if err := rows.Scan(
+			&i.Bucket,
+			&i.Plays,
+			&i.UniqueListeners,
+			&i.AvgListenedMs,
+			&i.CompletionRate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPlaybackTotals = `-- name: GetPlaybackTotals :one
+SELECT
+COUNT(*) FILTER (WHERE pb.counted) AS plays,
+COUNT(DISTINCT pb.user_id) AS unique_listeners,
+COALESCE(AVG(pb.listened_ms), 0)::FLOAT8 AS avg_listened_ms,
+COALESCE(AVG(pb.completed::INTEGER), 0)::FLOAT8 AS completion_rate
+FROM playbacks pb
+JOIN posts p ON p.id = pb.post_id
+WHERE pb.post_id = $1::VARCHAR(100) AND pb.user_id != p.author AND
+pb.created_at >= $2::TIMESTAMPTZ AND pb.created_at < $3::TIMESTAMPTZ
+`
+
+type GetPlaybackTotalsParams struct {
+	PostID string
+	Since  time.Time
+	Until  time.Time
+}
+
+type GetPlaybackTotalsRow struct {
+	Plays           int64
+	UniqueListeners int64
+	AvgListenedMs   float64
+	CompletionRate  float64
+}
+
+// The author playbacks are not part of the analytics
+func (q *Queries) GetPlaybackTotals(ctx context.Context, arg GetPlaybackTotalsParams) (GetPlaybackTotalsRow, error) {
+	row := q.db.QueryRowContext(ctx, getPlaybackTotals, arg.PostID, arg.Since, arg.Until)
+	var i GetPlaybackTotalsRow
+	err := row.Scan(
+		&i.Plays,
+		&i.UniqueListeners,
+		&i.AvgListenedMs,
+		&i.CompletionRate,
+	)
+	return i, err
+}
+
+const recordPlayback = `-- name: RecordPlayback :one
+WITH pb AS (
+	INSERT INTO playbacks (id, post_id, user_id, listened_ms, completed, counted, created_at)
+	SELECT $2::VARCHAR(100), p.id, $3::VARCHAR(100), $4::INTEGER, $5::BOOLEAN,
+	p.author != $3::VARCHAR(100) AND NOT EXISTS (
+		SELECT 1 FROM playbacks d
+		WHERE d.post_id = p.id AND d.user_id = $3::VARCHAR(100) AND d.counted AND
+		d.created_at > $6::TIMESTAMPTZ - $7::FLOAT8 * interval '1 second'
+	),
+	$6::TIMESTAMPTZ
+	FROM posts p
+	WHERE p.id = $1::VARCHAR(100)
+	RETURNING counted
+)
+UPDATE posts
+SET plays = plays + (SELECT COUNT(*) FROM pb WHERE counted)
+WHERE id = $1::VARCHAR(100)
+RETURNING plays, COALESCE((SELECT counted FROM pb), FALSE)::BOOLEAN AS counted
+`
+
+type RecordPlaybackParams struct {
+	PostID             string
+	ID                 string
+	UserID             string
+	ListenedMs         int32
+	Completed          bool
+	CreatedAt          time.Time
+	DedupWindowSeconds float64
+}
+
+type RecordPlaybackRow struct {
+	Plays   int32
+	Counted bool
+}
+
+func (q *Queries) RecordPlayback(ctx context.Context, arg RecordPlaybackParams) (RecordPlaybackRow, error) {
+	row := q.db.QueryRowContext(ctx, recordPlayback,
+		arg.PostID,
+		arg.ID,
+		arg.UserID,
+		arg.ListenedMs,
+		arg.Completed,
+		arg.CreatedAt,
+		arg.DedupWindowSeconds,
+	)
+	var i RecordPlaybackRow
+	err := row.Scan(&i.Plays, &i.Counted)
+	return i, err
+}
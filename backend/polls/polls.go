@@ -0,0 +1,211 @@
+package polls
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// MinOptions is the minimum number of options of a poll
+	MinOptions = 2
+	// MaxOptions is the maximum number of options of a poll
+	MaxOptions = 6
+	// MaxOptionLength is the maximum number of characters of a poll option
+	MaxOptionLength = 100
+	// MinDuration is the minimum time a poll stays open
+	MinDuration = 5 * time.Minute
+	// MaxDuration is the maximum time a poll stays open
+	MaxDuration = 30 * 24 * time.Hour
+)
+
+// Directory is the directory which operates on db tables 'polls', 'poll_options' and 'poll_votes'
+type Directory struct {
+	// querier is an interface containing all of the
+	// directory methods. Must be created with polls.NewDirectory(db)
+	querier Querier
+	db      *sql.DB
+}
+
+// NewDirectory creates a new polls directory
+func NewDirectory(db *sql.DB) *Directory {
+	return &Directory{db: db, querier: New(db)}
+}
+
+// Close closes Directory database connection
+func (d Directory) Close() error {
+	return d.db.Close()
+}
+
+// NormalizeOptions trims the poll options, validating their number and length.
+// Options must be distinct
+func NormalizeOptions(options []string) ([]string, error) {
+	if len(options) < MinOptions || len(options) > MaxOptions {
+		return nil, fmt.Errorf("a poll must have between %d and %d options", MinOptions, MaxOptions)
+	}
+	res := []string{}
+	seen := map[string]bool{}
+
+	for _, o := range options {
+		option := strings.TrimSpace(o)
+		if option == "" {
+			return nil, fmt.Errorf("poll options cannot be empty")
+		}
+		if len([]rune(option)) > MaxOptionLength {
+			return nil, fmt.Errorf("poll option %q exceeds %d characters", option, MaxOptionLength)
+		}
+		key := strings.ToLower(option)
+		if seen[key] {
+			return nil, fmt.Errorf("poll option %q is repeated", option)
+		}
+		seen[key] = true
+		res = append(res, option)
+	}
+
+	return res, nil
+}
+
+// NormalizeVote deduplicates and sorts the positions of the voted options, validating them against the poll
+func NormalizeVote(poll *v1API.Poll, options []int32) ([]int32, error) {
+	res := []int32{}
+	seen := map[int32]bool{}
+
+	for _, o := range options {
+		if o < 0 || int(o) >= len(poll.Options) {
+			return nil, fmt.Errorf("invalid poll option %d", o)
+		}
+		if seen[o] {
+			continue
+		}
+		seen[o] = true
+		res = append(res, o)
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("at least an option must be voted")
+	}
+	if len(res) > 1 && !poll.MultipleChoice {
+		return nil, fmt.Errorf("only one option can be voted")
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+
+	return res, nil
+}
+
+// CreatePoll stores the poll of the post along with its options
+func (d Directory) CreatePoll(ctx context.Context, params CreatePollParams) (*v1API.Poll, error) {
+	if params.CreatedAt.IsZero() {
+		params.CreatedAt = time.Now().UTC()
+	}
+	res, err := d.querier.CreatePoll(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	poll := pgPollToPB(Poll(res), params.CreatedAt)
+	for _, o := range params.Options {
+		poll.Options = append(poll.Options, &v1API.PollOption{Text: o})
+	}
+
+	return poll, nil
+}
+
+// GetPollsByPostIDs returns the polls of the posts along with their tallies and the votes of the user, keyed by post id.
+// Posts without a poll are not part of the result
+func (d Directory) GetPollsByPostIDs(ctx context.Context, userID string, postIDs []string) (map[string]*v1API.Poll, error) {
+	res := map[string]*v1API.Poll{}
+	if len(postIDs) == 0 {
+		return res, nil
+	}
+	pgPolls, err := d.querier.GetPollsByPostIDs(ctx, postIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(pgPolls) == 0 {
+		return res, nil
+	}
+
+	now := time.Now()
+	ids := []string{}
+	for _, p := range pgPolls {
+		res[p.PostID] = pgPollToPB(p, now)
+		ids = append(ids, p.PostID)
+	}
+	options, err := d.querier.GetPollOptionsByPostIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range options {
+		res[o.PostID].Options = append(res[o.PostID].Options, &v1API.PollOption{Text: o.Text, Votes: o.Votes})
+	}
+	voters, err := d.querier.CountPollVotersByPostIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range voters {
+		res[v.PostID].VotersCount = v.Voters
+	}
+	votes, err := d.querier.GetUserPollVotes(ctx, GetUserPollVotesParams{UserID: userID, PostIds: ids})
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range votes {
+		res[v.PostID].OwnVotes = v.Options
+	}
+
+	return res, nil
+}
+
+// VotePoll records the vote of the user. It returns false if the user already voted or the poll is closed,
+// as votes cannot be changed
+func (d Directory) VotePoll(ctx context.Context, postID, userID string, options []int32) (bool, error) {
+	n, err := d.querier.VotePoll(ctx, VotePollParams{
+		PostID:    postID,
+		UserID:    userID,
+		Options:   options,
+		CreatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+// ClaimClosedPolls marks up to batchSize closed polls as notified, returning the ones whose post still exists.
+// Rows are claimed with FOR UPDATE SKIP LOCKED, so that concurrent replicas never notify the same poll twice
+func (d Directory) ClaimClosedPolls(ctx context.Context, batchSize int32) ([]ClaimClosedPollsRow, error) {
+	return d.querier.ClaimClosedPolls(ctx, ClaimClosedPollsParams{
+		Now:       time.Now().UTC(),
+		BatchSize: batchSize,
+	})
+}
+
+// FormatResults returns a summary of the poll results, from the most voted option
+func FormatResults(poll *v1API.Poll) string {
+	options := make([]*v1API.PollOption, len(poll.Options))
+	copy(options, poll.Options)
+	sort.SliceStable(options, func(i, j int) bool { return options[i].Votes > options[j].Votes })
+
+	results := []string{}
+	for _, o := range options {
+		results = append(results, fmt.Sprintf("%s: %d", o.Text, o.Votes))
+	}
+
+	return strings.Join(results, ", ")
+}
+
+func pgPollToPB(p Poll, now time.Time) *v1API.Poll {
+	return &v1API.Poll{
+		Options:        []*v1API.PollOption{},
+		MultipleChoice: p.MultipleChoice,
+		ClosesAt:       timestamppb.New(p.ClosesAt),
+		Closed:         !p.ClosesAt.After(now),
+		OwnVotes:       []int32{},
+	}
+}
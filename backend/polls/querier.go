@@ -0,0 +1,19 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package polls
+
+import (
+	"context"
+)
+
+type Querier interface {
+	ClaimClosedPolls(ctx context.Context, arg ClaimClosedPollsParams) ([]ClaimClosedPollsRow, error)
+	CountPollVotersByPostIDs(ctx context.Context, postIds []string) ([]CountPollVotersByPostIDsRow, error)
+	CreatePoll(ctx context.Context, arg CreatePollParams) (CreatePollRow, error)
+	GetPollOptionsByPostIDs(ctx context.Context, postIds []string) ([]GetPollOptionsByPostIDsRow, error)
+	GetPollsByPostIDs(ctx context.Context, postIds []string) ([]Poll, error)
+	GetUserPollVotes(ctx context.Context, arg GetUserPollVotesParams) ([]GetUserPollVotesRow, error)
+	VotePoll(ctx context.Context, arg VotePollParams) (int64, error)
+}
+
+var _ Querier = (*Queries)(nil)
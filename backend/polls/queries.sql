@@ -0,0 +1,55 @@
+-- name: CreatePoll :one
+WITH pl AS (
+	INSERT INTO polls (post_id, multiple_choice, closes_at, created_at)
+	VALUES (sqlc.arg(post_id)::VARCHAR(100), sqlc.arg(multiple_choice)::BOOLEAN, sqlc.arg(closes_at)::TIMESTAMPTZ, sqlc.arg(created_at)::TIMESTAMPTZ)
+	RETURNING *
+), opts AS (
+	INSERT INTO poll_options (post_id, position, text)
+	SELECT pl.post_id, generate_subscripts(sqlc.arg(options)::VARCHAR[], 1) - 1, unnest(sqlc.arg(options)::VARCHAR[])
+	FROM pl
+)
+SELECT * FROM pl;
+
+-- name: GetPollsByPostIDs :many
+SELECT * FROM polls WHERE post_id = ANY(sqlc.arg(post_ids)::VARCHAR[]);
+
+-- name: GetPollOptionsByPostIDs :many
+SELECT o.post_id, o.position, o.text,
+(SELECT COUNT(*) FROM poll_votes v WHERE v.post_id = o.post_id AND o.position = ANY(v.options))::INTEGER AS votes
+FROM poll_options o
+WHERE o.post_id = ANY(sqlc.arg(post_ids)::VARCHAR[])
+ORDER BY o.post_id, o.position;
+
+-- name: CountPollVotersByPostIDs :many
+SELECT post_id, COUNT(*)::INTEGER AS voters FROM poll_votes
+WHERE post_id = ANY(sqlc.arg(post_ids)::VARCHAR[])
+GROUP BY post_id;
+
+-- name: GetUserPollVotes :many
+SELECT post_id, options FROM poll_votes
+WHERE user_id = sqlc.arg(user_id)::VARCHAR(100) AND post_id = ANY(sqlc.arg(post_ids)::VARCHAR[]);
+
+-- name: VotePoll :execrows
+INSERT INTO poll_votes (post_id, user_id, options, created_at)
+SELECT post_id, sqlc.arg(user_id)::VARCHAR(100), sqlc.arg(options)::INTEGER[], sqlc.arg(created_at)::TIMESTAMPTZ
+FROM polls
+WHERE post_id = sqlc.arg(post_id)::VARCHAR(100) AND closes_at > sqlc.arg(created_at)::TIMESTAMPTZ
+ON CONFLICT (post_id, user_id) DO NOTHING;
+
+-- name: ClaimClosedPolls :many
+WITH claimed AS (
+	UPDATE polls
+	SET results_notified_at = sqlc.arg(now)::TIMESTAMPTZ
+	WHERE polls.post_id IN (
+		SELECT pl.post_id FROM polls pl
+		JOIN posts p ON p.id = pl.post_id
+		WHERE pl.closes_at <= sqlc.arg(now)::TIMESTAMPTZ AND pl.results_notified_at IS NULL AND p.status = 'published'
+		ORDER BY pl.closes_at
+		LIMIT sqlc.arg(batch_size)::INTEGER
+		FOR UPDATE OF pl SKIP LOCKED
+	)
+	RETURNING polls.post_id
+)
+SELECT claimed.post_id, p.author FROM claimed
+JOIN posts p ON p.id = claimed.post_id
+WHERE p.deleted_at IS NULL;
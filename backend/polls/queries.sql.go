@@ -0,0 +1,288 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: queries.sql
+
+package polls
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const claimClosedPolls = `-- name: ClaimClosedPolls :many
+WITH claimed AS (
+	UPDATE polls
+	SET results_notified_at = $1::TIMESTAMPTZ
+	WHERE polls.post_id IN (
+		SELECT pl.post_id FROM polls pl
+		JOIN posts p ON p.id = pl.post_id
+		WHERE pl.closes_at <= $1::TIMESTAMPTZ AND pl.results_notified_at IS NULL AND p.status = 'published'
+		ORDER BY pl.closes_at
+		LIMIT $2::INTEGER
+		FOR UPDATE OF pl SKIP LOCKED
+	)
+	RETURNING polls.post_id
+)
+SELECT claimed.post_id, p.author FROM claimed
+JOIN posts p ON p.id = claimed.post_id
+WHERE p.deleted_at IS NULL
+`
+
+type ClaimClosedPollsParams struct {
+	Now       time.Time
+	BatchSize int32
+}
+
+type ClaimClosedPollsRow struct {
+	PostID string
+	Author string
+}
+
+func (q *Queries) ClaimClosedPolls(ctx context.Context, arg ClaimClosedPollsParams) ([]ClaimClosedPollsRow, error) {
+	rows, err := q.db.QueryContext(ctx, claimClosedPolls, arg.Now, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimClosedPollsRow
+	for rows.Next() {
+		var i ClaimClosedPollsRow
+		if err := rows.Scan(&i.PostID, &i.Author); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countPollVotersByPostIDs = `-- name: CountPollVotersByPostIDs :many
+SELECT post_id, COUNT(*)::INTEGER AS voters FROM poll_votes
+WHERE post_id = ANY($1::VARCHAR[])
+GROUP BY post_id
+`
+
+type CountPollVotersByPostIDsRow struct {
+	PostID string
+	Voters int32
+}
+
+func (q *Queries) CountPollVotersByPostIDs(ctx context.Context, postIds []string) ([]CountPollVotersByPostIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, countPollVotersByPostIDs, pq.Array(postIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountPollVotersByPostIDsRow
+	for rows.Next() {
+		var i CountPollVotersByPostIDsRow
+		if err := rows.Scan(&i.PostID, &i.Voters); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createPoll = `-- name: CreatePoll :one
+WITH pl AS (
+	INSERT INTO polls (post_id, multiple_choice, closes_at, created_at)
+	VALUES ($1::VARCHAR(100), $2::BOOLEAN, $3::TIMESTAMPTZ, $4::TIMESTAMPTZ)
+	RETURNING post_id, multiple_choice, closes_at, results_notified_at, created_at
+), opts AS (
+	INSERT INTO poll_options (post_id, position, text)
+	SELECT pl.post_id, generate_subscripts($5::VARCHAR[], 1) - 1, unnest($5::VARCHAR[])
+	FROM pl
+)
+SELECT post_id, multiple_choice, closes_at, results_notified_at, created_at FROM pl
+`
+
+type CreatePollParams struct {
+	PostID         string
+	MultipleChoice bool
+	ClosesAt       time.Time
+	CreatedAt      time.Time
+	Options        []string
+}
+
+type CreatePollRow struct {
+	PostID            string
+	MultipleChoice    bool
+	ClosesAt          time.Time
+	ResultsNotifiedAt sql.NullTime
+	CreatedAt         time.Time
+}
+
+func (q *Queries) CreatePoll(ctx context.Context, arg CreatePollParams) (CreatePollRow, error) {
+	row := q.db.QueryRowContext(ctx, createPoll,
+		arg.PostID,
+		arg.MultipleChoice,
+		arg.ClosesAt,
+		arg.CreatedAt,
+		pq.Array(arg.Options),
+	)
+	var i CreatePollRow
+	err := row.Scan(
+		&i.PostID,
+		&i.MultipleChoice,
+		&i.ClosesAt,
+		&i.ResultsNotifiedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getPollOptionsByPostIDs = `-- name: GetPollOptionsByPostIDs :many
+SELECT o.post_id, o.position, o.text,
+(SELECT COUNT(*) FROM poll_votes v WHERE v.post_id = o.post_id AND o.position = ANY(v.options))::INTEGER AS votes
+FROM poll_options o
+WHERE o.post_id = ANY($1::VARCHAR[])
+ORDER BY o.post_id, o.position
+`
+
+type GetPollOptionsByPostIDsRow struct {
+	PostID   string
+	Position int32
+	Text     string
+	Votes    int32
+}
+
+func (q *Queries) GetPollOptionsByPostIDs(ctx context.Context, postIds []string) ([]GetPollOptionsByPostIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPollOptionsByPostIDs, pq.Array(postIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPollOptionsByPostIDsRow
+	for rows.Next() {
+		var i GetPollOptionsByPostIDsRow
+		if err := rows.Scan(
+			&i.PostID,
+			&i.Position,
+			&i.Text,
+			&i.Votes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPollsByPostIDs = `-- name: GetPollsByPostIDs :many
+SELECT post_id, multiple_choice, closes_at, results_notified_at, created_at FROM polls WHERE post_id = ANY($1::VARCHAR[])
+`
+
+func (q *Queries) GetPollsByPostIDs(ctx context.Context, postIds []string) ([]Poll, error) {
+	rows, err := q.db.QueryContext(ctx, getPollsByPostIDs, pq.Array(postIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Poll
+	for rows.Next() {
+		var i Poll
+		if err := rows.Scan(
+			&i.PostID,
+			&i.MultipleChoice,
+			&i.ClosesAt,
+			&i.ResultsNotifiedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserPollVotes = `-- name: GetUserPollVotes :many
+SELECT post_id, options FROM poll_votes
+WHERE user_id = $1::VARCHAR(100) AND post_id = ANY($2::VARCHAR[])
+`
+
+type GetUserPollVotesParams struct {
+	UserID  string
+	PostIds []string
+}
+
+type GetUserPollVotesRow struct {
+	PostID  string
+	Options []int32
+}
+
+func (q *Queries) GetUserPollVotes(ctx context.Context, arg GetUserPollVotesParams) ([]GetUserPollVotesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getUserPollVotes, arg.UserID, pq.Array(arg.PostIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserPollVotesRow
+	for rows.Next() {
+		var i GetUserPollVotesRow
+		if err := rows.Scan(&i.PostID, pq.Array(&i.Options)); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const votePoll = `-- name: VotePoll :execrows
+INSERT INTO poll_votes (post_id, user_id, options, created_at)
+SELECT post_id, $1::VARCHAR(100), $2::INTEGER[], $3::TIMESTAMPTZ
+FROM polls
+WHERE post_id = $4::VARCHAR(100) AND closes_at > $3::TIMESTAMPTZ
+ON CONFLICT (post_id, user_id) DO NOTHING
+`
+
+type VotePollParams struct {
+	UserID    string
+	Options   []int32
+	CreatedAt time.Time
+	PostID    string
+}
+
+func (q *Queries) VotePoll(ctx context.Context, arg VotePollParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, votePoll,
+		arg.UserID,
+		pq.Array(arg.Options),
+		arg.CreatedAt,
+		arg.PostID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
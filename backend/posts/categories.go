@@ -0,0 +1,90 @@
+package posts
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+)
+
+// Category of the posts taxonomy
+type Category struct {
+	ID   string
+	Name string
+}
+
+// CategoryOther is the category of posts created without one
+const CategoryOther = "other"
+
+// Categories is the posts categories taxonomy
+var Categories = []Category{
+	{ID: "mindfulness", Name: "Mindfulness"},
+	{ID: "art", Name: "Art"},
+	{ID: "sports", Name: "Sports"},
+	{ID: "music", Name: "Music"},
+	{ID: "education", Name: "Education"},
+	{ID: "comedy", Name: "Comedy"},
+	{ID: "news", Name: "News"},
+	{ID: CategoryOther, Name: "Other"},
+}
+
+const (
+	// MaxTags is the maximum number of tags a post can have
+	MaxTags = 10
+	// MaxTagLength is the maximum length of a single tag
+	MaxTagLength = 32
+)
+
+// IsValidCategory returns whether the category id belongs to the taxonomy
+func IsValidCategory(id string) bool {
+	for _, c := range Categories {
+		if c.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// CategoriesToPB converts the categories taxonomy to protobuf
+func CategoriesToPB() []*v1API.PostCategory {
+	res := []*v1API.PostCategory{}
+	for _, c := range Categories {
+		res = append(res, &v1API.PostCategory{Id: c.ID, Name: c.Name})
+	}
+	return res
+}
+
+// NormalizeTag lowercases the tag, removing a leading `#` and surrounding spaces
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+}
+
+// NormalizeTags normalizes and deduplicates the tags, validating them.
+// Tags can contain only letters, digits, `_` and `-`
+func NormalizeTags(tags []string) ([]string, error) {
+	res := []string{}
+	seen := map[string]bool{}
+
+	for _, t := range tags {
+		tag := NormalizeTag(t)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		if len([]rune(tag)) > MaxTagLength {
+			return nil, fmt.Errorf("tag %q exceeds %d characters", tag, MaxTagLength)
+		}
+		for _, r := range tag {
+			if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '-' {
+				return nil, fmt.Errorf("tag %q contains invalid characters", tag)
+			}
+		}
+		seen[tag] = true
+		res = append(res, tag)
+	}
+	if len(res) > MaxTags {
+		return nil, fmt.Errorf("a post can have at most %d tags", MaxTags)
+	}
+
+	return res, nil
+}
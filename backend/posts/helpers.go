@@ -1,10 +1,12 @@
 package posts
 
 import (
+	"database/sql"
 	"fmt"
 
 	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
 	"github.com/DagDigg/unpaper/backend/pkg/dbentities"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func pgPostToPB(p Post) (*v1API.Post, error) {
@@ -12,21 +14,69 @@ func pgPostToPB(p Post) (*v1API.Post, error) {
 	if err != nil {
 		return nil, fmt.Errorf("error converting audio to PB: %v", err)
 	}
+	mentions, err := dbentities.MentionsRawJSONToPB(p.Mentions)
+	if err != nil {
+		return nil, fmt.Errorf("error converting mentions to PB: %v", err)
+	}
+	visibility, err := PGVisibilityToPB(Visibility(p.Visibility))
+	if err != nil {
+		return nil, err
+	}
+	status, err := PGStatusToPB(Status(p.Status))
+	if err != nil {
+		return nil, err
+	}
+
+	post := &v1API.Post{
+		Id:        p.ID,
+		Message:   p.Message,
+		Author:    p.Author,
+		Audio:     audio,
+		Likes:     p.Likes.Int32,
+		Mentions:  mentions,
+		Category:  p.Category,
+		Tags:      p.Tags,
+		CreatedAt: timestamppb.New(p.CreatedAt),
+		Deleted:   p.DeletedAt.Valid,
+		EditedAt:  nullTimeToPB(p.EditedAt),
+
+		Visibility:        visibility,
+		VisibilityListIds: p.VisibilityListIds,
+		Status:            status,
+		PublishAt:         nullTimeToPB(p.PublishAt),
+		PlaysCount:        p.Plays,
+	}
+	if p.SupportersRoomID.Valid {
+		purchaseType, err := PGPurchaseTypeToPB(PurchaseType(p.SupportersPurchaseType.String))
+		if err != nil {
+			return nil, err
+		}
+		post.SupportersOnly = &v1API.SupportersOnly{
+			RoomId:       p.SupportersRoomID.String,
+			PurchaseType: purchaseType,
+		}
+	}
+	if p.QuotedPostID.Valid {
+		// Only the id is known here, the quoted post is resolved along with the other post details
+		post.QuotedPost = &v1API.Post{Id: p.QuotedPostID.String}
+	}
+
+	return post, nil
+}
 
-	return &v1API.Post{
-		Id:      p.ID,
-		Message: p.Message,
-		Author:  p.Author,
-		Audio:   audio,
-		Likes:   p.Likes.Int32,
-	}, nil
+// nullTimeToPB converts a nullable postgres time to protobuf, returning nil when not set
+func nullTimeToPB(t sql.NullTime) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+	return timestamppb.New(t.Time)
 }
 
-func trendingTodayPostsListToPB(posts []GetTrendingTodayPostsRow) ([]*v1API.Post, error) {
+func pgPostsListToPB(posts []Post) ([]*v1API.Post, error) {
 	res := []*v1API.Post{}
 
 	for _, p := range posts {
-		pbPost, err := pgPostToPB(Post(p))
+		pbPost, err := pgPostToPB(p)
 		if err != nil {
 			return nil, err
 		}
@@ -3,10 +3,13 @@ package posts
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"time"
 
 	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
 	"github.com/Masterminds/squirrel"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Directory is the directory which operates on db table 'users'
@@ -30,6 +33,27 @@ func (d *Directory) Close() error {
 
 // CreatePost INSERTs a new post into db
 func (d *Directory) CreatePost(ctx context.Context, args CreatePostParams) (*v1API.Post, error) {
+	if args.Mentions == nil {
+		args.Mentions = json.RawMessage("[]")
+	}
+	if args.Category == "" {
+		args.Category = CategoryOther
+	}
+	if args.Tags == nil {
+		args.Tags = []string{}
+	}
+	if args.Visibility == "" {
+		args.Visibility = string(VisibilityPublic)
+	}
+	if args.VisibilityListIds == nil {
+		args.VisibilityListIds = []string{}
+	}
+	if args.Status == "" {
+		args.Status = string(StatusPublished)
+	}
+	if args.CreatedAt.IsZero() {
+		args.CreatedAt = time.Now().UTC()
+	}
 	res, err := d.querier.CreatePost(ctx, args)
 	if err != nil {
 		return nil, fmt.Errorf("error creating postgres post: %v", err)
@@ -48,9 +72,9 @@ func (d *Directory) GetPost(ctx context.Context, postID string) (*v1API.Post, er
 	return pgPostToPB(res)
 }
 
-// GetPosts returns all the posts
-func (d *Directory) GetPosts(ctx context.Context) ([]*v1API.Post, error) {
-	res, err := d.querier.GetPosts(ctx)
+// GetPosts returns a page of posts ordered from the newest, optionally filtered by category and tag
+func (d *Directory) GetPosts(ctx context.Context, params GetPostsParams) ([]*v1API.Post, error) {
+	res, err := d.querier.GetPosts(ctx, params)
 	if err != nil {
 		return nil, err
 	}
@@ -68,17 +92,37 @@ func (d *Directory) GetPosts(ctx context.Context) ([]*v1API.Post, error) {
 	return posts, nil
 }
 
-// LikePost increments the likes of the post and adds the userid who liked to the array of users who liked column
+// GetPostsByHashtag returns a page of the posts whose message, or comments, use the hashtag, from the newest
+func (d *Directory) GetPostsByHashtag(ctx context.Context, params GetPostsByHashtagParams) ([]*v1API.Post, error) {
+	res, err := d.querier.GetPostsByHashtag(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgPostsListToPB(res)
+}
+
+// LikePost records the user like and increments the likes of the post.
+// Liking an already liked post leaves the post unchanged
 func (d *Directory) LikePost(ctx context.Context, params LikePostParams) (*v1API.Post, error) {
+	if params.CreatedAt.IsZero() {
+		params.CreatedAt = time.Now().UTC()
+	}
 	res, err := d.querier.LikePost(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
-	return pgPostToPB(res)
+	post, err := pgPostToPB(res)
+	if err != nil {
+		return nil, err
+	}
+	post.HasAlreadyLiked = true
+
+	return post, nil
 }
 
-// RemoveLikeFromPost decrements the likes of the post and removes the userid who liked from the array of users who liked column
+// RemoveLikeFromPost removes the user like and decrements the likes of the post
 func (d *Directory) RemoveLikeFromPost(ctx context.Context, params RemoveLikeFromPostParams) (*v1API.Post, error) {
 	res, err := d.querier.RemoveLikeFromPost(ctx, params)
 	if err != nil {
@@ -88,7 +132,7 @@ func (d *Directory) RemoveLikeFromPost(ctx context.Context, params RemoveLikeFro
 	return pgPostToPB(res)
 }
 
-// HasUserLikedPost returns whether users_who_liked_post contains the passed user_id
+// HasUserLikedPost returns whether the user has liked the post
 func (d *Directory) HasUserLikedPost(ctx context.Context, params HasUserLikedPostParams) (bool, error) {
 	ok, err := d.querier.HasUserLikedPost(ctx, params)
 	if err != nil {
@@ -98,22 +142,310 @@ func (d *Directory) HasUserLikedPost(ctx context.Context, params HasUserLikedPos
 	return ok, nil
 }
 
-// GetTrendingTodayPosts returns today trending posts
-func (d *Directory) GetTrendingTodayPosts(ctx context.Context) ([]*v1API.Post, error) {
-	res, err := d.querier.GetTrendingTodayPosts(ctx)
+// GetLikedPostIDs returns the set of posts, among postIDs, liked by the user
+func (d *Directory) GetLikedPostIDs(ctx context.Context, userID string, postIDs []string) (map[string]bool, error) {
+	res, err := d.querier.GetLikedPostIDs(ctx, GetLikedPostIDsParams{
+		PostIds: postIDs,
+		UserID:  userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	liked := map[string]bool{}
+	for _, id := range res {
+		liked[id] = true
+	}
+
+	return liked, nil
+}
+
+// GetPostLikers returns a page of the users who liked the post, from the latest like
+func (d *Directory) GetPostLikers(ctx context.Context, params GetPostLikersParams) ([]*v1API.Liker, error) {
+	res, err := d.querier.GetPostLikers(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	likers := []*v1API.Liker{}
+	for _, r := range res {
+		likers = append(likers, &v1API.Liker{
+			UserId:   r.UserID,
+			Username: r.Username.String,
+			LikedAt:  timestamppb.New(r.CreatedAt),
+		})
+	}
+
+	return likers, nil
+}
+
+// RepostPost shares the post on behalf of the user, returning false if it was already reposted
+func (d *Directory) RepostPost(ctx context.Context, postID, userID string) (bool, error) {
+	n, err := d.querier.RepostPost(ctx, RepostPostParams{
+		PostID:    postID,
+		UserID:    userID,
+		CreatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+// UnrepostPost removes the user repost. Removing a missing repost is a no-op
+func (d *Directory) UnrepostPost(ctx context.Context, postID, userID string) error {
+	return d.querier.UnrepostPost(ctx, UnrepostPostParams{
+		PostID: postID,
+		UserID: userID,
+	})
+}
+
+// GetRepostedPostIDs returns the set of posts, among postIDs, reposted by the user
+func (d *Directory) GetRepostedPostIDs(ctx context.Context, userID string, postIDs []string) (map[string]bool, error) {
+	res, err := d.querier.GetRepostedPostIDs(ctx, GetRepostedPostIDsParams{
+		PostIds: postIDs,
+		UserID:  userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reposted := map[string]bool{}
+	for _, id := range res {
+		reposted[id] = true
+	}
+
+	return reposted, nil
+}
+
+// RepostKey identifies a repost of a post by a user
+type RepostKey struct {
+	PostID string
+	UserID string
+}
+
+// GetExistingReposts returns which of the passed reposts still exist
+func (d *Directory) GetExistingReposts(ctx context.Context, keys []RepostKey) (map[RepostKey]bool, error) {
+	existing := map[RepostKey]bool{}
+	if len(keys) == 0 {
+		return existing, nil
+	}
+	params := GetRepostsParams{}
+	for _, k := range keys {
+		params.PostIds = append(params.PostIds, k.PostID)
+		params.UserIds = append(params.UserIds, k.UserID)
+	}
+	res, err := d.querier.GetReposts(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range res {
+		existing[RepostKey{PostID: r.PostID, UserID: r.UserID}] = true
+	}
+
+	return existing, nil
+}
+
+// CountRepostsByPostIDs returns the number of reposts of every post, keyed by post id.
+// Posts without reposts are not present in the map
+func (d *Directory) CountRepostsByPostIDs(ctx context.Context, postIDs []string) (map[string]int32, error) {
+	res, err := d.querier.CountRepostsByPostIDs(ctx, postIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int32{}
+	for _, r := range res {
+		counts[r.PostID] = int32(r.RepostsCount)
+	}
+
+	return counts, nil
+}
+
+// CountQuotesByPostIDs returns the number of quote posts of every post, keyed by post id.
+// Posts without quotes are not present in the map
+func (d *Directory) CountQuotesByPostIDs(ctx context.Context, postIDs []string) (map[string]int32, error) {
+	res, err := d.querier.CountQuotesByPostIDs(ctx, postIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int32{}
+	for _, r := range res {
+		counts[r.PostID] = int32(r.QuotesCount)
+	}
+
+	return counts, nil
+}
+
+// GetQuotedPosts returns the posts with the passed ids visible to the viewer, keyed by id.
+// Deleted posts are returned as well, so that quotes can be marked as unavailable
+func (d *Directory) GetQuotedPosts(ctx context.Context, viewerID string, ids []string) (map[string]*v1API.Post, error) {
+	res, err := d.querier.GetQuotedPosts(ctx, GetQuotedPostsParams{
+		Ids:      ids,
+		ViewerID: viewerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	quoted := map[string]*v1API.Post{}
+	for _, p := range res {
+		post, err := pgPostToPB(p)
+		if err != nil {
+			return nil, err
+		}
+		quoted[p.ID] = post
+	}
+
+	return quoted, nil
+}
+
+// CanViewPost returns whether the post is visible to the viewer.
+// Missing posts are not visible
+func (d *Directory) CanViewPost(ctx context.Context, postID, viewerID string) (bool, error) {
+	return d.querier.CanViewPost(ctx, CanViewPostParams{
+		ID:       postID,
+		ViewerID: viewerID,
+	})
+}
+
+// GetPostsByIDs returns the posts with the passed ids visible to the viewer, in no particular order
+func (d *Directory) GetPostsByIDs(ctx context.Context, viewerID string, ids []string) ([]*v1API.Post, error) {
+	res, err := d.querier.GetPostsByIDs(ctx, GetPostsByIDsParams{
+		Ids:      ids,
+		ViewerID: viewerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	posts := []*v1API.Post{}
+	for _, p := range res {
+		post, err := pgPostToPB(p)
+		if err != nil {
+			return nil, err
+		}
+		posts = append(posts, post)
+	}
+
+	return posts, nil
+}
+
+// GetFollowedPostKeys returns the ids and creation dates of the posts written
+// by the users followed by the follower, from the newest
+func (d *Directory) GetFollowedPostKeys(ctx context.Context, params GetFollowedPostKeysParams) ([]GetFollowedPostKeysRow, error) {
+	return d.querier.GetFollowedPostKeys(ctx, params)
+}
+
+// GetPostKeysByAuthors returns the ids and creation dates of the posts written by the authors, from the newest
+func (d *Directory) GetPostKeysByAuthors(ctx context.Context, params GetPostKeysByAuthorsParams) ([]GetPostKeysByAuthorsRow, error) {
+	return d.querier.GetPostKeysByAuthors(ctx, params)
+}
+
+// UpdatePostMessage updates the post message, recording the previous one in the edit history.
+// Only the author can update a post, and deleted posts cannot be updated
+func (d *Directory) UpdatePostMessage(ctx context.Context, params UpdatePostMessageParams) (*v1API.Post, error) {
+	if params.Mentions == nil {
+		params.Mentions = json.RawMessage("[]")
+	}
+	if params.EditedAt.IsZero() {
+		params.EditedAt = time.Now().UTC()
+	}
+	res, err := d.querier.UpdatePostMessage(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgPostToPB(res)
+}
+
+// SoftDeletePost replaces the post content with a placeholder, recording the previous message in the edit history.
+// The author is the editor, unless a moderator is set. Bookmarks and hashtags of the post are removed along with its content
+func (d *Directory) SoftDeletePost(ctx context.Context, params SoftDeletePostParams) (*v1API.Post, error) {
+	if params.DeletedAt.IsZero() {
+		params.DeletedAt = time.Now().UTC()
+	}
+	// Moderators remove content on behalf of the author
+	if params.EditorID == "" {
+		params.EditorID = params.Author
+	}
+	res, err := d.querier.SoftDeletePost(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgPostToPB(res)
+}
+
+// GetDrafts returns a page of the author drafts, from the newest
+func (d *Directory) GetDrafts(ctx context.Context, params GetDraftsParams) ([]*v1API.Post, error) {
+	res, err := d.querier.GetDrafts(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgPostsListToPB(res)
+}
+
+// GetScheduledPosts returns a page of the author scheduled posts, from the earliest to be published
+func (d *Directory) GetScheduledPosts(ctx context.Context, params GetScheduledPostsParams) ([]*v1API.Post, error) {
+	res, err := d.querier.GetScheduledPosts(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgPostsListToPB(res)
+}
+
+// SchedulePost schedules a draft, or reschedules a scheduled post, to be published at publish_at.
+// Returns sql.ErrNoRows if the post is not an unpublished post of the author
+func (d *Directory) SchedulePost(ctx context.Context, params SchedulePostParams) (*v1API.Post, error) {
+	res, err := d.querier.SchedulePost(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgPostToPB(res)
+}
+
+// UnschedulePost moves a scheduled post back to the author drafts.
+// Returns sql.ErrNoRows if the post is not a scheduled post of the author
+func (d *Directory) UnschedulePost(ctx context.Context, params UnschedulePostParams) (*v1API.Post, error) {
+	res, err := d.querier.UnschedulePost(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgPostToPB(res)
+}
+
+// PublishPost immediately publishes a draft or a scheduled post, dating it to the publication time.
+// Returns sql.ErrNoRows if the post is not an unpublished post of the author
+func (d *Directory) PublishPost(ctx context.Context, params PublishPostParams) (*v1API.Post, error) {
+	if params.PublishedAt.IsZero() {
+		params.PublishedAt = time.Now().UTC()
+	}
+	res, err := d.querier.PublishPost(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
-	return trendingTodayPostsListToPB(res)
+	return pgPostToPB(res)
 }
 
-// GetTrendingTodayPostIDs returns today trending posts ids
-func (d *Directory) GetTrendingTodayPostIDs(ctx context.Context) ([]string, error) {
-	res, err := d.querier.GetTrendingTodayPostIDs(ctx)
+// PublishDuePosts publishes up to batchSize scheduled posts whose publish_at is past, returning them.
+// Rows are claimed with FOR UPDATE SKIP LOCKED, so that concurrent replicas never publish the same post twice
+func (d *Directory) PublishDuePosts(ctx context.Context, batchSize int32) ([]*v1API.Post, error) {
+	res, err := d.querier.PublishDuePosts(ctx, PublishDuePostsParams{
+		PublishedAt: time.Now().UTC(),
+		BatchSize:   batchSize,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return res, nil
+	return pgPostsListToPB(res)
 }
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
 	"github.com/DagDigg/unpaper/backend/pkg/dbentities"
@@ -71,8 +73,8 @@ func TestLikePost(t *testing.T) {
 
 		userWhoLiked := uuid.NewString()
 		likeParams := posts.LikePostParams{
-			ID:      post.Id,
-			Column1: userWhoLiked,
+			ID:     post.Id,
+			UserID: userWhoLiked,
 		}
 		likedPost, err := dir.LikePost(context.Background(), likeParams)
 		assert.Nil(err)
@@ -81,8 +83,8 @@ func TestLikePost(t *testing.T) {
 		assert.Equal(int32(1), likedPost.Likes)
 
 		ok, err := dir.HasUserLikedPost(context.Background(), posts.HasUserLikedPostParams{
-			ID:      likedPost.Id,
-			Column2: userWhoLiked,
+			PostID: likedPost.Id,
+			UserID: userWhoLiked,
 		})
 		assert.Nil(err)
 
@@ -90,8 +92,8 @@ func TestLikePost(t *testing.T) {
 
 		// Remove like
 		dislikeParams := posts.RemoveLikeFromPostParams{
-			ID:      post.Id,
-			Column1: userWhoLiked,
+			ID:     post.Id,
+			UserID: userWhoLiked,
 		}
 		updatedLikedPost, err := dir.RemoveLikeFromPost(context.Background(), dislikeParams)
 		assert.Nil(err)
@@ -102,6 +104,90 @@ func TestLikePost(t *testing.T) {
 
 }
 
+func TestGetPostsFilters(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	dir := getPostsDir(t)
+	rawJSONAudio, err := dbentities.NewAudioRawJSON(&v1API.Audio{Id: "foo"})
+	assert.Nil(err)
+
+	t.Run("When filtering posts by category and tag", func(t *testing.T) {
+		tag := uuid.NewString()
+		params := posts.CreatePostParams{
+			ID:       uuid.NewString(),
+			Author:   uuid.NewString(),
+			Message:  uuid.NewString(),
+			Audio:    rawJSONAudio,
+			Category: "music",
+			Tags:     []string{tag, "jazz"},
+		}
+		post, err := dir.CreatePost(context.Background(), params)
+		assert.Nil(err)
+		assert.Equal("music", post.Category)
+		assert.Equal(params.Tags, post.Tags)
+
+		res, err := dir.GetPosts(context.Background(), posts.GetPostsParams{Category: "music", Tag: tag})
+		assert.Nil(err)
+		assert.Len(res, 1)
+		assert.Equal(post.Id, res[0].Id)
+
+		res, err = dir.GetPosts(context.Background(), posts.GetPostsParams{Category: "art", Tag: tag})
+		assert.Nil(err)
+		assert.Len(res, 0)
+	})
+
+	t.Run("When creating a post without category", func(t *testing.T) {
+		post, err := dir.CreatePost(context.Background(), posts.CreatePostParams{
+			ID:      uuid.NewString(),
+			Author:  uuid.NewString(),
+			Message: uuid.NewString(),
+			Audio:   rawJSONAudio,
+		})
+		assert.Nil(err)
+		assert.Equal(posts.CategoryOther, post.Category)
+		assert.Empty(post.Tags)
+	})
+}
+
+func TestNormalizeTags(t *testing.T) {
+	assert := assert.New(t)
+
+	tags, err := posts.NormalizeTags([]string{" #Jazz", "jazz", "lo-fi", "", "study_music"})
+	assert.Nil(err)
+	assert.Equal([]string{"jazz", "lo-fi", "study_music"}, tags)
+
+	_, err = posts.NormalizeTags([]string{"no spaces"})
+	assert.NotNil(err)
+
+	tooMany := []string{}
+	for i := 0; i <= posts.MaxTags; i++ {
+		tooMany = append(tooMany, fmt.Sprintf("tag%d", i))
+	}
+	_, err = posts.NormalizeTags(tooMany)
+	assert.NotNil(err)
+}
+
+func TestLockPost(t *testing.T) {
+	assert := assert.New(t)
+
+	message := "Episode 1 of a long story told slowly\nthe second line"
+	p := &v1API.Post{
+		Message:  message,
+		Mentions: []*v1API.Mention{{UserId: uuid.NewString()}},
+		Audio:    &v1API.Audio{Id: "foo", Bytes: []byte("bar"), DurationMs: 1000},
+	}
+	posts.LockPost(p, v1API.PostLockReason_SUBSCRIPTION_REQUIRED)
+
+	assert.True(p.Locked)
+	assert.Equal(v1API.PostLockReason_SUBSCRIPTION_REQUIRED, p.LockReason)
+	assert.True(strings.HasPrefix(message, p.Message))
+	assert.Less(len(p.Message), len("Episode 1 of a long story told slowly"))
+	assert.LessOrEqual(utf8.RuneCountInString(p.Message), posts.PreviewLength)
+	assert.Empty(p.Mentions)
+	assert.Empty(p.Audio.Bytes)
+	assert.Equal(int32(1000), p.Audio.DurationMs)
+}
+
 // getPostsDir returns the *posts.Directory
 func getPostsDir(t *testing.T) *posts.Directory {
 	ws := v1Testing.GetWrappedServer(t)
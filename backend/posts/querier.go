@@ -4,17 +4,44 @@ package posts
 
 import (
 	"context"
+	"time"
 )
 
 type Querier interface {
+	CanViewPost(ctx context.Context, arg CanViewPostParams) (bool, error)
+	CountQuotesByPostIDs(ctx context.Context, postIds []string) ([]CountQuotesByPostIDsRow, error)
+	CountRepostsByPostIDs(ctx context.Context, postIds []string) ([]CountRepostsByPostIDsRow, error)
 	CreatePost(ctx context.Context, arg CreatePostParams) (Post, error)
+	DeleteTrendingGenerations(ctx context.Context, before time.Time) error
+	GetDrafts(ctx context.Context, arg GetDraftsParams) ([]Post, error)
+	GetFollowedPostKeys(ctx context.Context, arg GetFollowedPostKeysParams) ([]GetFollowedPostKeysRow, error)
+	GetLatestTrendingGeneration(ctx context.Context) (time.Time, error)
+	GetLikedPostIDs(ctx context.Context, arg GetLikedPostIDsParams) ([]string, error)
 	GetPost(ctx context.Context, id string) (Post, error)
-	GetPosts(ctx context.Context) ([]Post, error)
-	GetTrendingTodayPostIDs(ctx context.Context) ([]string, error)
-	GetTrendingTodayPosts(ctx context.Context) ([]GetTrendingTodayPostsRow, error)
+	GetPostKeysByAuthors(ctx context.Context, arg GetPostKeysByAuthorsParams) ([]GetPostKeysByAuthorsRow, error)
+	GetPostLikers(ctx context.Context, arg GetPostLikersParams) ([]GetPostLikersRow, error)
+	GetPosts(ctx context.Context, arg GetPostsParams) ([]Post, error)
+	GetPostsByHashtag(ctx context.Context, arg GetPostsByHashtagParams) ([]Post, error)
+	GetPostsByIDs(ctx context.Context, arg GetPostsByIDsParams) ([]Post, error)
+	GetQuotedPosts(ctx context.Context, arg GetQuotedPostsParams) ([]Post, error)
+	GetRepostedPostIDs(ctx context.Context, arg GetRepostedPostIDsParams) ([]string, error)
+	GetReposts(ctx context.Context, arg GetRepostsParams) ([]GetRepostsRow, error)
+	GetScheduledPosts(ctx context.Context, arg GetScheduledPostsParams) ([]Post, error)
+	GetTrendingPostIDs(ctx context.Context, arg GetTrendingPostIDsParams) ([]GetTrendingPostIDsRow, error)
+	GetTrendingPostIDsByHashtag(ctx context.Context, arg GetTrendingPostIDsByHashtagParams) ([]string, error)
+	HasTrendingGeneration(ctx context.Context, generation time.Time) (bool, error)
 	HasUserLikedPost(ctx context.Context, arg HasUserLikedPostParams) (bool, error)
 	LikePost(ctx context.Context, arg LikePostParams) (Post, error)
+	PublishDuePosts(ctx context.Context, arg PublishDuePostsParams) ([]Post, error)
+	PublishPost(ctx context.Context, arg PublishPostParams) (Post, error)
+	RefreshTrendingPosts(ctx context.Context, arg RefreshTrendingPostsParams) (int64, error)
 	RemoveLikeFromPost(ctx context.Context, arg RemoveLikeFromPostParams) (Post, error)
+	RepostPost(ctx context.Context, arg RepostPostParams) (int64, error)
+	SchedulePost(ctx context.Context, arg SchedulePostParams) (Post, error)
+	SoftDeletePost(ctx context.Context, arg SoftDeletePostParams) (Post, error)
+	UnrepostPost(ctx context.Context, arg UnrepostPostParams) error
+	UnschedulePost(ctx context.Context, arg UnschedulePostParams) (Post, error)
+	UpdatePostMessage(ctx context.Context, arg UpdatePostMessageParams) (Post, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -1,52 +1,274 @@
 -- name: CreatePost :one
-INSERT INTO posts (id, author, message, audio, created_at)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO posts (id, author, message, audio, created_at, mentions, category, tags, quoted_post_id, visibility, visibility_list_ids, status, publish_at, supporters_room_id, supporters_purchase_type)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 RETURNING *;
 
 -- name: GetPost :one
 SELECT * from posts
 WHERE id = $1;
 
+-- name: CanViewPost :one
+SELECT EXISTS(
+	SELECT 1 FROM posts p
+	WHERE p.id = sqlc.arg(id)::VARCHAR(100) AND
+	(p.author = sqlc.arg(viewer_id)::VARCHAR(100) OR can_view_post(sqlc.arg(viewer_id)::VARCHAR(100), p))
+);
+
 -- name: GetPosts :many
-SELECT * from posts; -- TODO: pagination
+SELECT p.* from posts p
+WHERE p.deleted_at IS NULL AND
+(sqlc.arg(category)::VARCHAR(100) = '' OR p.category = sqlc.arg(category)::VARCHAR(100)) AND
+(sqlc.arg(tag)::VARCHAR(100) = '' OR p.tags @> ARRAY[sqlc.arg(tag)::VARCHAR(100)]) AND
+(NOT sqlc.arg(has_cursor)::BOOLEAN OR (p.created_at, p.id) < (sqlc.arg(cursor_created_at)::TIMESTAMPTZ, sqlc.arg(cursor_id)::VARCHAR(100))) AND
+can_view_post(sqlc.arg(viewer_id)::VARCHAR(100), p)
+ORDER BY p.created_at DESC, p.id DESC
+LIMIT sqlc.arg(page_size)::INTEGER;
+
+-- name: GetPostsByHashtag :many
+SELECT p.* from posts p
+WHERE p.deleted_at IS NULL AND
+EXISTS (SELECT 1 FROM hashtag_uses u WHERE u.post_id = p.id AND u.hashtag = sqlc.arg(hashtag)::VARCHAR(100)) AND
+(NOT sqlc.arg(has_cursor)::BOOLEAN OR (p.created_at, p.id) < (sqlc.arg(cursor_created_at)::TIMESTAMPTZ, sqlc.arg(cursor_id)::VARCHAR(100))) AND
+can_view_post(sqlc.arg(viewer_id)::VARCHAR(100), p)
+ORDER BY p.created_at DESC, p.id DESC
+LIMIT sqlc.arg(page_size)::INTEGER;
+
+-- name: GetPostsByIDs :many
+SELECT p.* FROM posts p
+WHERE p.id = ANY(sqlc.arg(ids)::VARCHAR(100)[]) AND p.deleted_at IS NULL AND
+can_view_post(sqlc.arg(viewer_id)::VARCHAR(100), p);
+
+-- name: GetFollowedPostKeys :many
+WITH k AS (
+	SELECT p.id AS post_id, ''::VARCHAR(100) AS reposted_by, p.created_at, p.id::TEXT AS key FROM posts p
+	JOIN follows f ON f.following_user_id = p.author
+	WHERE f.follower_user_id = sqlc.arg(viewer_id)::VARCHAR(100) AND p.deleted_at IS NULL AND
+	(f.follow_date > f.unfollow_date OR f.unfollow_date IS NULL) AND
+	can_view_post(sqlc.arg(viewer_id)::VARCHAR(100), p)
+	UNION ALL
+	SELECT r.post_id, r.user_id, r.created_at, (r.post_id || ':' || r.user_id)::TEXT FROM reposts r
+	JOIN posts p ON p.id = r.post_id
+	JOIN follows f ON f.following_user_id = r.user_id
+	WHERE f.follower_user_id = sqlc.arg(viewer_id)::VARCHAR(100) AND p.deleted_at IS NULL AND
+	(f.follow_date > f.unfollow_date OR f.unfollow_date IS NULL) AND
+	can_view_post(sqlc.arg(viewer_id)::VARCHAR(100), p)
+)
+SELECT post_id, reposted_by, created_at FROM k
+WHERE NOT sqlc.arg(has_cursor)::BOOLEAN OR (created_at, key COLLATE "C") < (sqlc.arg(cursor_created_at)::TIMESTAMPTZ, sqlc.arg(cursor_id)::TEXT COLLATE "C")
+ORDER BY created_at DESC, key COLLATE "C" DESC
+LIMIT sqlc.arg(page_size)::INTEGER;
+
+-- name: GetPostKeysByAuthors :many
+WITH k AS (
+	SELECT p.id AS post_id, ''::VARCHAR(100) AS reposted_by, p.created_at, p.id::TEXT AS key FROM posts p
+	WHERE p.author = ANY(sqlc.arg(author_ids)::VARCHAR(100)[]) AND p.deleted_at IS NULL AND
+	can_view_post(sqlc.arg(viewer_id)::VARCHAR(100), p)
+	UNION ALL
+	SELECT r.post_id, r.user_id, r.created_at, (r.post_id || ':' || r.user_id)::TEXT FROM reposts r
+	JOIN posts p ON p.id = r.post_id
+	WHERE r.user_id = ANY(sqlc.arg(author_ids)::VARCHAR(100)[]) AND p.deleted_at IS NULL AND
+	can_view_post(sqlc.arg(viewer_id)::VARCHAR(100), p)
+)
+SELECT post_id, reposted_by, created_at FROM k
+WHERE NOT sqlc.arg(has_cursor)::BOOLEAN OR (created_at, key COLLATE "C") < (sqlc.arg(cursor_created_at)::TIMESTAMPTZ, sqlc.arg(cursor_id)::TEXT COLLATE "C")
+ORDER BY created_at DESC, key COLLATE "C" DESC
+LIMIT sqlc.arg(page_size)::INTEGER;
 
 -- name: LikePost :one
+WITH l AS (
+	INSERT INTO post_likes (post_id, user_id, created_at)
+	VALUES (sqlc.arg(id)::VARCHAR(100), sqlc.arg(user_id)::VARCHAR(100), sqlc.arg(created_at)::TIMESTAMPTZ)
+	ON CONFLICT (post_id, user_id) DO NOTHING
+	RETURNING post_id
+)
 UPDATE posts
-SET
-likes = likes + 1,
-user_ids_who_likes = array_append(user_ids_who_likes,$1::VARCHAR(100))
-WHERE id = $2
+SET likes = COALESCE(likes, 0) + (SELECT COUNT(*) FROM l)
+WHERE id = sqlc.arg(id)::VARCHAR(100)
 RETURNING *;
 
 -- name: RemoveLikeFromPost :one
+WITH l AS (
+	DELETE FROM post_likes
+	WHERE post_id = sqlc.arg(id)::VARCHAR(100) AND user_id = sqlc.arg(user_id)::VARCHAR(100)
+	RETURNING post_id
+)
 UPDATE posts
-SET
-likes = likes - 1,
-user_ids_who_likes = array_remove(user_ids_who_likes,$1::VARCHAR(100))
-WHERE id = $2
+SET likes = COALESCE(likes, 0) - (SELECT COUNT(*) FROM l)
+WHERE id = sqlc.arg(id)::VARCHAR(100)
 RETURNING *;
 
 -- name: HasUserLikedPost :one
-SELECT EXISTS(SELECT 1 FROM posts WHERE id=$1 AND $2::VARCHAR(100)=ANY(user_ids_who_likes::VARCHAR(100)[]));
-
--- name: GetTrendingTodayPosts :many
-WITH p AS (
-	SELECT * FROM posts
-	WHERE created_at > current_timestamp - interval '1 day'
-	ORDER BY likes DESC
-	LIMIT 30
+SELECT EXISTS(SELECT 1 FROM post_likes WHERE post_id = sqlc.arg(post_id)::VARCHAR(100) AND user_id = sqlc.arg(user_id)::VARCHAR(100));
+
+-- name: GetLikedPostIDs :many
+SELECT post_id FROM post_likes
+WHERE post_id = ANY(sqlc.arg(post_ids)::VARCHAR(100)[]) AND user_id = sqlc.arg(user_id)::VARCHAR(100);
+
+-- name: GetPostLikers :many
+SELECT l.user_id, u.username, l.created_at FROM post_likes l
+JOIN users u ON u.id = l.user_id
+WHERE l.post_id = sqlc.arg(post_id)::VARCHAR(100)
+AND (NOT sqlc.arg(has_cursor)::BOOLEAN OR (l.created_at, l.user_id) < (sqlc.arg(cursor_created_at)::TIMESTAMPTZ, sqlc.arg(cursor_id)::VARCHAR(100)))
+ORDER BY l.created_at DESC, l.user_id DESC
+LIMIT sqlc.arg(page_size)::INTEGER;
+
+-- name: RepostPost :execrows
+INSERT INTO reposts (post_id, user_id, created_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (post_id, user_id) DO NOTHING;
+
+-- name: UnrepostPost :exec
+DELETE FROM reposts
+WHERE post_id = $1 AND user_id = $2;
+
+-- name: GetRepostedPostIDs :many
+SELECT post_id FROM reposts
+WHERE post_id = ANY(sqlc.arg(post_ids)::VARCHAR(100)[]) AND user_id = sqlc.arg(user_id)::VARCHAR(100);
+
+-- name: GetReposts :many
+SELECT post_id, user_id FROM reposts
+WHERE post_id = ANY(sqlc.arg(post_ids)::VARCHAR(100)[]) AND user_id = ANY(sqlc.arg(user_ids)::VARCHAR(100)[]);
+
+-- name: CountRepostsByPostIDs :many
+SELECT post_id, COUNT(*) AS reposts_count FROM reposts
+WHERE post_id = ANY(sqlc.arg(post_ids)::VARCHAR(100)[])
+GROUP BY post_id;
+
+-- name: CountQuotesByPostIDs :many
+SELECT quoted_post_id::VARCHAR(100) AS post_id, COUNT(*) AS quotes_count FROM posts
+WHERE quoted_post_id = ANY(sqlc.arg(post_ids)::VARCHAR(100)[]) AND deleted_at IS NULL AND status = 'published'
+GROUP BY quoted_post_id;
+
+-- name: GetQuotedPosts :many
+SELECT p.* FROM posts p
+WHERE p.id = ANY(sqlc.arg(ids)::VARCHAR(100)[]) AND
+can_view_post(sqlc.arg(viewer_id)::VARCHAR(100), p);
+
+-- name: RefreshTrendingPosts :execrows
+INSERT INTO trending_posts (generation, post_id, category, score)
+SELECT sqlc.arg(generation)::TIMESTAMPTZ, p.id, p.category,
+(COALESCE(p.likes, 0) + 2 * (SELECT COUNT(*) FROM comments c WHERE c.post_id = p.id AND c.deleted_at IS NULL) + 0.2 * p.plays)::FLOAT8 /
+POWER(GREATEST(EXTRACT(EPOCH FROM sqlc.arg(generation)::TIMESTAMPTZ - p.created_at), 0) / 3600 + 2, sqlc.arg(gravity)::FLOAT8)
+FROM posts p
+WHERE p.created_at > sqlc.arg(generation)::TIMESTAMPTZ - sqlc.arg(window_seconds)::FLOAT8 * interval '1 second' AND
+p.deleted_at IS NULL AND p.status = 'published' AND p.visibility = 'public' AND
+(COALESCE(p.likes, 0) > 0 OR p.plays > 0 OR EXISTS (SELECT 1 FROM comments c WHERE c.post_id = p.id AND c.deleted_at IS NULL)) AND
+NOT EXISTS (SELECT 1 FROM trending_posts t WHERE t.generation > sqlc.arg(generation)::TIMESTAMPTZ - sqlc.arg(interval_seconds)::FLOAT8 * interval '1 second');
+
+-- name: DeleteTrendingGenerations :exec
+DELETE FROM trending_posts
+WHERE generation < sqlc.arg(before)::TIMESTAMPTZ AND generation < (SELECT MAX(t.generation) FROM trending_posts t);
+
+-- name: GetLatestTrendingGeneration :one
+SELECT generation FROM trending_posts
+ORDER BY generation DESC
+LIMIT 1;
+
+-- name: HasTrendingGeneration :one
+SELECT EXISTS(SELECT 1 FROM trending_posts WHERE generation = sqlc.arg(generation)::TIMESTAMPTZ);
+
+-- name: GetTrendingPostIDs :many
+SELECT post_id, score FROM trending_posts
+WHERE generation = sqlc.arg(generation)::TIMESTAMPTZ AND
+(sqlc.arg(category)::VARCHAR(100) = '' OR category = sqlc.arg(category)::VARCHAR(100)) AND
+(NOT sqlc.arg(has_cursor)::BOOLEAN OR (score, post_id) < (sqlc.arg(cursor_score)::FLOAT8, sqlc.arg(cursor_id)::VARCHAR(100)))
+ORDER BY score DESC, post_id DESC
+LIMIT sqlc.arg(page_size)::INTEGER;
+
+-- name: GetTrendingPostIDsByHashtag :many
+SELECT t.post_id FROM trending_posts t
+WHERE t.generation = (SELECT MAX(g.generation) FROM trending_posts g) AND
+EXISTS (SELECT 1 FROM hashtag_uses u WHERE u.post_id = t.post_id AND u.hashtag = sqlc.arg(hashtag)::VARCHAR(100))
+ORDER BY t.score DESC, t.post_id DESC
+LIMIT sqlc.arg(page_size)::INTEGER;
+
+-- name: UpdatePostMessage :one
+WITH e AS (
+	INSERT INTO edits (id, target_type, target_id, editor_id, action, previous_message, created_at)
+	SELECT sqlc.arg(edit_id)::VARCHAR(100), 'post', p.id, p.author, 'edit', p.message, sqlc.arg(edited_at)::TIMESTAMPTZ
+	FROM posts p
+	WHERE p.id = sqlc.arg(id)::VARCHAR(100) AND p.author = sqlc.arg(author)::VARCHAR(100) AND p.deleted_at IS NULL
+)
+UPDATE posts
+SET
+message = sqlc.arg(message)::TEXT,
+mentions = sqlc.arg(mentions)::JSON,
+edited_at = sqlc.arg(edited_at)::TIMESTAMPTZ
+WHERE id = sqlc.arg(id)::VARCHAR(100) AND author = sqlc.arg(author)::VARCHAR(100) AND deleted_at IS NULL
+RETURNING *;
+
+-- name: SoftDeletePost :one
+WITH e AS (
+	INSERT INTO edits (id, target_type, target_id, editor_id, action, previous_message, created_at)
+	SELECT sqlc.arg(edit_id)::VARCHAR(100), 'post', p.id, sqlc.arg(editor_id)::VARCHAR(100), 'delete', p.message, sqlc.arg(deleted_at)::TIMESTAMPTZ
+	FROM posts p
+	WHERE p.id = sqlc.arg(id)::VARCHAR(100) AND p.author = sqlc.arg(author)::VARCHAR(100) AND p.deleted_at IS NULL
+	RETURNING target_id
+), b AS (
+	DELETE FROM bookmarks
+	WHERE post_id IN (SELECT target_id FROM e)
+), h AS (
+	DELETE FROM hashtag_uses
+	WHERE post_id IN (SELECT target_id FROM e)
 )
-SELECT * FROM p 
-ORDER BY RANDOM()
-LIMIT 10;
+UPDATE posts
+SET
+message = '[deleted]',
+audio = '{}',
+mentions = '[]',
+tags = '{}',
+deleted_at = sqlc.arg(deleted_at)::TIMESTAMPTZ
+WHERE id = sqlc.arg(id)::VARCHAR(100) AND author = sqlc.arg(author)::VARCHAR(100) AND deleted_at IS NULL
+RETURNING *;
+
+-- name: GetDrafts :many
+SELECT * FROM posts
+WHERE author = sqlc.arg(author)::VARCHAR(100) AND status = 'draft' AND deleted_at IS NULL AND
+(NOT sqlc.arg(has_cursor)::BOOLEAN OR (created_at, id) < (sqlc.arg(cursor_created_at)::TIMESTAMPTZ, sqlc.arg(cursor_id)::VARCHAR(100)))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(page_size)::INTEGER;
 
--- name: GetTrendingTodayPostIDs :many
-WITH p AS (
+-- name: GetScheduledPosts :many
+SELECT * FROM posts
+WHERE author = sqlc.arg(author)::VARCHAR(100) AND status = 'scheduled' AND deleted_at IS NULL AND
+(NOT sqlc.arg(has_cursor)::BOOLEAN OR (publish_at, id) > (sqlc.arg(cursor_publish_at)::TIMESTAMPTZ, sqlc.arg(cursor_id)::VARCHAR(100)))
+ORDER BY publish_at ASC, id ASC
+LIMIT sqlc.arg(page_size)::INTEGER;
+
+-- name: SchedulePost :one
+UPDATE posts
+SET status = 'scheduled', publish_at = sqlc.arg(publish_at)::TIMESTAMPTZ
+WHERE id = sqlc.arg(id)::VARCHAR(100) AND author = sqlc.arg(author)::VARCHAR(100) AND
+status IN ('draft', 'scheduled') AND deleted_at IS NULL
+RETURNING *;
+
+-- name: UnschedulePost :one
+UPDATE posts
+SET status = 'draft', publish_at = NULL
+WHERE id = sqlc.arg(id)::VARCHAR(100) AND author = sqlc.arg(author)::VARCHAR(100) AND
+status = 'scheduled' AND deleted_at IS NULL
+RETURNING *;
+
+-- name: PublishPost :one
+UPDATE posts
+SET status = 'published', created_at = sqlc.arg(published_at)::TIMESTAMPTZ
+WHERE id = sqlc.arg(id)::VARCHAR(100) AND author = sqlc.arg(author)::VARCHAR(100) AND
+status IN ('draft', 'scheduled') AND deleted_at IS NULL
+RETURNING *;
+
+-- name: PublishDuePosts :many
+UPDATE posts
+SET status = 'published', created_at = sqlc.arg(published_at)::TIMESTAMPTZ
+WHERE id IN (
 	SELECT id FROM posts
-	WHERE created_at > current_timestamp - interval '1 day'
-	ORDER BY likes DESC
-	LIMIT 30
+	WHERE status = 'scheduled' AND publish_at <= sqlc.arg(published_at)::TIMESTAMPTZ AND deleted_at IS NULL AND
+	-- Posts of suspended authors are held until the suspension ends
+	NOT EXISTS (
+		SELECT 1 FROM users u
+		WHERE u.id = posts.author AND u.suspended_until > sqlc.arg(published_at)::TIMESTAMPTZ
+	)
+	ORDER BY publish_at
+	LIMIT sqlc.arg(batch_size)::INTEGER
+	FOR UPDATE SKIP LOCKED
 )
-SELECT * FROM p 
-ORDER BY RANDOM()
-LIMIT 10;
\ No newline at end of file
+RETURNING *;
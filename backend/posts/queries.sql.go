@@ -7,22 +7,121 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"time"
 
 	"github.com/lib/pq"
 )
 
+const canViewPost = `-- name: CanViewPost :one
+SELECT EXISTS(
+	SELECT 1 FROM posts p
+	WHERE p.id = $1::VARCHAR(100) AND
+	(p.author = $2::VARCHAR(100) OR can_view_post($2::VARCHAR(100), p))
+)
+`
+
+type CanViewPostParams struct {
+	ID       string
+	ViewerID string
+}
+
+func (q *Queries) CanViewPost(ctx context.Context, arg CanViewPostParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, canViewPost, arg.ID, arg.ViewerID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const countQuotesByPostIDs = `-- name: CountQuotesByPostIDs :many
+SELECT quoted_post_id::VARCHAR(100) AS post_id, COUNT(*) AS quotes_count FROM posts
+WHERE quoted_post_id = ANY($1::VARCHAR(100)[]) AND deleted_at IS NULL AND status = 'published'
+GROUP BY quoted_post_id
+`
+
+type CountQuotesByPostIDsRow struct {
+	PostID      string
+	QuotesCount int64
+}
+
+func (q *Queries) CountQuotesByPostIDs(ctx context.Context, postIds []string) ([]CountQuotesByPostIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, countQuotesByPostIDs, pq.Array(postIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountQuotesByPostIDsRow
+	for rows.Next() {
+		var i CountQuotesByPostIDsRow
+		if err := rows.Scan(&i.PostID, &i.QuotesCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countRepostsByPostIDs = `-- name: CountRepostsByPostIDs :many
+SELECT post_id, COUNT(*) AS reposts_count FROM reposts
+WHERE post_id = ANY($1::VARCHAR(100)[])
+GROUP BY post_id
+`
+
+type CountRepostsByPostIDsRow struct {
+	PostID       string
+	RepostsCount int64
+}
+
+func (q *Queries) CountRepostsByPostIDs(ctx context.Context, postIds []string) ([]CountRepostsByPostIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, countRepostsByPostIDs, pq.Array(postIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountRepostsByPostIDsRow
+	for rows.Next() {
+		var i CountRepostsByPostIDsRow
+		if err := rows.Scan(&i.PostID, &i.RepostsCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createPost = `-- name: CreatePost :one
-INSERT INTO posts (id, author, message, audio, created_at)
-VALUES ($1, $2, $3, $4, $5)
-RETURNING likes, audio, id, author, message, user_ids_who_likes, created_at
+INSERT INTO posts (id, author, message, audio, created_at, mentions, category, tags, quoted_post_id, visibility, visibility_list_ids, status, publish_at, supporters_room_id, supporters_purchase_type)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+RETURNING likes, audio, id, author, message, user_ids_who_likes, created_at, mentions, category, tags, edited_at, deleted_at, quoted_post_id, visibility, visibility_list_ids, status, publish_at, plays, supporters_room_id, supporters_purchase_type
 `
 
 type CreatePostParams struct {
-	ID        string
-	Author    string
-	Message   string
-	Audio     json.RawMessage
-	CreatedAt sql.NullTime
+	ID                     string
+	Author                 string
+	Message                string
+	Audio                  json.RawMessage
+	CreatedAt              time.Time
+	Mentions               json.RawMessage
+	Category               string
+	Tags                   []string
+	QuotedPostID           sql.NullString
+	Visibility             string
+	VisibilityListIds      []string
+	Status                 string
+	PublishAt              sql.NullTime
+	SupportersRoomID       sql.NullString
+	SupportersPurchaseType sql.NullString
 }
 
 func (q *Queries) CreatePost(ctx context.Context, arg CreatePostParams) (Post, error) {
@@ -32,6 +131,16 @@ func (q *Queries) CreatePost(ctx context.Context, arg CreatePostParams) (Post, e
 		arg.Message,
 		arg.Audio,
 		arg.CreatedAt,
+		arg.Mentions,
+		arg.Category,
+		pq.Array(arg.Tags),
+		arg.QuotedPostID,
+		arg.Visibility,
+		pq.Array(arg.VisibilityListIds),
+		arg.Status,
+		arg.PublishAt,
+		arg.SupportersRoomID,
+		arg.SupportersPurchaseType,
 	)
 	var i Post
 	err := row.Scan(
@@ -42,12 +151,211 @@ func (q *Queries) CreatePost(ctx context.Context, arg CreatePostParams) (Post, e
 		&i.Message,
 		pq.Array(&i.UserIdsWhoLikes),
 		&i.CreatedAt,
+		&i.Mentions,
+		&i.Category,
+		pq.Array(&i.Tags),
+		&i.EditedAt,
+		&i.DeletedAt,
+		&i.QuotedPostID,
+		&i.Visibility,
+		pq.Array(&i.VisibilityListIds),
+		&i.Status,
+		&i.PublishAt,
+		&i.Plays,
+		&i.SupportersRoomID,
+		&i.SupportersPurchaseType,
 	)
 	return i, err
 }
 
+const deleteTrendingGenerations = `-- name: DeleteTrendingGenerations :exec
+DELETE FROM trending_posts
+WHERE generation < $1::TIMESTAMPTZ AND generation < (SELECT MAX(t.generation) FROM trending_posts t)
+`
+
+func (q *Queries) DeleteTrendingGenerations(ctx context.Context, before time.Time) error {
+	_, err := q.db.ExecContext(ctx, deleteTrendingGenerations, before)
+	return err
+}
+
+const getDrafts = `-- name: GetDrafts :many
+SELECT likes, audio, id, author, message, user_ids_who_likes, created_at, mentions, category, tags, edited_at, deleted_at, quoted_post_id, visibility, visibility_list_ids, status, publish_at, plays, supporters_room_id, supporters_purchase_type FROM posts
+WHERE author = $1::VARCHAR(100) AND status = 'draft' AND deleted_at IS NULL AND
+(NOT $2::BOOLEAN OR (created_at, id) < ($3::TIMESTAMPTZ, $4::VARCHAR(100)))
+ORDER BY created_at DESC, id DESC
+LIMIT $5::INTEGER
+`
+
+type GetDraftsParams struct {
+	Author          string
+	HasCursor       bool
+	CursorCreatedAt time.Time
+	CursorID        string
+	PageSize        int32
+}
+
+func (q *Queries) GetDrafts(ctx context.Context, arg GetDraftsParams) ([]Post, error) {
+	rows, err := q.db.QueryContext(ctx, getDrafts,
+		arg.Author,
+		arg.HasCursor,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Post
+	for rows.Next() {
+		var i Post
+		if err := rows.Scan(
+			&i.Likes,
+			&i.Audio,
+			&i.ID,
+			&i.Author,
+			&i.Message,
+			pq.Array(&i.UserIdsWhoLikes),
+			&i.CreatedAt,
+			&i.Mentions,
+			&i.Category,
+			pq.Array(&i.Tags),
+			&i.EditedAt,
+			&i.DeletedAt,
+			&i.QuotedPostID,
+			&i.Visibility,
+			pq.Array(&i.VisibilityListIds),
+			&i.Status,
+			&i.PublishAt,
+			&i.Plays,
+			&i.SupportersRoomID,
+			&i.SupportersPurchaseType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFollowedPostKeys = `-- name: GetFollowedPostKeys :many
+WITH k AS (
+	SELECT p.id AS post_id, ''::VARCHAR(100) AS reposted_by, p.created_at, p.id::TEXT AS key FROM posts p
+	JOIN follows f ON f.following_user_id = p.author
+	WHERE f.follower_user_id = $5::VARCHAR(100) AND p.deleted_at IS NULL AND
+	(f.follow_date > f.unfollow_date OR f.unfollow_date IS NULL) AND
+	can_view_post($5::VARCHAR(100), p)
+	UNION ALL
+	SELECT r.post_id, r.user_id, r.created_at, (r.post_id || ':' || r.user_id)::TEXT FROM reposts r
+	JOIN posts p ON p.id = r.post_id
+	JOIN follows f ON f.following_user_id = r.user_id
+	WHERE f.follower_user_id = $5::VARCHAR(100) AND p.deleted_at IS NULL AND
+	(f.follow_date > f.unfollow_date OR f.unfollow_date IS NULL) AND
+	can_view_post($5::VARCHAR(100), p)
+)
+SELECT post_id, reposted_by, created_at FROM k
+WHERE NOT $1::BOOLEAN OR (created_at, key COLLATE "C") < ($2::TIMESTAMPTZ, $3::TEXT COLLATE "C")
+ORDER BY created_at DESC, key COLLATE "C" DESC
+LIMIT $4::INTEGER
+`
+
+type GetFollowedPostKeysParams struct {
+	HasCursor       bool
+	CursorCreatedAt time.Time
+	CursorID        string
+	PageSize        int32
+	ViewerID        string
+}
+
+type GetFollowedPostKeysRow struct {
+	PostID     string
+	RepostedBy string
+	CreatedAt  time.Time
+}
+
+func (q *Queries) GetFollowedPostKeys(ctx context.Context, arg GetFollowedPostKeysParams) ([]GetFollowedPostKeysRow, error) {
+	rows, err := q.db.QueryContext(ctx, getFollowedPostKeys,
+		arg.HasCursor,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageSize,
+		arg.ViewerID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetFollowedPostKeysRow
+	for rows.Next() {
+		var i GetFollowedPostKeysRow
+		if err := rows.Scan(&i.PostID, &i.RepostedBy, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLatestTrendingGeneration = `-- name: GetLatestTrendingGeneration :one
+SELECT generation FROM trending_posts
+ORDER BY generation DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestTrendingGeneration(ctx context.Context) (time.Time, error) {
+	row := q.db.QueryRowContext(ctx, getLatestTrendingGeneration)
+	var generation time.Time
+	err := row.Scan(&generation)
+	return generation, err
+}
+
+const getLikedPostIDs = `-- name: GetLikedPostIDs :many
+SELECT post_id FROM post_likes
+WHERE post_id = ANY($1::VARCHAR(100)[]) AND user_id = $2::VARCHAR(100)
+`
+
+type GetLikedPostIDsParams struct {
+	PostIds []string
+	UserID  string
+}
+
+func (q *Queries) GetLikedPostIDs(ctx context.Context, arg GetLikedPostIDsParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getLikedPostIDs, pq.Array(arg.PostIds), arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var post_id string
+		if err := rows.Scan(&post_id); err != nil {
+			return nil, err
+		}
+		items = append(items, post_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPost = `-- name: GetPost :one
-SELECT likes, audio, id, author, message, user_ids_who_likes, created_at from posts
+SELECT likes, audio, id, author, message, user_ids_who_likes, created_at, mentions, category, tags, edited_at, deleted_at, quoted_post_id, visibility, visibility_list_ids, status, publish_at, plays, supporters_room_id, supporters_purchase_type from posts
 WHERE id = $1
 `
 
@@ -62,16 +370,168 @@ func (q *Queries) GetPost(ctx context.Context, id string) (Post, error) {
 		&i.Message,
 		pq.Array(&i.UserIdsWhoLikes),
 		&i.CreatedAt,
+		&i.Mentions,
+		&i.Category,
+		pq.Array(&i.Tags),
+		&i.EditedAt,
+		&i.DeletedAt,
+		&i.QuotedPostID,
+		&i.Visibility,
+		pq.Array(&i.VisibilityListIds),
+		&i.Status,
+		&i.PublishAt,
+		&i.Plays,
+		&i.SupportersRoomID,
+		&i.SupportersPurchaseType,
 	)
 	return i, err
 }
 
+const getPostKeysByAuthors = `-- name: GetPostKeysByAuthors :many
+WITH k AS (
+	SELECT p.id AS post_id, ''::VARCHAR(100) AS reposted_by, p.created_at, p.id::TEXT AS key FROM posts p
+	WHERE p.author = ANY($5::VARCHAR(100)[]) AND p.deleted_at IS NULL AND
+	can_view_post($6::VARCHAR(100), p)
+	UNION ALL
+	SELECT r.post_id, r.user_id, r.created_at, (r.post_id || ':' || r.user_id)::TEXT FROM reposts r
+	JOIN posts p ON p.id = r.post_id
+	WHERE r.user_id = ANY($5::VARCHAR(100)[]) AND p.deleted_at IS NULL AND
+	can_view_post($6::VARCHAR(100), p)
+)
+SELECT post_id, reposted_by, created_at FROM k
+WHERE NOT $1::BOOLEAN OR (created_at, key COLLATE "C") < ($2::TIMESTAMPTZ, $3::TEXT COLLATE "C")
+ORDER BY created_at DESC, key COLLATE "C" DESC
+LIMIT $4::INTEGER
+`
+
+type GetPostKeysByAuthorsParams struct {
+	HasCursor       bool
+	CursorCreatedAt time.Time
+	CursorID        string
+	PageSize        int32
+	AuthorIds       []string
+	ViewerID        string
+}
+
+type GetPostKeysByAuthorsRow struct {
+	PostID     string
+	RepostedBy string
+	CreatedAt  time.Time
+}
+
+func (q *Queries) GetPostKeysByAuthors(ctx context.Context, arg GetPostKeysByAuthorsParams) ([]GetPostKeysByAuthorsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPostKeysByAuthors,
+		arg.HasCursor,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageSize,
+		pq.Array(arg.AuthorIds),
+		arg.ViewerID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPostKeysByAuthorsRow
+	for rows.Next() {
+		var i GetPostKeysByAuthorsRow
+		if err := rows.Scan(&i.PostID, &i.RepostedBy, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPostLikers = `-- name: GetPostLikers :many
+SELECT l.user_id, u.username, l.created_at FROM post_likes l
+JOIN users u ON u.id = l.user_id
+WHERE l.post_id = $1::VARCHAR(100)
+AND (NOT $2::BOOLEAN OR (l.created_at, l.user_id) < ($3::TIMESTAMPTZ, $4::VARCHAR(100)))
+ORDER BY l.created_at DESC, l.user_id DESC
+LIMIT $5::INTEGER
+`
+
+type GetPostLikersParams struct {
+	PostID          string
+	HasCursor       bool
+	CursorCreatedAt time.Time
+	CursorID        string
+	PageSize        int32
+}
+
+type GetPostLikersRow struct {
+	UserID    string
+	Username  sql.NullString
+	CreatedAt time.Time
+}
+
+func (q *Queries) GetPostLikers(ctx context.Context, arg GetPostLikersParams) ([]GetPostLikersRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPostLikers,
+		arg.PostID,
+		arg.HasCursor,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPostLikersRow
+	for rows.Next() {
+		var i GetPostLikersRow
+		if err := rows.Scan(&i.UserID, &i.Username, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPosts = `-- name: GetPosts :many
-SELECT likes, audio, id, author, message, user_ids_who_likes, created_at from posts
+SELECT p.likes, p.audio, p.id, p.author, p.message, p.user_ids_who_likes, p.created_at, p.mentions, p.category, p.tags, p.edited_at, p.deleted_at, p.quoted_post_id, p.visibility, p.visibility_list_ids, p.status, p.publish_at, p.plays, p.supporters_room_id, p.supporters_purchase_type from posts p
+WHERE p.deleted_at IS NULL AND
+($1::VARCHAR(100) = '' OR p.category = $1::VARCHAR(100)) AND
+($2::VARCHAR(100) = '' OR p.tags @> ARRAY[$2::VARCHAR(100)]) AND
+(NOT $3::BOOLEAN OR (p.created_at, p.id) < ($4::TIMESTAMPTZ, $5::VARCHAR(100))) AND
+can_view_post($6::VARCHAR(100), p)
+ORDER BY p.created_at DESC, p.id DESC
+LIMIT $7::INTEGER
 `
 
-func (q *Queries) GetPosts(ctx context.Context) ([]Post, error) {
-	rows, err := q.db.QueryContext(ctx, getPosts)
+type GetPostsParams struct {
+	Category        string
+	Tag             string
+	HasCursor       bool
+	CursorCreatedAt time.Time
+	CursorID        string
+	ViewerID        string
+	PageSize        int32
+}
+
+func (q *Queries) GetPosts(ctx context.Context, arg GetPostsParams) ([]Post, error) {
+	rows, err := q.db.QueryContext(ctx, getPosts,
+		arg.Category,
+		arg.Tag,
+		arg.HasCursor,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.ViewerID,
+		arg.PageSize,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -87,6 +547,19 @@ func (q *Queries) GetPosts(ctx context.Context) ([]Post, error) {
 			&i.Message,
 			pq.Array(&i.UserIdsWhoLikes),
 			&i.CreatedAt,
+			&i.Mentions,
+			&i.Category,
+			pq.Array(&i.Tags),
+			&i.EditedAt,
+			&i.DeletedAt,
+			&i.QuotedPostID,
+			&i.Visibility,
+			pq.Array(&i.VisibilityListIds),
+			&i.Status,
+			&i.PublishAt,
+			&i.Plays,
+			&i.SupportersRoomID,
+			&i.SupportersPurchaseType,
 		); err != nil {
 			return nil, err
 		}
@@ -101,31 +574,66 @@ func (q *Queries) GetPosts(ctx context.Context) ([]Post, error) {
 	return items, nil
 }
 
-const getTrendingTodayPostIDs = `-- name: GetTrendingTodayPostIDs :many
-WITH p AS (
-	SELECT id FROM posts
-	WHERE created_at > current_timestamp - interval '1 day'
-	ORDER BY likes DESC
-	LIMIT 30
-)
-SELECT id FROM p 
-ORDER BY RANDOM()
-LIMIT 10
+const getPostsByHashtag = `-- name: GetPostsByHashtag :many
+SELECT p.likes, p.audio, p.id, p.author, p.message, p.user_ids_who_likes, p.created_at, p.mentions, p.category, p.tags, p.edited_at, p.deleted_at, p.quoted_post_id, p.visibility, p.visibility_list_ids, p.status, p.publish_at, p.plays, p.supporters_room_id, p.supporters_purchase_type from posts p
+WHERE p.deleted_at IS NULL AND
+EXISTS (SELECT 1 FROM hashtag_uses u WHERE u.post_id = p.id AND u.hashtag = $1::VARCHAR(100)) AND
+(NOT $2::BOOLEAN OR (p.created_at, p.id) < ($3::TIMESTAMPTZ, $4::VARCHAR(100))) AND
+can_view_post($5::VARCHAR(100), p)
+ORDER BY p.created_at DESC, p.id DESC
+LIMIT $6::INTEGER
 `
 
-func (q *Queries) GetTrendingTodayPostIDs(ctx context.Context) ([]string, error) {
-	rows, err := q.db.QueryContext(ctx, getTrendingTodayPostIDs)
+type GetPostsByHashtagParams struct {
+	Hashtag         string
+	HasCursor       bool
+	CursorCreatedAt time.Time
+	CursorID        string
+	ViewerID        string
+	PageSize        int32
+}
+
+func (q *Queries) GetPostsByHashtag(ctx context.Context, arg GetPostsByHashtagParams) ([]Post, error) {
+	rows, err := q.db.QueryContext(ctx, getPostsByHashtag,
+		arg.Hashtag,
+		arg.HasCursor,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.ViewerID,
+		arg.PageSize,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []string
+	var items []Post
 	for rows.Next() {
-		var id string
-		if err := rows.Scan(&id); err != nil {
+		var i Post
+		if err := rows.Scan(
+			&i.Likes,
+			&i.Audio,
+			&i.ID,
+			&i.Author,
+			&i.Message,
+			pq.Array(&i.UserIdsWhoLikes),
+			&i.CreatedAt,
+			&i.Mentions,
+			&i.Category,
+			pq.Array(&i.Tags),
+			&i.EditedAt,
+			&i.DeletedAt,
+			&i.QuotedPostID,
+			&i.Visibility,
+			pq.Array(&i.VisibilityListIds),
+			&i.Status,
+			&i.PublishAt,
+			&i.Plays,
+			&i.SupportersRoomID,
+			&i.SupportersPurchaseType,
+		); err != nil {
 			return nil, err
 		}
-		items = append(items, id)
+		items = append(items, i)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -136,37 +644,26 @@ func (q *Queries) GetTrendingTodayPostIDs(ctx context.Context) ([]string, error)
 	return items, nil
 }
 
-const getTrendingTodayPosts = `-- name: GetTrendingTodayPosts :many
-WITH p AS (
-	SELECT likes, audio, id, author, message, user_ids_who_likes, created_at FROM posts
-	WHERE created_at > current_timestamp - interval '1 day'
-	ORDER BY likes DESC
-	LIMIT 30
-)
-SELECT likes, audio, id, author, message, user_ids_who_likes, created_at FROM p 
-ORDER BY RANDOM()
-LIMIT 10
+const getPostsByIDs = `-- name: GetPostsByIDs :many
+SELECT p.likes, p.audio, p.id, p.author, p.message, p.user_ids_who_likes, p.created_at, p.mentions, p.category, p.tags, p.edited_at, p.deleted_at, p.quoted_post_id, p.visibility, p.visibility_list_ids, p.status, p.publish_at, p.plays, p.supporters_room_id, p.supporters_purchase_type FROM posts p
+WHERE p.id = ANY($1::VARCHAR(100)[]) AND p.deleted_at IS NULL AND
+can_view_post($2::VARCHAR(100), p)
 `
 
-type GetTrendingTodayPostsRow struct {
-	Likes           sql.NullInt32
-	Audio           json.RawMessage
-	ID              string
-	Author          string
-	Message         string
-	UserIdsWhoLikes []string
-	CreatedAt       sql.NullTime
+type GetPostsByIDsParams struct {
+	Ids      []string
+	ViewerID string
 }
 
-func (q *Queries) GetTrendingTodayPosts(ctx context.Context) ([]GetTrendingTodayPostsRow, error) {
-	rows, err := q.db.QueryContext(ctx, getTrendingTodayPosts)
+func (q *Queries) GetPostsByIDs(ctx context.Context, arg GetPostsByIDsParams) ([]Post, error) {
+	rows, err := q.db.QueryContext(ctx, getPostsByIDs, pq.Array(arg.Ids), arg.ViewerID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetTrendingTodayPostsRow
+	var items []Post
 	for rows.Next() {
-		var i GetTrendingTodayPostsRow
+		var i Post
 		if err := rows.Scan(
 			&i.Likes,
 			&i.Audio,
@@ -175,6 +672,19 @@ func (q *Queries) GetTrendingTodayPosts(ctx context.Context) ([]GetTrendingToday
 			&i.Message,
 			pq.Array(&i.UserIdsWhoLikes),
 			&i.CreatedAt,
+			&i.Mentions,
+			&i.Category,
+			pq.Array(&i.Tags),
+			&i.EditedAt,
+			&i.DeletedAt,
+			&i.QuotedPostID,
+			&i.Visibility,
+			pq.Array(&i.VisibilityListIds),
+			&i.Status,
+			&i.PublishAt,
+			&i.Plays,
+			&i.SupportersRoomID,
+			&i.SupportersPurchaseType,
 		); err != nil {
 			return nil, err
 		}
@@ -189,69 +699,766 @@ func (q *Queries) GetTrendingTodayPosts(ctx context.Context) ([]GetTrendingToday
 	return items, nil
 }
 
-const hasUserLikedPost = `-- name: HasUserLikedPost :one
-SELECT EXISTS(SELECT 1 FROM posts WHERE id=$1 AND $2::VARCHAR(100)=ANY(user_ids_who_likes::VARCHAR(100)[]))
-`
-
-type HasUserLikedPostParams struct {
-	ID      string
-	Column2 string
-}
-
-func (q *Queries) HasUserLikedPost(ctx context.Context, arg HasUserLikedPostParams) (bool, error) {
-	row := q.db.QueryRowContext(ctx, hasUserLikedPost, arg.ID, arg.Column2)
-	var exists bool
-	err := row.Scan(&exists)
-	return exists, err
-}
-
-const likePost = `-- name: LikePost :one
-
-UPDATE posts
-SET
-likes = likes + 1,
-user_ids_who_likes = array_append(user_ids_who_likes,$1::VARCHAR(100))
-WHERE id = $2
-RETURNING likes, audio, id, author, message, user_ids_who_likes, created_at
+const getQuotedPosts = `-- name: GetQuotedPosts :many
+SELECT p.likes, p.audio, p.id, p.author, p.message, p.user_ids_who_likes, p.created_at, p.mentions, p.category, p.tags, p.edited_at, p.deleted_at, p.quoted_post_id, p.visibility, p.visibility_list_ids, p.status, p.publish_at, p.plays, p.supporters_room_id, p.supporters_purchase_type FROM posts p
+WHERE p.id = ANY($1::VARCHAR(100)[]) AND
+can_view_post($2::VARCHAR(100), p)
 `
 
-type LikePostParams struct {
-	Column1 string
-	ID      string
-}
-
-// TODO: pagination
-func (q *Queries) LikePost(ctx context.Context, arg LikePostParams) (Post, error) {
-	row := q.db.QueryRowContext(ctx, likePost, arg.Column1, arg.ID)
-	var i Post
-	err := row.Scan(
-		&i.Likes,
-		&i.Audio,
-		&i.ID,
-		&i.Author,
-		&i.Message,
-		pq.Array(&i.UserIdsWhoLikes),
-		&i.CreatedAt,
-	)
-	return i, err
+type GetQuotedPostsParams struct {
+	Ids      []string
+	ViewerID string
 }
 
-const removeLikeFromPost = `-- name: RemoveLikeFromPost :one
+func (q *Queries) GetQuotedPosts(ctx context.Context, arg GetQuotedPostsParams) ([]Post, error) {
+	rows, err := q.db.QueryContext(ctx, getQuotedPosts, pq.Array(arg.Ids), arg.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Post
+	for rows.Next() {
+		var i Post
+		if err := rows.Scan(
+			&i.Likes,
+			&i.Audio,
+			&i.ID,
+			&i.Author,
+			&i.Message,
+			pq.Array(&i.UserIdsWhoLikes),
+			&i.CreatedAt,
+			&i.Mentions,
+			&i.Category,
+			pq.Array(&i.Tags),
+			&i.EditedAt,
+			&i.DeletedAt,
+			&i.QuotedPostID,
+			&i.Visibility,
+			pq.Array(&i.VisibilityListIds),
+			&i.Status,
+			&i.PublishAt,
+			&i.Plays,
+			&i.SupportersRoomID,
+			&i.SupportersPurchaseType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRepostedPostIDs = `-- name: GetRepostedPostIDs :many
+SELECT post_id FROM reposts
+WHERE post_id = ANY($1::VARCHAR(100)[]) AND user_id = $2::VARCHAR(100)
+`
+
+type GetRepostedPostIDsParams struct {
+	PostIds []string
+	UserID  string
+}
+
+func (q *Queries) GetRepostedPostIDs(ctx context.Context, arg GetRepostedPostIDsParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getRepostedPostIDs, pq.Array(arg.PostIds), arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var post_id string
+		if err := rows.Scan(&post_id); err != nil {
+			return nil, err
+		}
+		items = append(items, post_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReposts = `-- name: GetReposts :many
+SELECT post_id, user_id FROM reposts
+WHERE post_id = ANY($1::VARCHAR(100)[]) AND user_id = ANY($2::VARCHAR(100)[])
+`
+
+type GetRepostsParams struct {
+	PostIds []string
+	UserIds []string
+}
+
+type GetRepostsRow struct {
+	PostID string
+	UserID string
+}
+
+func (q *Queries) GetReposts(ctx context.Context, arg GetRepostsParams) ([]GetRepostsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getReposts, pq.Array(arg.PostIds), pq.Array(arg.UserIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRepostsRow
+	for rows.Next() {
+		var i GetRepostsRow
+		if err := rows.Scan(&i.PostID, &i.UserID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getScheduledPosts = `-- name: GetScheduledPosts :many
+SELECT likes, audio, id, author, message, user_ids_who_likes, created_at, mentions, category, tags, edited_at, deleted_at, quoted_post_id, visibility, visibility_list_ids, status, publish_at, plays, supporters_room_id, supporters_purchase_type FROM posts
+WHERE author = $1::VARCHAR(100) AND status = 'scheduled' AND deleted_at IS NULL AND
+(NOT $2::BOOLEAN OR (publish_at, id) > ($3::TIMESTAMPTZ, $4::VARCHAR(100)))
+ORDER BY publish_at ASC, id ASC
+LIMIT $5::INTEGER
+`
+
+type GetScheduledPostsParams struct {
+	Author          string
+	HasCursor       bool
+	CursorPublishAt time.Time
+	CursorID        string
+	PageSize        int32
+}
+
+func (q *Queries) GetScheduledPosts(ctx context.Context, arg GetScheduledPostsParams) ([]Post, error) {
+	rows, err := q.db.QueryContext(ctx, getScheduledPosts,
+		arg.Author,
+		arg.HasCursor,
+		arg.CursorPublishAt,
+		arg.CursorID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Post
+	for rows.Next() {
+		var i Post
+		if err := rows.Scan(
+			&i.Likes,
+			&i.Audio,
+			&i.ID,
+			&i.Author,
+			&i.Message,
+			pq.Array(&i.UserIdsWhoLikes),
+			&i.CreatedAt,
+			&i.Mentions,
+			&i.Category,
+			pq.Array(&i.Tags),
+			&i.EditedAt,
+			&i.DeletedAt,
+			&i.QuotedPostID,
+			&i.Visibility,
+			pq.Array(&i.VisibilityListIds),
+			&i.Status,
+			&i.PublishAt,
+			&i.Plays,
+			&i.SupportersRoomID,
+			&i.SupportersPurchaseType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTrendingPostIDs = `-- name: GetTrendingPostIDs :many
+SELECT post_id, score FROM trending_posts
+WHERE generation = $1::TIMESTAMPTZ AND
+($2::VARCHAR(100) = '' OR category = $2::VARCHAR(100)) AND
+(NOT $3::BOOLEAN OR (score, post_id) < ($4::FLOAT8, $5::VARCHAR(100)))
+ORDER BY score DESC, post_id DESC
+LIMIT $6::INTEGER
+`
+
+type GetTrendingPostIDsParams struct {
+	Generation  time.Time
+	Category    string
+	HasCursor   bool
+	CursorScore float64
+	CursorID    string
+	PageSize    int32
+}
+
+type GetTrendingPostIDsRow struct {
+	PostID string
+	Score  float64
+}
+
+func (q *Queries) GetTrendingPostIDs(ctx context.Context, arg GetTrendingPostIDsParams) ([]GetTrendingPostIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTrendingPostIDs,
+		arg.Generation,
+		arg.Category,
+		arg.HasCursor,
+		arg.CursorScore,
+		arg.CursorID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTrendingPostIDsRow
+	for rows.Next() {
+		var i GetTrendingPostIDsRow
+		if err := rows.Scan(&i.PostID, &i.Score); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTrendingPostIDsByHashtag = `-- name: GetTrendingPostIDsByHashtag :many
+SELECT t.post_id FROM trending_posts t
+WHERE t.generation = (SELECT MAX(g.generation) FROM trending_posts g) AND
+EXISTS (SELECT 1 FROM hashtag_uses u WHERE u.post_id = t.post_id AND u.hashtag = $1::VARCHAR(100))
+ORDER BY t.score DESC, t.post_id DESC
+LIMIT $2::INTEGER
+`
+
+type GetTrendingPostIDsByHashtagParams struct {
+	Hashtag  string
+	PageSize int32
+}
+
+func (q *Queries) GetTrendingPostIDsByHashtag(ctx context.Context, arg GetTrendingPostIDsByHashtagParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getTrendingPostIDsByHashtag, arg.Hashtag, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var post_id string
+		if err := rows.Scan(&post_id); err != nil {
+			return nil, err
+		}
+		items = append(items, post_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const hasTrendingGeneration = `-- name: HasTrendingGeneration :one
+SELECT EXISTS(SELECT 1 FROM trending_posts WHERE generation = $1::TIMESTAMPTZ)
+`
+
+func (q *Queries) HasTrendingGeneration(ctx context.Context, generation time.Time) (bool, error) {
+	row := q.db.QueryRowContext(ctx, hasTrendingGeneration, generation)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const hasUserLikedPost = `-- name: HasUserLikedPost :one
+SELECT EXISTS(SELECT 1 FROM post_likes WHERE post_id = $1::VARCHAR(100) AND user_id = $2::VARCHAR(100))
+`
+
+type HasUserLikedPostParams struct {
+	PostID string
+	UserID string
+}
+
+func (q *Queries) HasUserLikedPost(ctx context.Context, arg HasUserLikedPostParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, hasUserLikedPost, arg.PostID, arg.UserID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const likePost = `-- name: LikePost :one
+WITH l AS (
+	INSERT INTO post_likes (post_id, user_id, created_at)
+	VALUES ($1::VARCHAR(100), $2::VARCHAR(100), $3::TIMESTAMPTZ)
+	ON CONFLICT (post_id, user_id) DO NOTHING
+	RETURNING post_id
+)
 UPDATE posts
-SET
-likes = likes - 1,
-user_ids_who_likes = array_remove(user_ids_who_likes,$1::VARCHAR(100))
-WHERE id = $2
-RETURNING likes, audio, id, author, message, user_ids_who_likes, created_at
+SET likes = COALESCE(likes, 0) + (SELECT COUNT(*) FROM l)
+WHERE id = $1::VARCHAR(100)
+RETURNING likes, audio, id, author, message, user_ids_who_likes, created_at, mentions, category, tags, edited_at, deleted_at, quoted_post_id, visibility, visibility_list_ids, status, publish_at, plays, supporters_room_id, supporters_purchase_type
+`
+
+type LikePostParams struct {
+	ID        string
+	UserID    string
+	CreatedAt time.Time
+}
+
+func (q *Queries) LikePost(ctx context.Context, arg LikePostParams) (Post, error) {
+	row := q.db.QueryRowContext(ctx, likePost, arg.ID, arg.UserID, arg.CreatedAt)
+	var i Post
+	err := row.Scan(
+		&i.Likes,
+		&i.Audio,
+		&i.ID,
+		&i.Author,
+		&i.Message,
+		pq.Array(&i.UserIdsWhoLikes),
+		&i.CreatedAt,
+		&i.Mentions,
+		&i.Category,
+		pq.Array(&i.Tags),
+		&i.EditedAt,
+		&i.DeletedAt,
+		&i.QuotedPostID,
+		&i.Visibility,
+		pq.Array(&i.VisibilityListIds),
+		&i.Status,
+		&i.PublishAt,
+		&i.Plays,
+		&i.SupportersRoomID,
+		&i.SupportersPurchaseType,
+	)
+	return i, err
+}
+
+const publishDuePosts = `-- name: PublishDuePosts :many
+UPDATE posts
+SET status = 'published', created_at = $1::TIMESTAMPTZ
+WHERE id IN (
+	SELECT id FROM posts
+	WHERE status = 'scheduled' AND publish_at <= $1::TIMESTAMPTZ AND deleted_at IS NULL AND
+	-- Posts of suspended authors are held until the suspension ends
+	NOT EXISTS (
+		SELECT 1 FROM users u
+		WHERE u.id = posts.author AND u.suspended_until > $1::TIMESTAMPTZ
+	)
+	ORDER BY publish_at
+	LIMIT $2::INTEGER
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING likes, audio, id, author, message, user_ids_who_likes, created_at, mentions, category, tags, edited_at, deleted_at, quoted_post_id, visibility, visibility_list_ids, status, publish_at, plays, supporters_room_id, supporters_purchase_type
+`
+
+type PublishDuePostsParams struct {
+	PublishedAt time.Time
+	BatchSize   int32
+}
+
+func (q *Queries) PublishDuePosts(ctx context.Context, arg PublishDuePostsParams) ([]Post, error) {
+	rows, err := q.db.QueryContext(ctx, publishDuePosts, arg.PublishedAt, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Post
+	for rows.Next() {
+		var i Post
+		if err := rows.Scan(
+			&i.Likes,
+			&i.Audio,
+			&i.ID,
+			&i.Author,
+			&i.Message,
+			pq.Array(&i.UserIdsWhoLikes),
+			&i.CreatedAt,
+			&i.Mentions,
+			&i.Category,
+			pq.Array(&i.Tags),
+			&i.EditedAt,
+			&i.DeletedAt,
+			&i.QuotedPostID,
+			&i.Visibility,
+			pq.Array(&i.VisibilityListIds),
+			&i.Status,
+			&i.PublishAt,
+			&i.Plays,
+			&i.SupportersRoomID,
+			&i.SupportersPurchaseType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const publishPost = `-- name: PublishPost :one
+UPDATE posts
+SET status = 'published', created_at = $1::TIMESTAMPTZ
+WHERE id = $2::VARCHAR(100) AND author = $3::VARCHAR(100) AND
+status IN ('draft', 'scheduled') AND deleted_at IS NULL
+RETURNING likes, audio, id, author, message, user_ids_who_likes, created_at, mentions, category, tags, edited_at, deleted_at, quoted_post_id, visibility, visibility_list_ids, status, publish_at, plays, supporters_room_id, supporters_purchase_type
+`
+
+type PublishPostParams struct {
+	PublishedAt time.Time
+	ID          string
+	Author      string
+}
+
+func (q *Queries) PublishPost(ctx context.Context, arg PublishPostParams) (Post, error) {
+	row := q.db.QueryRowContext(ctx, publishPost, arg.PublishedAt, arg.ID, arg.Author)
+	var i Post
+	err := row.Scan(
+		&i.Likes,
+		&i.Audio,
+		&i.ID,
+		&i.Author,
+		&i.Message,
+		pq.Array(&i.UserIdsWhoLikes),
+		&i.CreatedAt,
+		&i.Mentions,
+		&i.Category,
+		pq.Array(&i.Tags),
+		&i.EditedAt,
+		&i.DeletedAt,
+		&i.QuotedPostID,
+		&i.Visibility,
+		pq.Array(&i.VisibilityListIds),
+		&i.Status,
+		&i.PublishAt,
+		&i.Plays,
+		&i.SupportersRoomID,
+		&i.SupportersPurchaseType,
+	)
+	return i, err
+}
+
+const refreshTrendingPosts = `-- name: RefreshTrendingPosts :execrows
+INSERT INTO trending_posts (generation, post_id, category, score)
+SELECT $1::TIMESTAMPTZ, p.id, p.category,
+(COALESCE(p.likes, 0) + 2 * (SELECT COUNT(*) FROM comments c WHERE c.post_id = p.id AND c.deleted_at IS NULL) + 0.2 * p.plays)::FLOAT8 /
+POWER(GREATEST(EXTRACT(EPOCH FROM $1::TIMESTAMPTZ - p.created_at), 0) / 3600 + 2, $2::FLOAT8)
+FROM posts p
+WHERE p.created_at > $1::TIMESTAMPTZ - $3::FLOAT8 * interval '1 second' AND
+p.deleted_at IS NULL AND p.status = 'published' AND p.visibility = 'public' AND
+(COALESCE(p.likes, 0) > 0 OR p.plays > 0 OR EXISTS (SELECT 1 FROM comments c WHERE c.post_id = p.id AND c.deleted_at IS NULL)) AND
+NOT EXISTS (SELECT 1 FROM trending_posts t WHERE t.generation > $1::TIMESTAMPTZ - $4::FLOAT8 * interval '1 second')
+`
+
+type RefreshTrendingPostsParams struct {
+	Generation      time.Time
+	Gravity         float64
+	WindowSeconds   float64
+	IntervalSeconds float64
+}
+
+func (q *Queries) RefreshTrendingPosts(ctx context.Context, arg RefreshTrendingPostsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, refreshTrendingPosts,
+		arg.Generation,
+		arg.Gravity,
+		arg.WindowSeconds,
+		arg.IntervalSeconds,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const removeLikeFromPost = `-- name: RemoveLikeFromPost :one
+WITH l AS (
+	DELETE FROM post_likes
+	WHERE post_id = $1::VARCHAR(100) AND user_id = $2::VARCHAR(100)
+	RETURNING post_id
+)
+UPDATE posts
+SET likes = COALESCE(likes, 0) - (SELECT COUNT(*) FROM l)
+WHERE id = $1::VARCHAR(100)
+RETURNING likes, audio, id, author, message, user_ids_who_likes, created_at, mentions, category, tags, edited_at, deleted_at, quoted_post_id, visibility, visibility_list_ids, status, publish_at, plays, supporters_room_id, supporters_purchase_type
 `
 
 type RemoveLikeFromPostParams struct {
-	Column1 string
-	ID      string
+	ID     string
+	UserID string
 }
 
 func (q *Queries) RemoveLikeFromPost(ctx context.Context, arg RemoveLikeFromPostParams) (Post, error) {
-	row := q.db.QueryRowContext(ctx, removeLikeFromPost, arg.Column1, arg.ID)
+	row := q.db.QueryRowContext(ctx, removeLikeFromPost, arg.ID, arg.UserID)
+	var i Post
+	err := row.Scan(
+		&i.Likes,
+		&i.Audio,
+		&i.ID,
+		&i.Author,
+		&i.Message,
+		pq.Array(&i.UserIdsWhoLikes),
+		&i.CreatedAt,
+		&i.Mentions,
+		&i.Category,
+		pq.Array(&i.Tags),
+		&i.EditedAt,
+		&i.DeletedAt,
+		&i.QuotedPostID,
+		&i.Visibility,
+		pq.Array(&i.VisibilityListIds),
+		&i.Status,
+		&i.PublishAt,
+		&i.Plays,
+		&i.SupportersRoomID,
+		&i.SupportersPurchaseType,
+	)
+	return i, err
+}
+
+const repostPost = `-- name: RepostPost :execrows
+INSERT INTO reposts (post_id, user_id, created_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (post_id, user_id) DO NOTHING
+`
+
+type RepostPostParams struct {
+	PostID    string
+	UserID    string
+	CreatedAt time.Time
+}
+
+func (q *Queries) RepostPost(ctx context.Context, arg RepostPostParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, repostPost, arg.PostID, arg.UserID, arg.CreatedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const schedulePost = `-- name: SchedulePost :one
+UPDATE posts
+SET status = 'scheduled', publish_at = $1::TIMESTAMPTZ
+WHERE id = $2::VARCHAR(100) AND author = $3::VARCHAR(100) AND
+status IN ('draft', 'scheduled') AND deleted_at IS NULL
+RETURNING likes, audio, id, author, message, user_ids_who_likes, created_at, mentions, category, tags, edited_at, deleted_at, quoted_post_id, visibility, visibility_list_ids, status, publish_at, plays, supporters_room_id, supporters_purchase_type
+`
+
+type SchedulePostParams struct {
+	PublishAt time.Time
+	ID        string
+	Author    string
+}
+
+func (q *Queries) SchedulePost(ctx context.Context, arg SchedulePostParams) (Post, error) {
+	row := q.db.QueryRowContext(ctx, schedulePost, arg.PublishAt, arg.ID, arg.Author)
+	var i Post
+	err := row.Scan(
+		&i.Likes,
+		&i.Audio,
+		&i.ID,
+		&i.Author,
+		&i.Message,
+		pq.Array(&i.UserIdsWhoLikes),
+		&i.CreatedAt,
+		&i.Mentions,
+		&i.Category,
+		pq.Array(&i.Tags),
+		&i.EditedAt,
+		&i.DeletedAt,
+		&i.QuotedPostID,
+		&i.Visibility,
+		pq.Array(&i.VisibilityListIds),
+		&i.Status,
+		&i.PublishAt,
+		&i.Plays,
+		&i.SupportersRoomID,
+		&i.SupportersPurchaseType,
+	)
+	return i, err
+}
+
+const softDeletePost = `-- name: SoftDeletePost :one
+WITH e AS (
+	INSERT INTO edits (id, target_type, target_id, editor_id, action, previous_message, created_at)
+	SELECT $4::VARCHAR(100), 'post', p.id, $5::VARCHAR(100), 'delete', p.message, $1::TIMESTAMPTZ
+	FROM posts p
+	WHERE p.id = $2::VARCHAR(100) AND p.author = $3::VARCHAR(100) AND p.deleted_at IS NULL
+	RETURNING target_id
+), b AS (
+	DELETE FROM bookmarks
+	WHERE post_id IN (SELECT target_id FROM e)
+), h AS (
+	DELETE FROM hashtag_uses
+	WHERE post_id IN (SELECT target_id FROM e)
+)
+UPDATE posts
+SET
+message = '[deleted]',
+audio = '{}',
+mentions = '[]',
+tags = '{}',
+deleted_at = $1::TIMESTAMPTZ
+WHERE id = $2::VARCHAR(100) AND author = $3::VARCHAR(100) AND deleted_at IS NULL
+RETURNING likes, audio, id, author, message, user_ids_who_likes, created_at, mentions, category, tags, edited_at, deleted_at, quoted_post_id, visibility, visibility_list_ids, status, publish_at, plays, supporters_room_id, supporters_purchase_type
+`
+
+type SoftDeletePostParams struct {
+	DeletedAt time.Time
+	ID        string
+	Author    string
+	EditID    string
+	EditorID  string
+}
+
+func (q *Queries) SoftDeletePost(ctx context.Context, arg SoftDeletePostParams) (Post, error) {
+	row := q.db.QueryRowContext(ctx, softDeletePost,
+		arg.DeletedAt,
+		arg.ID,
+		arg.Author,
+		arg.EditID,
+		arg.EditorID,
+	)
+	var i Post
+	err := row.Scan(
+		&i.Likes,
+		&i.Audio,
+		&i.ID,
+		&i.Author,
+		&i.Message,
+		pq.Array(&i.UserIdsWhoLikes),
+		&i.CreatedAt,
+		&i.Mentions,
+		&i.Category,
+		pq.Array(&i.Tags),
+		&i.EditedAt,
+		&i.DeletedAt,
+		&i.QuotedPostID,
+		&i.Visibility,
+		pq.Array(&i.VisibilityListIds),
+		&i.Status,
+		&i.PublishAt,
+		&i.Plays,
+		&i.SupportersRoomID,
+		&i.SupportersPurchaseType,
+	)
+	return i, err
+}
+
+const unrepostPost = `-- name: UnrepostPost :exec
+DELETE FROM reposts
+WHERE post_id = $1 AND user_id = $2
+`
+
+type UnrepostPostParams struct {
+	PostID string
+	UserID string
+}
+
+func (q *Queries) UnrepostPost(ctx context.Context, arg UnrepostPostParams) error {
+	_, err := q.db.ExecContext(ctx, unrepostPost, arg.PostID, arg.UserID)
+	return err
+}
+
+const unschedulePost = `-- name: UnschedulePost :one
+UPDATE posts
+SET status = 'draft', publish_at = NULL
+WHERE id = $1::VARCHAR(100) AND author = $2::VARCHAR(100) AND
+status = 'scheduled' AND deleted_at IS NULL
+RETURNING likes, audio, id, author, message, user_ids_who_likes, created_at, mentions, category, tags, edited_at, deleted_at, quoted_post_id, visibility, visibility_list_ids, status, publish_at, plays, supporters_room_id, supporters_purchase_type
+`
+
+type UnschedulePostParams struct {
+	ID     string
+	Author string
+}
+
+func (q *Queries) UnschedulePost(ctx context.Context, arg UnschedulePostParams) (Post, error) {
+	row := q.db.QueryRowContext(ctx, unschedulePost, arg.ID, arg.Author)
+	var i Post
+	err := row.Scan(
+		&i.Likes,
+		&i.Audio,
+		&i.ID,
+		&i.Author,
+		&i.Message,
+		pq.Array(&i.UserIdsWhoLikes),
+		&i.CreatedAt,
+		&i.Mentions,
+		&i.Category,
+		pq.Array(&i.Tags),
+		&i.EditedAt,
+		&i.DeletedAt,
+		&i.QuotedPostID,
+		&i.Visibility,
+		pq.Array(&i.VisibilityListIds),
+		&i.Status,
+		&i.PublishAt,
+		&i.Plays,
+		&i.SupportersRoomID,
+		&i.SupportersPurchaseType,
+	)
+	return i, err
+}
+
+const updatePostMessage = `-- name: UpdatePostMessage :one
+WITH e AS (
+	INSERT INTO edits (id, target_type, target_id, editor_id, action, previous_message, created_at)
+	SELECT $6::VARCHAR(100), 'post', p.id, p.author, 'edit', p.message, $3::TIMESTAMPTZ
+	FROM posts p
+	WHERE p.id = $4::VARCHAR(100) AND p.author = $5::VARCHAR(100) AND p.deleted_at IS NULL
+)
+UPDATE posts
+SET
+message = $1::TEXT,
+mentions = $2::JSON,
+edited_at = $3::TIMESTAMPTZ
+WHERE id = $4::VARCHAR(100) AND author = $5::VARCHAR(100) AND deleted_at IS NULL
+RETURNING likes, audio, id, author, message, user_ids_who_likes, created_at, mentions, category, tags, edited_at, deleted_at, quoted_post_id, visibility, visibility_list_ids, status, publish_at, plays, supporters_room_id, supporters_purchase_type
+`
+
+type UpdatePostMessageParams struct {
+	Message  string
+	Mentions json.RawMessage
+	EditedAt time.Time
+	ID       string
+	Author   string
+	EditID   string
+}
+
+func (q *Queries) UpdatePostMessage(ctx context.Context, arg UpdatePostMessageParams) (Post, error) {
+	row := q.db.QueryRowContext(ctx, updatePostMessage,
+		arg.Message,
+		arg.Mentions,
+		arg.EditedAt,
+		arg.ID,
+		arg.Author,
+		arg.EditID,
+	)
 	var i Post
 	err := row.Scan(
 		&i.Likes,
@@ -261,6 +1468,19 @@ func (q *Queries) RemoveLikeFromPost(ctx context.Context, arg RemoveLikeFromPost
 		&i.Message,
 		pq.Array(&i.UserIdsWhoLikes),
 		&i.CreatedAt,
+		&i.Mentions,
+		&i.Category,
+		pq.Array(&i.Tags),
+		&i.EditedAt,
+		&i.DeletedAt,
+		&i.QuotedPostID,
+		&i.Visibility,
+		pq.Array(&i.VisibilityListIds),
+		&i.Status,
+		&i.PublishAt,
+		&i.Plays,
+		&i.SupportersRoomID,
+		&i.SupportersPurchaseType,
 	)
 	return i, err
 }
@@ -0,0 +1,33 @@
+package posts
+
+import (
+	"fmt"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+)
+
+// Status refers to the publication state of a post
+type Status string
+
+const (
+	// StatusPublished posts are visible according to their visibility
+	StatusPublished Status = "published"
+	// StatusDraft posts are only visible to their author
+	StatusDraft Status = "draft"
+	// StatusScheduled posts are only visible to their author until publish_at
+	StatusScheduled Status = "scheduled"
+)
+
+// PGStatusToPB converts a postgres post status to protobuf
+func PGStatusToPB(s Status) (v1API.PostStatus_Enum, error) {
+	switch s {
+	case StatusPublished:
+		return v1API.PostStatus_PUBLISHED, nil
+	case StatusDraft:
+		return v1API.PostStatus_DRAFT, nil
+	case StatusScheduled:
+		return v1API.PostStatus_SCHEDULED, nil
+	default:
+		return 0, fmt.Errorf("invalid post status: %q", s)
+	}
+}
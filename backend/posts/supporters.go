@@ -0,0 +1,77 @@
+package posts
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+)
+
+// PurchaseType refers to the purchase which grants access to a supporters only post
+type PurchaseType string
+
+const (
+	// PurchaseTypeOneTime posts are accessible to users who bought the room access
+	PurchaseTypeOneTime PurchaseType = "one_time"
+	// PurchaseTypeSubscriptionMonthly posts are accessible to the room subscribers
+	PurchaseTypeSubscriptionMonthly PurchaseType = "subscription_monthly"
+)
+
+// PreviewLength is the maximum number of characters of the message preview of a locked post.
+// It is kept to a short title, so that the preview does not give away the locked content
+const PreviewLength = 24
+
+// PGPurchaseTypeToPB converts a postgres purchase type to protobuf
+func PGPurchaseTypeToPB(t PurchaseType) (v1API.RoomSubcriptionType_Enum, error) {
+	switch t {
+	case PurchaseTypeOneTime:
+		return v1API.RoomSubcriptionType_ONE_TIME, nil
+	case PurchaseTypeSubscriptionMonthly:
+		return v1API.RoomSubcriptionType_SUBSCRIPTION_MONTHLY, nil
+	default:
+		return 0, fmt.Errorf("invalid purchase type: %q", t)
+	}
+}
+
+// PBPurchaseTypeToPG converts a protobuf purchase type to postgres
+func PBPurchaseTypeToPG(t v1API.RoomSubcriptionType_Enum) (PurchaseType, error) {
+	switch t {
+	case v1API.RoomSubcriptionType_ONE_TIME:
+		return PurchaseTypeOneTime, nil
+	case v1API.RoomSubcriptionType_SUBSCRIPTION_MONTHLY:
+		return PurchaseTypeSubscriptionMonthly, nil
+	default:
+		return "", fmt.Errorf("invalid purchase type: %v", t)
+	}
+}
+
+// LockPost strips the content of a post the user cannot access.
+// The message is reduced to a preview of its first line, and the audio to its metadata
+func LockPost(p *v1API.Post, reason v1API.PostLockReason_Enum) {
+	p.Locked = true
+	p.LockReason = reason
+	p.Message = messagePreview(p.Message)
+	p.Mentions = nil
+	if p.Audio != nil {
+		p.Audio = &v1API.Audio{
+			Id:         p.Audio.Id,
+			Format:     p.Audio.Format,
+			DurationMs: p.Audio.DurationMs,
+		}
+	}
+}
+
+// messagePreview returns the first line of the message, truncated to PreviewLength characters.
+// It must match the post_preview SQL function, which searches match for locked posts
+func messagePreview(msg string) string {
+	if i := strings.IndexByte(msg, '\n'); i >= 0 {
+		msg = msg[:i]
+	}
+	msg = strings.TrimSpace(msg)
+	if utf8.RuneCountInString(msg) <= PreviewLength {
+		return msg
+	}
+
+	return string([]rune(msg)[:PreviewLength])
+}
@@ -0,0 +1,113 @@
+package posts
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+const (
+	// TrendingRefreshInterval is the minimum time between two generations of trending posts
+	TrendingRefreshInterval = 5 * time.Minute
+	// TrendingRetention is the time an older generation is kept, so that cursors
+	// of clients paginating it keep working after a refresh
+	TrendingRetention = 30 * time.Minute
+	// TrendingWindow is the maximum age of a post to be trending
+	TrendingWindow = 7 * 24 * time.Hour
+	// TrendingGravity is the exponent of the post age in hours decaying its score.
+	// The higher the gravity, the faster older posts stop trending
+	TrendingGravity = 1.8
+	// MixTrendingPostsCount is the number of trending posts of a mix
+	MixTrendingPostsCount = 10
+)
+
+// ErrTrendingGenerationExpired is returned when paginating a generation of trending posts which has been deleted
+var ErrTrendingGenerationExpired = errors.New("trending generation expired")
+
+// RefreshTrendingPosts computes a new generation of trending posts scored by likes, comments and plays,
+// decayed by age. It is a no-op when the latest generation is more recent than TrendingRefreshInterval.
+// Generations older than TrendingRetention are deleted, except for the latest one.
+// It returns the number of scored posts
+func (d *Directory) RefreshTrendingPosts(ctx context.Context, now time.Time) (int64, error) {
+	count, err := d.querier.RefreshTrendingPosts(ctx, RefreshTrendingPostsParams{
+		Generation:      now,
+		Gravity:         TrendingGravity,
+		WindowSeconds:   TrendingWindow.Seconds(),
+		IntervalSeconds: TrendingRefreshInterval.Seconds(),
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err := d.querier.DeleteTrendingGenerations(ctx, now.Add(-TrendingRetention)); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetLatestTrendingGeneration returns the time of the latest generation of trending posts.
+// A zero time is returned when none has been computed yet
+func (d *Directory) GetLatestTrendingGeneration(ctx context.Context) (time.Time, error) {
+	generation, err := d.querier.GetLatestTrendingGeneration(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return generation.UTC(), nil
+}
+
+// GetTrendingPostIDs returns a page of the ids of the generation trending posts, from the highest score.
+// An empty category returns trending posts of every category.
+// ErrTrendingGenerationExpired is returned when the generation no longer exists
+func (d *Directory) GetTrendingPostIDs(ctx context.Context, params GetTrendingPostIDsParams) ([]GetTrendingPostIDsRow, error) {
+	res, err := d.querier.GetTrendingPostIDs(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) == 0 && params.HasCursor {
+		exists, err := d.querier.HasTrendingGeneration(ctx, params.Generation)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, ErrTrendingGenerationExpired
+		}
+	}
+
+	return res, nil
+}
+
+// GetTrendingPostIDsByCategory returns the ids of the latest trending posts of the category
+func (d *Directory) GetTrendingPostIDsByCategory(ctx context.Context, category string) ([]string, error) {
+	generation, err := d.GetLatestTrendingGeneration(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := d.querier.GetTrendingPostIDs(ctx, GetTrendingPostIDsParams{
+		Generation: generation,
+		Category:   category,
+		PageSize:   MixTrendingPostsCount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []string{}
+	for _, r := range res {
+		ids = append(ids, r.PostID)
+	}
+
+	return ids, nil
+}
+
+// GetTrendingPostIDsByHashtag returns the ids of the latest trending posts using the hashtag
+func (d *Directory) GetTrendingPostIDsByHashtag(ctx context.Context, hashtag string) ([]string, error) {
+	return d.querier.GetTrendingPostIDsByHashtag(ctx, GetTrendingPostIDsByHashtagParams{
+		Hashtag:  hashtag,
+		PageSize: MixTrendingPostsCount,
+	})
+}
@@ -0,0 +1,47 @@
+package posts
+
+import (
+	"fmt"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+)
+
+// Visibility refers to the audience of a post
+type Visibility string
+
+const (
+	// VisibilityPublic posts are visible to everyone
+	VisibilityPublic Visibility = "public"
+	// VisibilityFollowers posts are visible to the author followers
+	VisibilityFollowers Visibility = "followers"
+	// VisibilityLists posts are visible to the members of the author lists in visibility_list_ids
+	VisibilityLists Visibility = "lists"
+)
+
+// PGVisibilityToPB converts a postgres post visibility to protobuf
+func PGVisibilityToPB(v Visibility) (v1API.PostVisibility_Enum, error) {
+	switch v {
+	case VisibilityPublic:
+		return v1API.PostVisibility_PUBLIC, nil
+	case VisibilityFollowers:
+		return v1API.PostVisibility_FOLLOWERS, nil
+	case VisibilityLists:
+		return v1API.PostVisibility_LISTS, nil
+	default:
+		return 0, fmt.Errorf("invalid post visibility: %q", v)
+	}
+}
+
+// PBVisibilityToPG converts a protobuf post visibility to postgres
+func PBVisibilityToPG(v v1API.PostVisibility_Enum) (Visibility, error) {
+	switch v {
+	case v1API.PostVisibility_PUBLIC:
+		return VisibilityPublic, nil
+	case v1API.PostVisibility_FOLLOWERS:
+		return VisibilityFollowers, nil
+	case v1API.PostVisibility_LISTS:
+		return VisibilityLists, nil
+	default:
+		return "", fmt.Errorf("invalid post visibility: %v", v)
+	}
+}
@@ -0,0 +1,52 @@
+-- name: Search :many
+WITH q AS (
+	SELECT websearch_to_tsquery('simple', sqlc.arg(query)::TEXT) AS tsq
+), r AS (
+	SELECT 'post'::VARCHAR(100) AS result_type, p.id, ts_rank(to_tsvector('simple', CASE
+		WHEN can_access_post(sqlc.arg(viewer_id)::VARCHAR(100), p) THEN p.message
+		ELSE post_preview(p.message)
+	END), q.tsq)::FLOAT8 AS rank
+	FROM posts p, q
+	WHERE sqlc.arg(include_posts)::BOOLEAN AND to_tsvector('simple', p.message) @@ q.tsq AND
+	-- Locked posts only match the preview shown to the viewer
+	(can_access_post(sqlc.arg(viewer_id)::VARCHAR(100), p) OR to_tsvector('simple', post_preview(p.message)) @@ q.tsq) AND
+	p.deleted_at IS NULL AND
+	(sqlc.arg(category)::VARCHAR(100) = '' OR p.category = sqlc.arg(category)::VARCHAR(100)) AND
+	NOT EXISTS (
+		SELECT 1 FROM blocks b
+		WHERE (b.user_id = sqlc.arg(viewer_id)::VARCHAR(100) AND b.blocked_user_id = p.author) OR
+		(b.user_id = p.author AND b.blocked_user_id = sqlc.arg(viewer_id)::VARCHAR(100))
+	) AND
+	can_view_post(sqlc.arg(viewer_id)::VARCHAR(100), p)
+	UNION ALL
+	SELECT 'comment'::VARCHAR(100), c.id, ts_rank(to_tsvector('simple', c.message), q.tsq)::FLOAT8
+	FROM comments c JOIN posts p ON p.id = c.post_id, q
+	WHERE sqlc.arg(include_comments)::BOOLEAN AND to_tsvector('simple', c.message) @@ q.tsq AND
+	c.deleted_at IS NULL AND p.deleted_at IS NULL AND
+	(sqlc.arg(category)::VARCHAR(100) = '' OR p.category = sqlc.arg(category)::VARCHAR(100)) AND
+	NOT EXISTS (
+		SELECT 1 FROM blocks b
+		WHERE (b.user_id = sqlc.arg(viewer_id)::VARCHAR(100) AND b.blocked_user_id IN (c.author, p.author)) OR
+		(b.user_id IN (c.author, p.author) AND b.blocked_user_id = sqlc.arg(viewer_id)::VARCHAR(100))
+	) AND
+	can_view_post(sqlc.arg(viewer_id)::VARCHAR(100), p)
+	UNION ALL
+	SELECT 'user'::VARCHAR(100), u.id, GREATEST(
+		similarity(u.username, sqlc.arg(query)::TEXT),
+		similarity(COALESCE(u.given_name, '') || ' ' || COALESCE(u.family_name, ''), sqlc.arg(query)::TEXT)
+	)::FLOAT8
+	FROM users u
+	WHERE sqlc.arg(include_users)::BOOLEAN AND
+	(u.username % sqlc.arg(query)::TEXT OR
+	(COALESCE(u.given_name, '') || ' ' || COALESCE(u.family_name, '')) % sqlc.arg(query)::TEXT OR
+	starts_with(LOWER(u.username), LOWER(sqlc.arg(query)::TEXT))) AND
+	NOT EXISTS (
+		SELECT 1 FROM blocks b
+		WHERE (b.user_id = sqlc.arg(viewer_id)::VARCHAR(100) AND b.blocked_user_id = u.id) OR
+		(b.user_id = u.id AND b.blocked_user_id = sqlc.arg(viewer_id)::VARCHAR(100))
+	)
+)
+SELECT result_type, id, rank FROM r
+WHERE NOT sqlc.arg(has_cursor)::BOOLEAN OR (rank, id COLLATE "C") < (sqlc.arg(cursor_rank)::FLOAT8, sqlc.arg(cursor_id)::TEXT COLLATE "C")
+ORDER BY rank DESC, id COLLATE "C" DESC
+LIMIT sqlc.arg(page_size)::INTEGER;
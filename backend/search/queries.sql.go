@@ -0,0 +1,115 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: queries.sql
+
+package search
+
+import (
+	"context"
+)
+
+const search = `-- name: Search :many
+WITH q AS (
+	SELECT websearch_to_tsquery('simple', $5::TEXT) AS tsq
+), r AS (
+	SELECT 'post'::VARCHAR(100) AS result_type, p.id, ts_rank(to_tsvector('simple', CASE
+		WHEN can_access_post($6::VARCHAR(100), p) THEN p.message
+		ELSE post_preview(p.message)
+	END), q.tsq)::FLOAT8 AS rank
+	FROM posts p, q
+	WHERE $7::BOOLEAN AND to_tsvector('simple', p.message) @@ q.tsq AND
+	-- Locked posts only match the preview shown to the viewer
+	(can_access_post($6::VARCHAR(100), p) OR to_tsvector('simple', post_preview(p.message)) @@ q.tsq) AND
+	p.deleted_at IS NULL AND
+	($8::VARCHAR(100) = '' OR p.category = $8::VARCHAR(100)) AND
+	NOT EXISTS (
+		SELECT 1 FROM blocks b
+		WHERE (b.user_id = $6::VARCHAR(100) AND b.blocked_user_id = p.author) OR
+		(b.user_id = p.author AND b.blocked_user_id = $6::VARCHAR(100))
+	) AND
+	can_view_post($6::VARCHAR(100), p)
+	UNION ALL
+	SELECT 'comment'::VARCHAR(100), c.id, ts_rank(to_tsvector('simple', c.message), q.tsq)::FLOAT8
+	FROM comments c JOIN posts p ON p.id = c.post_id, q
+	WHERE $9::BOOLEAN AND to_tsvector('simple', c.message) @@ q.tsq AND
+	c.deleted_at IS NULL AND p.deleted_at IS NULL AND
+	($8::VARCHAR(100) = '' OR p.category = $8::VARCHAR(100)) AND
+	NOT EXISTS (
+		SELECT 1 FROM blocks b
+		WHERE (b.user_id = $6::VARCHAR(100) AND b.blocked_user_id IN (c.author, p.author)) OR
+		(b.user_id IN (c.author, p.author) AND b.blocked_user_id = $6::VARCHAR(100))
+	) AND
+	can_view_post($6::VARCHAR(100), p)
+	UNION ALL
+	SELECT 'user'::VARCHAR(100), u.id, GREATEST(
+		similarity(u.username, $5::TEXT),
+		similarity(COALESCE(u.given_name, '') || ' ' || COALESCE(u.family_name, ''), $5::TEXT)
+	)::FLOAT8
+	FROM users u
+	WHERE $10::BOOLEAN AND
+	(u.username % $5::TEXT OR
+	(COALESCE(u.given_name, '') || ' ' || COALESCE(u.family_name, '')) % $5::TEXT OR
+	starts_with(LOWER(u.username), LOWER($5::TEXT))) AND
+	NOT EXISTS (
+		SELECT 1 FROM blocks b
+		WHERE (b.user_id = $6::VARCHAR(100) AND b.blocked_user_id = u.id) OR
+		(b.user_id = u.id AND b.blocked_user_id = $6::VARCHAR(100))
+	)
+)
+SELECT result_type, id, rank FROM r
+WHERE NOT $1::BOOLEAN OR (rank, id COLLATE "C") < ($2::FLOAT8, $3::TEXT COLLATE "C")
+ORDER BY rank DESC, id COLLATE "C" DESC
+LIMIT $4::INTEGER
+`
+
+type SearchParams struct {
+	HasCursor       bool
+	CursorRank      float64
+	CursorID        string
+	PageSize        int32
+	Query           string
+	ViewerID        string
+	IncludePosts    bool
+	Category        string
+	IncludeComments bool
+	IncludeUsers    bool
+}
+
+type SearchRow struct {
+	ResultType string
+	ID         string
+	Rank       float64
+}
+
+func (q *Queries) Search(ctx context.Context, arg SearchParams) ([]SearchRow, error) {
+	rows, err := q.db.QueryContext(ctx, search,
+		arg.HasCursor,
+		arg.CursorRank,
+		arg.CursorID,
+		arg.PageSize,
+		arg.Query,
+		arg.ViewerID,
+		arg.IncludePosts,
+		arg.Category,
+		arg.IncludeComments,
+		arg.IncludeUsers,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchRow
+	for rows.Next() {
+		var i SearchRow
+		if err := rows.Scan(&i.ResultType, &i.ID, &i.Rank); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
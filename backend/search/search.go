@@ -0,0 +1,64 @@
+package search
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	v1API "github.com/DagDigg/unpaper/backend/pkg/api/v1"
+)
+
+// MaxQueryLength is the maximum length, in bytes, of a search query
+const MaxQueryLength = 200
+
+// ResultType refers to the kind of entity matched by a search
+type ResultType string
+
+const (
+	// ResultTypePost matches post messages
+	ResultTypePost ResultType = "post"
+	// ResultTypeComment matches comment messages
+	ResultTypeComment ResultType = "comment"
+	// ResultTypeUser matches usernames and display names
+	ResultTypeUser ResultType = "user"
+)
+
+// Directory runs full-text searches across the 'posts', 'comments' and 'users' tables
+type Directory struct {
+	// querier is an interface containing all of the
+	// directory methods. Must be created with search.NewDirectory(db)
+	querier Querier
+	db      *sql.DB
+}
+
+// NewDirectory creates a new search directory
+func NewDirectory(db *sql.DB) *Directory {
+	return &Directory{db: db, querier: New(db)}
+}
+
+// Close closes Directory database connection
+func (d Directory) Close() error {
+	return d.db.Close()
+}
+
+// Search returns a page of the entities matching the query, from the most relevant.
+// Posts and comments are ranked with full-text search, users by trigram similarity of their names.
+// Results hidden from the viewer, or written by users blocked in either direction, are left out.
+// Supporters only posts the viewer cannot access only match their preview
+func (d Directory) Search(ctx context.Context, params SearchParams) ([]SearchRow, error) {
+	return d.querier.Search(ctx, params)
+}
+
+// PGResultTypeToPB converts a postgres search result type to protobuf
+func PGResultTypeToPB(t ResultType) (v1API.SearchResultType_Enum, error) {
+	switch t {
+	case ResultTypePost:
+		return v1API.SearchResultType_POST, nil
+	case ResultTypeComment:
+		return v1API.SearchResultType_COMMENT, nil
+	case ResultTypeUser:
+		return v1API.SearchResultType_USER, nil
+	default:
+		return 0, fmt.Errorf("invalid search result type: %q", t)
+	}
+}
@@ -0,0 +1,16 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package threads
+
+import (
+	"context"
+)
+
+type Querier interface {
+	GetThreadSubscribers(ctx context.Context, postID string) ([]string, error)
+	IsSubscribedToThread(ctx context.Context, arg IsSubscribedToThreadParams) (bool, error)
+	SubscribeToThread(ctx context.Context, arg SubscribeToThreadParams) error
+	UnsubscribeFromThread(ctx context.Context, arg UnsubscribeFromThreadParams) error
+}
+
+var _ Querier = (*Queries)(nil)
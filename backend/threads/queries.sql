@@ -0,0 +1,16 @@
+-- name: SubscribeToThread :exec
+INSERT INTO thread_subscriptions (post_id, user_id, created_at)
+VALUES (sqlc.arg(post_id)::VARCHAR(100), sqlc.arg(user_id)::VARCHAR(100), sqlc.arg(created_at)::TIMESTAMPTZ)
+ON CONFLICT (post_id, user_id) DO NOTHING;
+
+-- name: UnsubscribeFromThread :exec
+DELETE FROM thread_subscriptions
+WHERE post_id = $1 AND user_id = $2;
+
+-- name: IsSubscribedToThread :one
+SELECT EXISTS(SELECT 1 FROM thread_subscriptions WHERE post_id = $1 AND user_id = $2);
+
+-- name: GetThreadSubscribers :many
+SELECT user_id FROM thread_subscriptions
+WHERE post_id = $1
+ORDER BY created_at, user_id;
@@ -0,0 +1,86 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: queries.sql
+
+package threads
+
+import (
+	"context"
+	"time"
+)
+
+const getThreadSubscribers = `-- name: GetThreadSubscribers :many
+SELECT user_id FROM thread_subscriptions
+WHERE post_id = $1
+ORDER BY created_at, user_id
+`
+
+func (q *Queries) GetThreadSubscribers(ctx context.Context, postID string) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getThreadSubscribers, postID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var user_id string
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const isSubscribedToThread = `-- name: IsSubscribedToThread :one
+SELECT EXISTS(SELECT 1 FROM thread_subscriptions WHERE post_id = $1 AND user_id = $2)
+`
+
+type IsSubscribedToThreadParams struct {
+	PostID string
+	UserID string
+}
+
+func (q *Queries) IsSubscribedToThread(ctx context.Context, arg IsSubscribedToThreadParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isSubscribedToThread, arg.PostID, arg.UserID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const subscribeToThread = `-- name: SubscribeToThread :exec
+INSERT INTO thread_subscriptions (post_id, user_id, created_at)
+VALUES ($1::VARCHAR(100), $2::VARCHAR(100), $3::TIMESTAMPTZ)
+ON CONFLICT (post_id, user_id) DO NOTHING
+`
+
+type SubscribeToThreadParams struct {
+	PostID    string
+	UserID    string
+	CreatedAt time.Time
+}
+
+func (q *Queries) SubscribeToThread(ctx context.Context, arg SubscribeToThreadParams) error {
+	_, err := q.db.ExecContext(ctx, subscribeToThread, arg.PostID, arg.UserID, arg.CreatedAt)
+	return err
+}
+
+const unsubscribeFromThread = `-- name: UnsubscribeFromThread :exec
+DELETE FROM thread_subscriptions
+WHERE post_id = $1 AND user_id = $2
+`
+
+type UnsubscribeFromThreadParams struct {
+	PostID string
+	UserID string
+}
+
+func (q *Queries) UnsubscribeFromThread(ctx context.Context, arg UnsubscribeFromThreadParams) error {
+	_, err := q.db.ExecContext(ctx, unsubscribeFromThread, arg.PostID, arg.UserID)
+	return err
+}
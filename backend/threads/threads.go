@@ -0,0 +1,56 @@
+package threads
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Directory is the directory which operates on db table 'thread_subscriptions'
+type Directory struct {
+	// querier is an interface containing all of the
+	// directory methods. Must be created with threads.NewDirectory(db)
+	querier Querier
+	db      *sql.DB
+}
+
+// NewDirectory creates a new threads directory
+func NewDirectory(db *sql.DB) *Directory {
+	return &Directory{db: db, querier: New(db)}
+}
+
+// Close closes Directory database connection
+func (d Directory) Close() error {
+	return d.db.Close()
+}
+
+// SubscribeToThread subscribes the user to the segments added by the author to the post thread.
+// Subscribing twice is a no-op
+func (d Directory) SubscribeToThread(ctx context.Context, params SubscribeToThreadParams) error {
+	if params.CreatedAt.IsZero() {
+		params.CreatedAt = time.Now().UTC()
+	}
+
+	return d.querier.SubscribeToThread(ctx, params)
+}
+
+// UnsubscribeFromThread removes the user subscription to the post thread. Removing a missing subscription is a no-op
+func (d Directory) UnsubscribeFromThread(ctx context.Context, postID, userID string) error {
+	return d.querier.UnsubscribeFromThread(ctx, UnsubscribeFromThreadParams{
+		PostID: postID,
+		UserID: userID,
+	})
+}
+
+// IsSubscribedToThread returns whether the user is subscribed to the post thread
+func (d Directory) IsSubscribedToThread(ctx context.Context, postID, userID string) (bool, error) {
+	return d.querier.IsSubscribedToThread(ctx, IsSubscribedToThreadParams{
+		PostID: postID,
+		UserID: userID,
+	})
+}
+
+// GetThreadSubscribers returns the ids of the users subscribed to the post thread, from the first subscribed
+func (d Directory) GetThreadSubscribers(ctx context.Context, postID string) ([]string, error) {
+	return d.querier.GetThreadSubscribers(ctx, postID)
+}
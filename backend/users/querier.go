@@ -14,10 +14,15 @@ type Querier interface {
 	GetEmail(ctx context.Context, id string) (string, error)
 	GetEmailVerified(ctx context.Context, id string) (sql.NullBool, error)
 	GetPassword(ctx context.Context, id string) (sql.NullString, error)
+	GetSuspendedUntil(ctx context.Context, id string) (sql.NullTime, error)
 	GetUser(ctx context.Context, id string) (User, error)
 	GetUserByUsername(ctx context.Context, username sql.NullString) (User, error)
 	GetUserIDFromEmail(ctx context.Context, email string) (string, error)
 	GetUserSuggestions(ctx context.Context, lower string) ([]User, error)
+	GetUsersByIDs(ctx context.Context, ids []string) ([]User, error)
+	IsModerator(ctx context.Context, id string) (bool, error)
+	SuspendUser(ctx context.Context, arg SuspendUserParams) error
+	UpdateMessageRequestsPolicy(ctx context.Context, arg UpdateMessageRequestsPolicyParams) (User, error)
 	UpdatePassword(ctx context.Context, arg UpdatePasswordParams) error
 	UpdatePasswordChangedAt(ctx context.Context, arg UpdatePasswordChangedAtParams) error
 	UpdateUsername(ctx context.Context, arg UpdateUsernameParams) (User, error)
@@ -65,6 +65,10 @@ FROM users
 WHERE (LOWER(username)) LIKE (LOWER($1))
 LIMIT 4;
 
+-- name: GetUsersByIDs :many
+SELECT * FROM users
+WHERE id = ANY(sqlc.arg(ids)::VARCHAR(100)[]);
+
 -- name: UserIDExists :one
 SELECT EXISTS(SELECT 1 FROM users WHERE id = $1);
 
@@ -72,4 +76,26 @@ SELECT EXISTS(SELECT 1 FROM users WHERE id = $1);
 SELECT EXISTS(SELECT 1 FROM users WHERE (LOWER(email)) = $1);
 
 -- name: UsernameExists :one
-SELECT EXISTS(SELECT 1 FROM users WHERE (LOWER(username)) = $1);
\ No newline at end of file
+SELECT EXISTS(SELECT 1 FROM users WHERE (LOWER(username)) = $1);
+
+-- name: UpdateMessageRequestsPolicy :one
+UPDATE users
+SET message_requests_policy = $1
+WHERE id = $2
+RETURNING *;
+
+-- name: IsModerator :one
+SELECT is_moderator
+FROM users
+WHERE id = $1;
+
+-- name: SuspendUser :exec
+-- An ongoing longer suspension is kept
+UPDATE users
+SET suspended_until = GREATEST(COALESCE(suspended_until, sqlc.arg(suspended_until)::TIMESTAMPTZ), sqlc.arg(suspended_until)::TIMESTAMPTZ)
+WHERE id = sqlc.arg(id)::VARCHAR(100);
+
+-- name: GetSuspendedUntil :one
+SELECT suspended_until
+FROM users
+WHERE id = $1;
@@ -6,12 +6,15 @@ package users
 import (
 	"context"
 	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
 )
 
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (id, given_name, family_name, email, password, username)
 VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING email_verified, password_changed_at, email, password, id, family_name, type, given_name, username
+RETURNING email_verified, password_changed_at, email, password, id, family_name, type, given_name, username, message_requests_policy, is_moderator, suspended_until
 `
 
 type CreateUserParams struct {
@@ -43,6 +46,9 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.Type,
 		&i.GivenName,
 		&i.Username,
+		&i.MessageRequestsPolicy,
+		&i.IsModerator,
+		&i.SuspendedUntil,
 	)
 	return i, err
 }
@@ -108,8 +114,21 @@ func (q *Queries) GetPassword(ctx context.Context, id string) (sql.NullString, e
 	return password, err
 }
 
+const getSuspendedUntil = `-- name: GetSuspendedUntil :one
+SELECT suspended_until
+FROM users
+WHERE id = $1
+`
+
+func (q *Queries) GetSuspendedUntil(ctx context.Context, id string) (sql.NullTime, error) {
+	row := q.db.QueryRowContext(ctx, getSuspendedUntil, id)
+	var suspended_until sql.NullTime
+	err := row.Scan(&suspended_until)
+	return suspended_until, err
+}
+
 const getUser = `-- name: GetUser :one
-SELECT email_verified, password_changed_at, email, password, id, family_name, type, given_name, username 
+SELECT email_verified, password_changed_at, email, password, id, family_name, type, given_name, username, message_requests_policy, is_moderator, suspended_until 
 FROM users
 WHERE id = $1
 `
@@ -127,12 +146,15 @@ func (q *Queries) GetUser(ctx context.Context, id string) (User, error) {
 		&i.Type,
 		&i.GivenName,
 		&i.Username,
+		&i.MessageRequestsPolicy,
+		&i.IsModerator,
+		&i.SuspendedUntil,
 	)
 	return i, err
 }
 
 const getUserByUsername = `-- name: GetUserByUsername :one
-SELECT email_verified, password_changed_at, email, password, id, family_name, type, given_name, username 
+SELECT email_verified, password_changed_at, email, password, id, family_name, type, given_name, username, message_requests_policy, is_moderator, suspended_until 
 FROM users
 WHERE username = $1
 `
@@ -150,6 +172,9 @@ func (q *Queries) GetUserByUsername(ctx context.Context, username sql.NullString
 		&i.Type,
 		&i.GivenName,
 		&i.Username,
+		&i.MessageRequestsPolicy,
+		&i.IsModerator,
+		&i.SuspendedUntil,
 	)
 	return i, err
 }
@@ -168,7 +193,7 @@ func (q *Queries) GetUserIDFromEmail(ctx context.Context, email string) (string,
 }
 
 const getUserSuggestions = `-- name: GetUserSuggestions :many
-SELECT email_verified, password_changed_at, email, password, id, family_name, type, given_name, username
+SELECT email_verified, password_changed_at, email, password, id, family_name, type, given_name, username, message_requests_policy, is_moderator, suspended_until
 FROM users
 WHERE (LOWER(username)) LIKE (LOWER($1))
 LIMIT 4
@@ -193,6 +218,9 @@ func (q *Queries) GetUserSuggestions(ctx context.Context, lower string) ([]User,
 			&i.Type,
 			&i.GivenName,
 			&i.Username,
+			&i.MessageRequestsPolicy,
+			&i.IsModerator,
+			&i.SuspendedUntil,
 		); err != nil {
 			return nil, err
 		}
@@ -207,6 +235,109 @@ func (q *Queries) GetUserSuggestions(ctx context.Context, lower string) ([]User,
 	return items, nil
 }
 
+const getUsersByIDs = `-- name: GetUsersByIDs :many
+SELECT email_verified, password_changed_at, email, password, id, family_name, type, given_name, username, message_requests_policy, is_moderator, suspended_until FROM users
+WHERE id = ANY($1::VARCHAR(100)[])
+`
+
+func (q *Queries) GetUsersByIDs(ctx context.Context, ids []string) ([]User, error) {
+	rows, err := q.db.QueryContext(ctx, getUsersByIDs, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.EmailVerified,
+			&i.PasswordChangedAt,
+			&i.Email,
+			&i.Password,
+			&i.ID,
+			&i.FamilyName,
+			&i.Type,
+			&i.GivenName,
+			&i.Username,
+			&i.MessageRequestsPolicy,
+			&i.IsModerator,
+			&i.SuspendedUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const isModerator = `-- name: IsModerator :one
+SELECT is_moderator
+FROM users
+WHERE id = $1
+`
+
+func (q *Queries) IsModerator(ctx context.Context, id string) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isModerator, id)
+	var is_moderator bool
+	err := row.Scan(&is_moderator)
+	return is_moderator, err
+}
+
+const suspendUser = `-- name: SuspendUser :exec
+UPDATE users
+SET suspended_until = GREATEST(COALESCE(suspended_until, $1::TIMESTAMPTZ), $1::TIMESTAMPTZ)
+WHERE id = $2::VARCHAR(100)
+`
+
+type SuspendUserParams struct {
+	SuspendedUntil time.Time
+	ID             string
+}
+
+// An ongoing longer suspension is kept
+func (q *Queries) SuspendUser(ctx context.Context, arg SuspendUserParams) error {
+	_, err := q.db.ExecContext(ctx, suspendUser, arg.SuspendedUntil, arg.ID)
+	return err
+}
+
+const updateMessageRequestsPolicy = `-- name: UpdateMessageRequestsPolicy :one
+UPDATE users
+SET message_requests_policy = $1
+WHERE id = $2
+RETURNING email_verified, password_changed_at, email, password, id, family_name, type, given_name, username, message_requests_policy, is_moderator, suspended_until
+`
+
+type UpdateMessageRequestsPolicyParams struct {
+	MessageRequestsPolicy string
+	ID                    string
+}
+
+func (q *Queries) UpdateMessageRequestsPolicy(ctx context.Context, arg UpdateMessageRequestsPolicyParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, updateMessageRequestsPolicy, arg.MessageRequestsPolicy, arg.ID)
+	var i User
+	err := row.Scan(
+		&i.EmailVerified,
+		&i.PasswordChangedAt,
+		&i.Email,
+		&i.Password,
+		&i.ID,
+		&i.FamilyName,
+		&i.Type,
+		&i.GivenName,
+		&i.Username,
+		&i.MessageRequestsPolicy,
+		&i.IsModerator,
+		&i.SuspendedUntil,
+	)
+	return i, err
+}
+
 const updatePassword = `-- name: UpdatePassword :exec
 UPDATE users
 SET password = $2
@@ -243,7 +374,7 @@ const updateUsername = `-- name: UpdateUsername :one
 UPDATE users
 SET username = $1
 WHERE id = $2
-RETURNING email_verified, password_changed_at, email, password, id, family_name, type, given_name, username
+RETURNING email_verified, password_changed_at, email, password, id, family_name, type, given_name, username, message_requests_policy, is_moderator, suspended_until
 `
 
 type UpdateUsernameParams struct {
@@ -264,6 +395,9 @@ func (q *Queries) UpdateUsername(ctx context.Context, arg UpdateUsernameParams)
 		&i.Type,
 		&i.GivenName,
 		&i.Username,
+		&i.MessageRequestsPolicy,
+		&i.IsModerator,
+		&i.SuspendedUntil,
 	)
 	return i, err
 }
@@ -43,6 +43,18 @@ const (
 	UserTypeCreator UserType = "creator"
 )
 
+// MessageRequestsPolicy denotes which new conversations are routed to the user's message requests
+type MessageRequestsPolicy string
+
+const (
+	// MessageRequestsPolicyFollowedOnly routes conversations started by users not followed to the requests
+	MessageRequestsPolicyFollowedOnly MessageRequestsPolicy = "followed_only"
+	// MessageRequestsPolicyEveryone lets anyone start a conversation directly
+	MessageRequestsPolicyEveryone MessageRequestsPolicy = "everyone"
+	// MessageRequestsPolicyNobody routes every new conversation to the requests
+	MessageRequestsPolicyNobody MessageRequestsPolicy = "nobody"
+)
+
 // CreateUser creates an user on db and returns its userID. Currently used only for testing
 func (d Directory) CreateUser(ctx context.Context, user CreateUserParams) (*v1API.User, error) {
 	pgUser, err := d.querier.CreateUser(ctx, user)
@@ -70,6 +82,30 @@ func (d Directory) GetUserByUsername(ctx context.Context, username string) (*v1A
 	return userPostgresToProto(pgUser)
 }
 
+// IsModerator returns whether the user can inspect and moderate other users content
+func (d Directory) IsModerator(ctx context.Context, userID string) (bool, error) {
+	return d.querier.IsModerator(ctx, userID)
+}
+
+// SuspendUser prevents the user from publishing content until the passed time.
+// An ongoing longer suspension is kept
+func (d Directory) SuspendUser(ctx context.Context, userID string, until time.Time) error {
+	return d.querier.SuspendUser(ctx, SuspendUserParams{
+		ID:             userID,
+		SuspendedUntil: until,
+	})
+}
+
+// GetSuspendedUntil returns the end of the user suspension. A zero time means that the user has never been suspended
+func (d Directory) GetSuspendedUntil(ctx context.Context, userID string) (time.Time, error) {
+	res, err := d.querier.GetSuspendedUntil(ctx, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return res.Time, nil
+}
+
 // DeleteUser deletes an user by userID
 func (d Directory) DeleteUser(ctx context.Context, userID string) error {
 	ok, err := d.querier.UserIDExists(ctx, userID)
@@ -178,6 +214,24 @@ func (d Directory) UpdateUsername(ctx context.Context, params *UpdateUsernamePar
 	return userPostgresToProto(res)
 }
 
+// UpdateMessageRequestsPolicy updates the message_requests_policy column
+func (d Directory) UpdateMessageRequestsPolicy(ctx context.Context, userID string, policy v1API.MessageRequestsPolicy_Enum) (*v1API.User, error) {
+	pgPolicy, err := pbMessageRequestsPolicyToPG(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := d.querier.UpdateMessageRequestsPolicy(ctx, UpdateMessageRequestsPolicyParams{
+		ID:                    userID,
+		MessageRequestsPolicy: string(pgPolicy),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return userPostgresToProto(res)
+}
+
 // GetUserSuggestions returns a list of username suggestions given a LIKE input
 func (d Directory) GetUserSuggestions(ctx context.Context, query string) ([]*v1API.UserSuggestion, error) {
 	res, err := d.querier.GetUserSuggestions(ctx, query+"%")
@@ -197,11 +251,33 @@ func (d Directory) GetUserSuggestions(ctx context.Context, query string) ([]*v1A
 	return users, nil
 }
 
+// GetUserSuggestionsByIDs returns the username suggestions of the users with the passed ids, keyed by id
+func (d Directory) GetUserSuggestionsByIDs(ctx context.Context, ids []string) (map[string]*v1API.UserSuggestion, error) {
+	res, err := d.querier.GetUsersByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	users := map[string]*v1API.UserSuggestion{}
+	for _, v := range res {
+		users[v.ID] = &v1API.UserSuggestion{
+			Id:       v.ID,
+			Username: v.Username.String,
+		}
+	}
+
+	return users, nil
+}
+
 func userPostgresToProto(u User) (*v1API.User, error) {
 	userType, err := pgUserTypeToProto(UserType(u.Type))
 	if err != nil {
 		return nil, err
 	}
+	policy, err := pgMessageRequestsPolicyToProto(MessageRequestsPolicy(u.MessageRequestsPolicy))
+	if err != nil {
+		return nil, err
+	}
 	return &v1API.User{
 		Api:           "v1",
 		Id:            u.ID,
@@ -211,6 +287,8 @@ func userPostgresToProto(u User) (*v1API.User, error) {
 		EmailVerified: u.EmailVerified.Bool,
 		Type:          userType,
 		Username:      u.Username.String,
+
+		MessageRequestsPolicy: policy,
 	}, nil
 }
 
@@ -224,3 +302,29 @@ func pgUserTypeToProto(t UserType) (v1API.UserType, error) {
 		return 0, fmt.Errorf("unexpected userType: '%v'", t)
 	}
 }
+
+func pgMessageRequestsPolicyToProto(p MessageRequestsPolicy) (v1API.MessageRequestsPolicy_Enum, error) {
+	switch p {
+	case MessageRequestsPolicyFollowedOnly:
+		return v1API.MessageRequestsPolicy_FOLLOWED_ONLY, nil
+	case MessageRequestsPolicyEveryone:
+		return v1API.MessageRequestsPolicy_EVERYONE, nil
+	case MessageRequestsPolicyNobody:
+		return v1API.MessageRequestsPolicy_NOBODY, nil
+	default:
+		return 0, fmt.Errorf("unexpected message requests policy: '%v'", p)
+	}
+}
+
+func pbMessageRequestsPolicyToPG(p v1API.MessageRequestsPolicy_Enum) (MessageRequestsPolicy, error) {
+	switch p {
+	case v1API.MessageRequestsPolicy_FOLLOWED_ONLY:
+		return MessageRequestsPolicyFollowedOnly, nil
+	case v1API.MessageRequestsPolicy_EVERYONE:
+		return MessageRequestsPolicyEveryone, nil
+	case v1API.MessageRequestsPolicy_NOBODY:
+		return MessageRequestsPolicyNobody, nil
+	default:
+		return "", fmt.Errorf("unexpected message requests policy: '%v'", p)
+	}
+}
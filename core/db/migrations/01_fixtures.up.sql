@@ -1,15 +1,33 @@
 /* Auto generated file. Do not edit by hand. This file was generated by SchemaHero. */
 
- create table "users" ("email_verified" boolean null default 'false', "password_changed_at" timestamp with time zone null, "email" character varying (100) not null, "password" character varying (100) null, "id" character varying (100) not null, "family_name" character varying (100) null, "type" character varying (100) not null default 'member', "given_name" character varying (100) null, "username" character varying (100) null, primary key ("id"), constraint "idx_users_username" unique ("username"), constraint "idx_users_email" unique ("email"));
-create table "comments" ("likes" integer null default '0', "audio" json not null, "author" character varying (100) not null, "parent_id" character varying (100) null, "post_id" character varying (100) not null, "thread_type" character varying (100) not null default 'none', "id" character varying (100) not null, "thread_target_id" character varying (100) null, "message" character varying (100) null, "user_ids_who_likes" character varying (100)[], primary key ("id"), constraint comments_parent_id_fkey foreign key (parent_id) references comments (id) on delete NO ACTION);
+ create table "users" ("email_verified" boolean null default 'false', "password_changed_at" timestamp with time zone null, "email" character varying (100) not null, "password" character varying (100) null, "id" character varying (100) not null, "family_name" character varying (100) null, "type" character varying (100) not null default 'member', "given_name" character varying (100) null, "username" character varying (100) null, "message_requests_policy" character varying (100) not null default 'followed_only', "is_moderator" boolean not null default 'false', "suspended_until" timestamp with time zone null, primary key ("id"), constraint "idx_users_username" unique ("username"), constraint "idx_users_email" unique ("email"));
+create table "blocks" ("user_id" character varying (100) not null, "blocked_user_id" character varying (100) not null, "created_at" timestamp with time zone not null, primary key ("user_id", "blocked_user_id"), constraint blocks_user_id_fkey foreign key (user_id) references users (id) on delete CASCADE, constraint blocks_blocked_user_id_fkey foreign key (blocked_user_id) references users (id) on delete CASCADE);
+create table "comments" ("likes" integer null default '0', "audio" json not null, "author" character varying (100) not null, "parent_id" character varying (100) null, "post_id" character varying (100) not null, "thread_type" character varying (100) not null default 'none', "id" character varying (100) not null, "thread_target_id" character varying (100) null, "message" character varying (100) null, "user_ids_who_likes" character varying (100)[], "mentions" json not null default '[]', "created_at" timestamp with time zone not null default now(), "edited_at" timestamp with time zone null, "deleted_at" timestamp with time zone null, "views" integer not null default '0', "replies_count" integer not null default '0', "top_score" double precision not null default '0', "hot_score" double precision not null default '0', "controversy_score" double precision not null default '0', primary key ("id"), constraint comments_parent_id_fkey foreign key (parent_id) references comments (id) on delete NO ACTION);
+create table "comment_likes" ("comment_id" character varying (100) not null, "user_id" character varying (100) not null, "created_at" timestamp with time zone not null, primary key ("comment_id", "user_id"), constraint comment_likes_comment_id_fkey foreign key (comment_id) references comments (id) on delete CASCADE);
+create table "comment_views" ("comment_id" character varying (100) not null, "user_id" character varying (100) not null, "created_at" timestamp with time zone not null, primary key ("comment_id", "user_id"), constraint comment_views_comment_id_fkey foreign key (comment_id) references comments (id) on delete CASCADE);
 create table "connected_accounts" ("can_receive_payments" boolean not null default 'false', "user_id" character varying (100) not null, "customer_id" character varying (100) not null, "account_id" character varying (100) not null, primary key ("account_id"), constraint "idx_connected_accounts_user_id" unique ("user_id"));
 create table "connected_customers" ("user_id" character varying (100) not null, "customer_id" character varying (100) not null, "connected_customer_id" character varying (100) not null, "account_id" character varying (100) not null, primary key ("user_id"));
 create table "customers" ("trial_used" boolean null default 'false', "id" character varying (100) not null, "customer_id" character varying (100) not null, "first_name" character varying (100) not null, "last_name" character varying (100) not null, "account_id" character varying (100) null, primary key ("id"), constraint "idx_customers_customer_id" unique ("customer_id"));
+create table "edits" ("id" character varying (100) not null, "target_type" character varying (100) not null, "target_id" character varying (100) not null, "editor_id" character varying (100) not null, "action" character varying (100) not null, "previous_message" text null, "created_at" timestamp with time zone not null, primary key ("id"));
 create table "follows" ("follower_user_id" character varying (100), "following_user_id" character varying (100), "follow_date" timestamp with time zone not null, "unfollow_date" timestamp with time zone, primary key ("follower_user_id", "following_user_id"), constraint users_follower_fkey foreign key (follower_user_id) references users (id) on delete NO ACTION, constraint users_following_fkey foreign key (following_user_id) references users (id) on delete NO ACTION);
 create table "lists" ("allowed_users" json null, "id" text not null, "name" character varying (100) not null, "owner_user_id" text not null, primary key ("id"));
 create table "mixes" ("id" character varying (100) not null, "user_id" character varying (100) not null, "category" character varying (100) not null, "post_ids" character varying (100)[] not null default '{}', "background" json not null, "requested_at" timestamp with time zone not null, "title" character varying (100) not null, primary key ("id"));
 create table "notifications" ("id" character varying (100) not null, "user_id_to_notify" character varying (100) not null, "user_id_who_fired_event" character varying (100) not null, "date" timestamp with time zone not null, "read" boolean not null default 'false', "trigger_id" character varying (100), "event_id" character varying (100) not null, "content" character varying (100), primary key ("id"));
-create table "posts" ("likes" integer null default '0', "audio" json not null, "id" character varying (100) not null, "author" character varying (100) not null, "message" character varying (100) not null, "user_ids_who_likes" character varying (100)[] not null default '{}', "created_at" timestamp with time zone, primary key ("id"));
+create table "posts" ("likes" integer null default '0', "audio" json not null, "id" character varying (100) not null, "author" character varying (100) not null, "message" character varying (100) not null, "user_ids_who_likes" character varying (100)[] not null default '{}', "created_at" timestamp with time zone not null, "mentions" json not null default '[]', "category" character varying (100) not null default 'other', "tags" character varying (100)[] not null default '{}', "edited_at" timestamp with time zone null, "deleted_at" timestamp with time zone null, "quoted_post_id" character varying (100) null, "visibility" character varying (100) not null default 'public', "visibility_list_ids" character varying (100)[] not null default '{}', "status" character varying (100) not null default 'published', "publish_at" timestamp with time zone null, "plays" integer not null default '0', "supporters_room_id" character varying (100) null, "supporters_purchase_type" character varying (100) null, primary key ("id"));
+create table "post_likes" ("post_id" character varying (100) not null, "user_id" character varying (100) not null, "created_at" timestamp with time zone not null, primary key ("post_id", "user_id"), constraint post_likes_post_id_fkey foreign key (post_id) references posts (id) on delete CASCADE);
+create table "bookmarks" ("user_id" character varying (100) not null, "post_id" character varying (100) not null, "collection" character varying (100) not null, "created_at" timestamp with time zone not null, primary key ("user_id", "post_id"), constraint bookmarks_post_id_fkey foreign key (post_id) references posts (id) on delete CASCADE);
+create table "reposts" ("post_id" character varying (100) not null, "user_id" character varying (100) not null, "created_at" timestamp with time zone not null, primary key ("post_id", "user_id"), constraint reposts_post_id_fkey foreign key (post_id) references posts (id) on delete CASCADE);
+create table "hashtags" ("name" character varying (100) not null, "created_at" timestamp with time zone not null, primary key ("name"));
+create table "hashtag_uses" ("hashtag" character varying (100) not null, "post_id" character varying (100) not null, "source_id" character varying (100) not null, "created_at" timestamp with time zone not null, primary key ("hashtag", "source_id"), constraint hashtag_uses_hashtag_fkey foreign key (hashtag) references hashtags (name) on delete CASCADE, constraint hashtag_uses_post_id_fkey foreign key (post_id) references posts (id) on delete CASCADE);
+create table "playbacks" ("id" character varying (100) not null, "post_id" character varying (100) not null, "user_id" character varying (100) not null, "listened_ms" integer not null, "completed" boolean not null, "counted" boolean not null, "created_at" timestamp with time zone not null, primary key ("id"), constraint playbacks_post_id_fkey foreign key (post_id) references posts (id) on delete CASCADE);
+create table "reports" ("id" character varying (100) not null, "target_type" character varying (100) not null, "target_id" character varying (100) not null, "conversation_id" character varying (100) null, "target_author_id" character varying (100) not null, "content" text not null, "status" character varying (100) not null default 'open', "claimed_by" character varying (100) null, "claimed_at" timestamp with time zone null, "resolution" character varying (100) null, "resolved_by" character varying (100) null, "resolved_at" timestamp with time zone null, "opened_at" timestamp with time zone not null, "last_reported_at" timestamp with time zone not null, primary key ("id"), constraint "reports_target_idx" unique ("target_type", "target_id"));
+create table "report_entries" ("report_id" character varying (100) not null, "reporter_id" character varying (100) not null, "reason" character varying (100) not null, "details" text not null, "created_at" timestamp with time zone not null, primary key ("report_id", "reporter_id"), constraint report_entries_report_id_fkey foreign key (report_id) references reports (id) on delete CASCADE);
+create table "moderation_actions" ("id" character varying (100) not null, "report_id" character varying (100) not null, "moderator_id" character varying (100) not null, "action" character varying (100) not null, "target_type" character varying (100) not null, "target_id" character varying (100) not null, "target_author_id" character varying (100) not null, "note" text not null, "created_at" timestamp with time zone not null, primary key ("id"), constraint moderation_actions_report_id_fkey foreign key (report_id) references reports (id) on delete CASCADE);
+create table "polls" ("post_id" character varying (100) not null, "multiple_choice" boolean not null default 'false', "closes_at" timestamp with time zone not null, "results_notified_at" timestamp with time zone null, "created_at" timestamp with time zone not null, primary key ("post_id"), constraint polls_post_id_fkey foreign key (post_id) references posts (id) on delete CASCADE);
+create table "poll_options" ("post_id" character varying (100) not null, "position" integer not null, "text" character varying (100) not null, primary key ("post_id", "position"), constraint poll_options_post_id_fkey foreign key (post_id) references polls (post_id) on delete CASCADE);
+create table "poll_votes" ("post_id" character varying (100) not null, "user_id" character varying (100) not null, "options" integer[] not null, "created_at" timestamp with time zone not null, primary key ("post_id", "user_id"), constraint poll_votes_post_id_fkey foreign key (post_id) references polls (post_id) on delete CASCADE);
+create table "trending_posts" ("generation" timestamp with time zone not null, "post_id" character varying (100) not null, "category" character varying (100) not null, "score" double precision not null, primary key ("generation", "post_id"), constraint trending_posts_post_id_fkey foreign key (post_id) references posts (id) on delete CASCADE);
+create table "thread_subscriptions" ("post_id" character varying (100) not null, "user_id" character varying (100) not null, "created_at" timestamp with time zone not null, primary key ("post_id", "user_id"), constraint thread_subscriptions_post_id_fkey foreign key (post_id) references posts (id) on delete CASCADE);
 create table "room_subscriptions" ("latest_invoice" jsonb null, "current_period_end" timestamp with time zone null, "customer_id" character varying (100) not null, "connected_customer_id" character varying (100) not null, "account_id" character varying (100) not null, "id" character varying (100) not null, "status" character varying (100) not null, "room_id" character varying (100) not null, "room_subscription_type" character varying (100) not null, "user_id" character varying (100) not null, primary key ("id"), constraint "idx_room_subscriptions_user_id_room_id" unique ("user_id", "room_id"));
 create table "stripe_default_payment_methods" ("exp_month" integer not null, "exp_year" integer not null, "is_default" boolean null default 'true', "id" character varying (100) not null, "last_four" character varying (4) not null, "user_id" character varying (100) not null, "customer_id" character varying (100) not null, primary key ("customer_id"), constraint "idx_stripe_default_payment_methods_id" unique ("id"), constraint "idx_stripe_default_payment_methods_id_customer_id" unique ("id", "customer_id"));
 create table "stripe_subscriptions" ("current_period_end" timestamp with time zone not null, "latest_invoice" jsonb null, "id" character varying (100) not null, "user_id" character varying (100) not null, "customer_id" character varying (100) not null, "status" character varying (100) not null, primary key ("id"), constraint "idx_stripe_subscriptions_customer_id" unique ("customer_id"));
@@ -0,0 +1,4 @@
+insert into "post_likes" ("post_id", "user_id", "created_at") select p.id, l.user_id, p.created_at from "posts" p, unnest(p.user_ids_who_likes) as l(user_id) on conflict ("post_id", "user_id") do nothing;
+insert into "comment_likes" ("comment_id", "user_id", "created_at") select c.id, l.user_id, c.created_at from "comments" c, unnest(c.user_ids_who_likes) as l(user_id) on conflict ("comment_id", "user_id") do nothing;
+update "posts" p set "likes" = (select count(*) from "post_likes" l where l.post_id = p.id);
+update "comments" c set "likes" = (select count(*) from "comment_likes" l where l.comment_id = c.id);
@@ -0,0 +1,2 @@
+update "comments" c set "replies_count" = (select count(*) from "comments" r where r.parent_id = c.id);
+update "comments" set "top_score" = (case when greatest("views", coalesce("likes", 0)) = 0 then 0 else (coalesce("likes", 0)::float8 / greatest("views", coalesce("likes", 0)) + 1.9208 / greatest("views", coalesce("likes", 0)) - 1.96 * sqrt(coalesce("likes", 0)::float8 * (greatest("views", coalesce("likes", 0)) - coalesce("likes", 0)) / power(greatest("views", coalesce("likes", 0)), 3) + 0.9604 / power(greatest("views", coalesce("likes", 0)), 2))) / (1 + 3.8416 / greatest("views", coalesce("likes", 0))) end), "hot_score" = log(coalesce("likes", 0) + 1) + extract(epoch from "created_at") / 45000, "controversy_score" = (case when coalesce("likes", 0) = 0 or "replies_count" = 0 then 0 else power(coalesce("likes", 0) + "replies_count", least(coalesce("likes", 0), "replies_count")::float8 / greatest(coalesce("likes", 0), "replies_count")) end);
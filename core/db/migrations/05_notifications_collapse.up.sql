@@ -0,0 +1,3 @@
+alter table "notifications" add column if not exists "count" integer not null default '1';
+alter table "notifications" add column if not exists "collapse_key" text;
+create unique index if not exists "notifications_collapse_key_idx" on "notifications" ("collapse_key");